@@ -381,6 +381,361 @@ var _ interface {
 	ErrorName() string
 } = CreateUserExampleReplyValidationError{}
 
+// Validate checks the field values on CreateBatchUserExampleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBatchUserExampleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBatchUserExampleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBatchUserExampleRequestMultiError, or nil if none found.
+func (m *CreateBatchUserExampleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBatchUserExampleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUserExamples()) < 1 {
+		err := CreateBatchUserExampleRequestValidationError{
+			field:  "UserExamples",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUserExamples() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateBatchUserExampleRequestValidationError{
+						field:  fmt.Sprintf("UserExamples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateBatchUserExampleRequestValidationError{
+						field:  fmt.Sprintf("UserExamples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateBatchUserExampleRequestValidationError{
+					field:  fmt.Sprintf("UserExamples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateBatchUserExampleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBatchUserExampleRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateBatchUserExampleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateBatchUserExampleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBatchUserExampleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBatchUserExampleRequestMultiError) AllErrors() []error { return m }
+
+// CreateBatchUserExampleRequestValidationError is the validation error returned
+// by CreateBatchUserExampleRequest.Validate if the designated constraints
+// aren't met.
+type CreateBatchUserExampleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBatchUserExampleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBatchUserExampleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBatchUserExampleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBatchUserExampleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBatchUserExampleRequestValidationError) ErrorName() string {
+	return "CreateBatchUserExampleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBatchUserExampleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBatchUserExampleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBatchUserExampleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBatchUserExampleRequestValidationError{}
+
+// Validate checks the field values on CreateBatchUserExampleReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBatchUserExampleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBatchUserExampleReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBatchUserExampleReplyMultiError, or nil if none found.
+func (m *CreateBatchUserExampleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBatchUserExampleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateBatchUserExampleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBatchUserExampleReplyMultiError is an error wrapping multiple
+// validation errors returned by CreateBatchUserExampleReply.ValidateAll() if
+// the designated constraints aren't met.
+type CreateBatchUserExampleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBatchUserExampleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBatchUserExampleReplyMultiError) AllErrors() []error { return m }
+
+// CreateBatchUserExampleReplyValidationError is the validation error returned
+// by CreateBatchUserExampleReply.Validate if the designated constraints
+// aren't met.
+type CreateBatchUserExampleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBatchUserExampleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBatchUserExampleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBatchUserExampleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBatchUserExampleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBatchUserExampleReplyValidationError) ErrorName() string {
+	return "CreateBatchUserExampleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBatchUserExampleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBatchUserExampleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBatchUserExampleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBatchUserExampleReplyValidationError{}
+
+// Validate checks the field values on UpsertUserExampleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertUserExampleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertUserExampleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpsertUserExampleReplyMultiError, or nil if none found.
+func (m *UpsertUserExampleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertUserExampleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return UpsertUserExampleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertUserExampleReplyMultiError is an error wrapping multiple validation
+// errors returned by UpsertUserExampleReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpsertUserExampleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertUserExampleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertUserExampleReplyMultiError) AllErrors() []error { return m }
+
+// UpsertUserExampleReplyValidationError is the validation error returned by
+// UpsertUserExampleReply.Validate if the designated constraints aren't met.
+type UpsertUserExampleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertUserExampleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertUserExampleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertUserExampleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertUserExampleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertUserExampleReplyValidationError) ErrorName() string {
+	return "UpsertUserExampleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertUserExampleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertUserExampleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertUserExampleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertUserExampleReplyValidationError{}
+
 // Validate checks the field values on DeleteUserExampleByIDRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -764,7 +1119,263 @@ func (m *UpdateUserExampleByIDReply) validate(all bool) error {
 type UpdateUserExampleByIDReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserExampleByIDReplyMultiError) Error() string {
+func (m UpdateUserExampleByIDReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserExampleByIDReplyMultiError) AllErrors() []error { return m }
+
+// UpdateUserExampleByIDReplyValidationError is the validation error returned
+// by UpdateUserExampleByIDReply.Validate if the designated constraints aren't met.
+type UpdateUserExampleByIDReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserExampleByIDReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserExampleByIDReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserExampleByIDReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserExampleByIDReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserExampleByIDReplyValidationError) ErrorName() string {
+	return "UpdateUserExampleByIDReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserExampleByIDReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserExampleByIDReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserExampleByIDReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserExampleByIDReplyValidationError{}
+
+// Validate checks the field values on UpdateUserExampleByIDsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserExampleByIDsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserExampleByIDsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUserExampleByIDsRequestMultiError, or nil if none found.
+func (m *UpdateUserExampleByIDsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserExampleByIDsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIds()) < 1 {
+		err := UpdateUserExampleByIDsRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserExample() == nil {
+		err := UpdateUserExampleByIDsRequestValidationError{
+			field:  "UserExample",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUserExample()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserExampleByIDsRequestValidationError{
+					field:  "UserExample",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserExampleByIDsRequestValidationError{
+					field:  "UserExample",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUserExample()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserExampleByIDsRequestValidationError{
+				field:  "UserExample",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateUserExampleByIDsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserExampleByIDsRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserExampleByIDsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateUserExampleByIDsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserExampleByIDsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserExampleByIDsRequestMultiError) AllErrors() []error { return m }
+
+// UpdateUserExampleByIDsRequestValidationError is the validation error returned
+// by UpdateUserExampleByIDsRequest.Validate if the designated constraints
+// aren't met.
+type UpdateUserExampleByIDsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserExampleByIDsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserExampleByIDsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserExampleByIDsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserExampleByIDsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserExampleByIDsRequestValidationError) ErrorName() string {
+	return "UpdateUserExampleByIDsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserExampleByIDsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserExampleByIDsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserExampleByIDsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserExampleByIDsRequestValidationError{}
+
+// Validate checks the field values on UpdateUserExampleByIDsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserExampleByIDsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserExampleByIDsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUserExampleByIDsReplyMultiError, or nil if none found.
+func (m *UpdateUserExampleByIDsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserExampleByIDsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateUserExampleByIDsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserExampleByIDsReplyMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserExampleByIDsReply.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateUserExampleByIDsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserExampleByIDsReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -773,11 +1384,12 @@ func (m UpdateUserExampleByIDReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserExampleByIDReplyMultiError) AllErrors() []error { return m }
+func (m UpdateUserExampleByIDsReplyMultiError) AllErrors() []error { return m }
 
-// UpdateUserExampleByIDReplyValidationError is the validation error returned
-// by UpdateUserExampleByIDReply.Validate if the designated constraints aren't met.
-type UpdateUserExampleByIDReplyValidationError struct {
+// UpdateUserExampleByIDsReplyValidationError is the validation error returned
+// by UpdateUserExampleByIDsReply.Validate if the designated constraints
+// aren't met.
+type UpdateUserExampleByIDsReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -785,24 +1397,24 @@ type UpdateUserExampleByIDReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserExampleByIDReplyValidationError) Field() string { return e.field }
+func (e UpdateUserExampleByIDsReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserExampleByIDReplyValidationError) Reason() string { return e.reason }
+func (e UpdateUserExampleByIDsReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserExampleByIDReplyValidationError) Cause() error { return e.cause }
+func (e UpdateUserExampleByIDsReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserExampleByIDReplyValidationError) Key() bool { return e.key }
+func (e UpdateUserExampleByIDsReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserExampleByIDReplyValidationError) ErrorName() string {
-	return "UpdateUserExampleByIDReplyValidationError"
+func (e UpdateUserExampleByIDsReplyValidationError) ErrorName() string {
+	return "UpdateUserExampleByIDsReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserExampleByIDReplyValidationError) Error() string {
+func (e UpdateUserExampleByIDsReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -814,14 +1426,14 @@ func (e UpdateUserExampleByIDReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserExampleByIDReply.%s: %s%s",
+		"invalid %sUpdateUserExampleByIDsReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserExampleByIDReplyValidationError{}
+var _ error = UpdateUserExampleByIDsReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -829,7 +1441,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserExampleByIDReplyValidationError{}
+} = UpdateUserExampleByIDsReplyValidationError{}
 
 // Validate checks the field values on UserExample with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -1635,6 +2247,8 @@ func (m *ListUserExampleReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
 	if len(errors) > 0 {
 		return ListUserExampleReplyMultiError(errors)
 	}
@@ -1714,3 +2328,298 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListUserExampleReplyValidationError{}
+
+// Validate checks the field values on GetUserExampleByEmailRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserExampleByEmailRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserExampleByEmailRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserExampleByEmailRequestMultiError, or nil if none found.
+func (m *GetUserExampleByEmailRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserExampleByEmailRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = GetUserExampleByEmailRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserExampleByEmailRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetUserExampleByEmailRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *GetUserExampleByEmailRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// GetUserExampleByEmailRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserExampleByEmailRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserExampleByEmailRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserExampleByEmailRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserExampleByEmailRequestMultiError) AllErrors() []error { return m }
+
+// GetUserExampleByEmailRequestValidationError is the validation error returned by
+// GetUserExampleByEmailRequest.Validate if the designated constraints aren't met.
+type GetUserExampleByEmailRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserExampleByEmailRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserExampleByEmailRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserExampleByEmailRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserExampleByEmailRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserExampleByEmailRequestValidationError) ErrorName() string {
+	return "GetUserExampleByEmailRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserExampleByEmailRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserExampleByEmailRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserExampleByEmailRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserExampleByEmailRequestValidationError{}
+
+// Validate checks the field values on GetUserExampleByEmailReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserExampleByEmailReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserExampleByEmailReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserExampleByEmailReplyMultiError, or nil if none found.
+func (m *GetUserExampleByEmailReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserExampleByEmailReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUserExample()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserExampleByEmailReplyValidationError{
+					field:  "UserExample",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserExampleByEmailReplyValidationError{
+					field:  "UserExample",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUserExample()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserExampleByEmailReplyValidationError{
+				field:  "UserExample",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserExampleByEmailReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserExampleByEmailReplyMultiError is an error wrapping multiple validation
+// errors returned by GetUserExampleByEmailReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserExampleByEmailReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserExampleByEmailReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserExampleByEmailReplyMultiError) AllErrors() []error { return m }
+
+// GetUserExampleByEmailReplyValidationError is the validation error returned by
+// GetUserExampleByEmailReply.Validate if the designated constraints aren't met.
+type GetUserExampleByEmailReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserExampleByEmailReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserExampleByEmailReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserExampleByEmailReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserExampleByEmailReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserExampleByEmailReplyValidationError) ErrorName() string {
+	return "GetUserExampleByEmailReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserExampleByEmailReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserExampleByEmailReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserExampleByEmailReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserExampleByEmailReplyValidationError{}
@@ -23,11 +23,15 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UserExampleServiceClient interface {
 	Create(ctx context.Context, in *CreateUserExampleRequest, opts ...grpc.CallOption) (*CreateUserExampleReply, error)
+	CreateBatch(ctx context.Context, in *CreateBatchUserExampleRequest, opts ...grpc.CallOption) (*CreateBatchUserExampleReply, error)
+	Upsert(ctx context.Context, in *CreateUserExampleRequest, opts ...grpc.CallOption) (*UpsertUserExampleReply, error)
 	DeleteByID(ctx context.Context, in *DeleteUserExampleByIDRequest, opts ...grpc.CallOption) (*DeleteUserExampleByIDReply, error)
 	UpdateByID(ctx context.Context, in *UpdateUserExampleByIDRequest, opts ...grpc.CallOption) (*UpdateUserExampleByIDReply, error)
+	UpdateByIDs(ctx context.Context, in *UpdateUserExampleByIDsRequest, opts ...grpc.CallOption) (*UpdateUserExampleByIDsReply, error)
 	GetByID(ctx context.Context, in *GetUserExampleByIDRequest, opts ...grpc.CallOption) (*GetUserExampleByIDReply, error)
 	ListByIDs(ctx context.Context, in *ListUserExampleByIDsRequest, opts ...grpc.CallOption) (*ListUserExampleByIDsReply, error)
 	List(ctx context.Context, in *ListUserExampleRequest, opts ...grpc.CallOption) (*ListUserExampleReply, error)
+	GetByEmail(ctx context.Context, in *GetUserExampleByEmailRequest, opts ...grpc.CallOption) (*GetUserExampleByEmailReply, error)
 }
 
 type userExampleServiceClient struct {
@@ -47,6 +51,24 @@ func (c *userExampleServiceClient) Create(ctx context.Context, in *CreateUserExa
 	return out, nil
 }
 
+func (c *userExampleServiceClient) CreateBatch(ctx context.Context, in *CreateBatchUserExampleRequest, opts ...grpc.CallOption) (*CreateBatchUserExampleReply, error) {
+	out := new(CreateBatchUserExampleReply)
+	err := c.cc.Invoke(ctx, "/api.serverNameExample.v1.userExampleService/CreateBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExampleServiceClient) Upsert(ctx context.Context, in *CreateUserExampleRequest, opts ...grpc.CallOption) (*UpsertUserExampleReply, error) {
+	out := new(UpsertUserExampleReply)
+	err := c.cc.Invoke(ctx, "/api.serverNameExample.v1.userExampleService/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userExampleServiceClient) DeleteByID(ctx context.Context, in *DeleteUserExampleByIDRequest, opts ...grpc.CallOption) (*DeleteUserExampleByIDReply, error) {
 	out := new(DeleteUserExampleByIDReply)
 	err := c.cc.Invoke(ctx, "/api.serverNameExample.v1.userExampleService/DeleteByID", in, out, opts...)
@@ -65,6 +87,15 @@ func (c *userExampleServiceClient) UpdateByID(ctx context.Context, in *UpdateUse
 	return out, nil
 }
 
+func (c *userExampleServiceClient) UpdateByIDs(ctx context.Context, in *UpdateUserExampleByIDsRequest, opts ...grpc.CallOption) (*UpdateUserExampleByIDsReply, error) {
+	out := new(UpdateUserExampleByIDsReply)
+	err := c.cc.Invoke(ctx, "/api.serverNameExample.v1.userExampleService/UpdateByIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userExampleServiceClient) GetByID(ctx context.Context, in *GetUserExampleByIDRequest, opts ...grpc.CallOption) (*GetUserExampleByIDReply, error) {
 	out := new(GetUserExampleByIDReply)
 	err := c.cc.Invoke(ctx, "/api.serverNameExample.v1.userExampleService/GetByID", in, out, opts...)
@@ -92,16 +123,29 @@ func (c *userExampleServiceClient) List(ctx context.Context, in *ListUserExample
 	return out, nil
 }
 
+func (c *userExampleServiceClient) GetByEmail(ctx context.Context, in *GetUserExampleByEmailRequest, opts ...grpc.CallOption) (*GetUserExampleByEmailReply, error) {
+	out := new(GetUserExampleByEmailReply)
+	err := c.cc.Invoke(ctx, "/api.serverNameExample.v1.userExampleService/GetByEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserExampleServiceServer is the server API for UserExampleService service.
 // All implementations must embed UnimplementedUserExampleServiceServer
 // for forward compatibility
 type UserExampleServiceServer interface {
 	Create(context.Context, *CreateUserExampleRequest) (*CreateUserExampleReply, error)
+	CreateBatch(context.Context, *CreateBatchUserExampleRequest) (*CreateBatchUserExampleReply, error)
+	Upsert(context.Context, *CreateUserExampleRequest) (*UpsertUserExampleReply, error)
 	DeleteByID(context.Context, *DeleteUserExampleByIDRequest) (*DeleteUserExampleByIDReply, error)
 	UpdateByID(context.Context, *UpdateUserExampleByIDRequest) (*UpdateUserExampleByIDReply, error)
+	UpdateByIDs(context.Context, *UpdateUserExampleByIDsRequest) (*UpdateUserExampleByIDsReply, error)
 	GetByID(context.Context, *GetUserExampleByIDRequest) (*GetUserExampleByIDReply, error)
 	ListByIDs(context.Context, *ListUserExampleByIDsRequest) (*ListUserExampleByIDsReply, error)
 	List(context.Context, *ListUserExampleRequest) (*ListUserExampleReply, error)
+	GetByEmail(context.Context, *GetUserExampleByEmailRequest) (*GetUserExampleByEmailReply, error)
 	mustEmbedUnimplementedUserExampleServiceServer()
 }
 
@@ -112,12 +156,21 @@ type UnimplementedUserExampleServiceServer struct {
 func (UnimplementedUserExampleServiceServer) Create(context.Context, *CreateUserExampleRequest) (*CreateUserExampleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
+func (UnimplementedUserExampleServiceServer) CreateBatch(context.Context, *CreateBatchUserExampleRequest) (*CreateBatchUserExampleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBatch not implemented")
+}
+func (UnimplementedUserExampleServiceServer) Upsert(context.Context, *CreateUserExampleRequest) (*UpsertUserExampleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
 func (UnimplementedUserExampleServiceServer) DeleteByID(context.Context, *DeleteUserExampleByIDRequest) (*DeleteUserExampleByIDReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteByID not implemented")
 }
 func (UnimplementedUserExampleServiceServer) UpdateByID(context.Context, *UpdateUserExampleByIDRequest) (*UpdateUserExampleByIDReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateByID not implemented")
 }
+func (UnimplementedUserExampleServiceServer) UpdateByIDs(context.Context, *UpdateUserExampleByIDsRequest) (*UpdateUserExampleByIDsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateByIDs not implemented")
+}
 func (UnimplementedUserExampleServiceServer) GetByID(context.Context, *GetUserExampleByIDRequest) (*GetUserExampleByIDReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
 }
@@ -127,6 +180,9 @@ func (UnimplementedUserExampleServiceServer) ListByIDs(context.Context, *ListUse
 func (UnimplementedUserExampleServiceServer) List(context.Context, *ListUserExampleRequest) (*ListUserExampleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedUserExampleServiceServer) GetByEmail(context.Context, *GetUserExampleByEmailRequest) (*GetUserExampleByEmailReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByEmail not implemented")
+}
 func (UnimplementedUserExampleServiceServer) mustEmbedUnimplementedUserExampleServiceServer() {}
 
 // UnsafeUserExampleServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +214,42 @@ func _UserExampleService_Create_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserExampleService_CreateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBatchUserExampleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExampleServiceServer).CreateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.serverNameExample.v1.userExampleService/CreateBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExampleServiceServer).CreateBatch(ctx, req.(*CreateBatchUserExampleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExampleService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserExampleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExampleServiceServer).Upsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.serverNameExample.v1.userExampleService/Upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExampleServiceServer).Upsert(ctx, req.(*CreateUserExampleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserExampleService_DeleteByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteUserExampleByIDRequest)
 	if err := dec(in); err != nil {
@@ -194,6 +286,24 @@ func _UserExampleService_UpdateByID_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserExampleService_UpdateByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserExampleByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExampleServiceServer).UpdateByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.serverNameExample.v1.userExampleService/UpdateByIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExampleServiceServer).UpdateByIDs(ctx, req.(*UpdateUserExampleByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserExampleService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserExampleByIDRequest)
 	if err := dec(in); err != nil {
@@ -248,6 +358,24 @@ func _UserExampleService_List_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserExampleService_GetByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserExampleByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExampleServiceServer).GetByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.serverNameExample.v1.userExampleService/GetByEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExampleServiceServer).GetByEmail(ctx, req.(*GetUserExampleByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserExampleService_ServiceDesc is the grpc.ServiceDesc for UserExampleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -259,6 +387,14 @@ var UserExampleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _UserExampleService_Create_Handler,
 		},
+		{
+			MethodName: "CreateBatch",
+			Handler:    _UserExampleService_CreateBatch_Handler,
+		},
+		{
+			MethodName: "Upsert",
+			Handler:    _UserExampleService_Upsert_Handler,
+		},
 		{
 			MethodName: "DeleteByID",
 			Handler:    _UserExampleService_DeleteByID_Handler,
@@ -267,6 +403,10 @@ var UserExampleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateByID",
 			Handler:    _UserExampleService_UpdateByID_Handler,
 		},
+		{
+			MethodName: "UpdateByIDs",
+			Handler:    _UserExampleService_UpdateByIDs_Handler,
+		},
 		{
 			MethodName: "GetByID",
 			Handler:    _UserExampleService_GetByID_Handler,
@@ -279,6 +419,10 @@ var UserExampleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _UserExampleService_List_Handler,
 		},
+		{
+			MethodName: "GetByEmail",
+			Handler:    _UserExampleService_GetByEmail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/serverNameExample/v1/userExample.proto",
@@ -14,11 +14,15 @@ import (
 
 type UserExampleServiceLogicer interface {
 	Create(ctx context.Context, req *CreateUserExampleRequest) (*CreateUserExampleReply, error)
+	CreateBatch(ctx context.Context, req *CreateBatchUserExampleRequest) (*CreateBatchUserExampleReply, error)
 	DeleteByID(ctx context.Context, req *DeleteUserExampleByIDRequest) (*DeleteUserExampleByIDReply, error)
+	GetByEmail(ctx context.Context, req *GetUserExampleByEmailRequest) (*GetUserExampleByEmailReply, error)
 	GetByID(ctx context.Context, req *GetUserExampleByIDRequest) (*GetUserExampleByIDReply, error)
 	List(ctx context.Context, req *ListUserExampleRequest) (*ListUserExampleReply, error)
 	ListByIDs(ctx context.Context, req *ListUserExampleByIDsRequest) (*ListUserExampleByIDsReply, error)
 	UpdateByID(ctx context.Context, req *UpdateUserExampleByIDRequest) (*UpdateUserExampleByIDReply, error)
+	UpdateByIDs(ctx context.Context, req *UpdateUserExampleByIDsRequest) (*UpdateUserExampleByIDsReply, error)
+	Upsert(ctx context.Context, req *CreateUserExampleRequest) (*UpsertUserExampleReply, error)
 }
 
 type UserExampleServiceOption func(*userExampleServiceOptions)
@@ -88,11 +92,15 @@ type userExampleServiceRouter struct {
 
 func (r *userExampleServiceRouter) register() {
 	r.iRouter.Handle("POST", "/api/v1/userExample", r.Create_0)
+	r.iRouter.Handle("POST", "/api/v1/userExamples/batch", r.CreateBatch_0)
+	r.iRouter.Handle("PUT", "/api/v1/userExample", r.Upsert_0)
 	r.iRouter.Handle("DELETE", "/api/v1/userExample/:id", r.DeleteByID_0)
 	r.iRouter.Handle("PUT", "/api/v1/userExample/:id", r.UpdateByID_0)
+	r.iRouter.Handle("PUT", "/api/v1/userExamples/ids", r.UpdateByIDs_0)
 	r.iRouter.Handle("GET", "/api/v1/userExample/:id", r.GetByID_0)
 	r.iRouter.Handle("POST", "/api/v1/userExamples/ids", r.ListByIDs_0)
 	r.iRouter.Handle("POST", "/api/v1/userExamples", r.List_0)
+	r.iRouter.Handle("GET", "/api/v1/userExample/email/:email", r.GetByEmail_0)
 
 }
 
@@ -117,6 +125,48 @@ func (r *userExampleServiceRouter) Create_0(c *gin.Context) {
 	r.iResponse.Success(c, out)
 }
 
+func (r *userExampleServiceRouter) CreateBatch_0(c *gin.Context) {
+	req := &CreateBatchUserExampleRequest{}
+
+	if err := c.ShouldBindJSON(req); err != nil {
+		r.zapLog.Warn("ShouldBindJSON error", zap.Error(err), middleware.GCtxRequestIDField(c))
+		r.iResponse.ParamError(c, err)
+		return
+	}
+
+	out, err := r.iLogic.CreateBatch(c.Request.Context(), req)
+	if err != nil {
+		isIgnore := r.iResponse.Error(c, err)
+		if !isIgnore {
+			r.zapLog.Error("CreateBatch error", zap.Error(err), middleware.GCtxRequestIDField(c))
+		}
+		return
+	}
+
+	r.iResponse.Success(c, out)
+}
+
+func (r *userExampleServiceRouter) Upsert_0(c *gin.Context) {
+	req := &CreateUserExampleRequest{}
+
+	if err := c.ShouldBindJSON(req); err != nil {
+		r.zapLog.Warn("ShouldBindJSON error", zap.Error(err), middleware.GCtxRequestIDField(c))
+		r.iResponse.ParamError(c, err)
+		return
+	}
+
+	out, err := r.iLogic.Upsert(c.Request.Context(), req)
+	if err != nil {
+		isIgnore := r.iResponse.Error(c, err)
+		if !isIgnore {
+			r.zapLog.Error("Upsert error", zap.Error(err), middleware.GCtxRequestIDField(c))
+		}
+		return
+	}
+
+	r.iResponse.Success(c, out)
+}
+
 func (r *userExampleServiceRouter) DeleteByID_0(c *gin.Context) {
 	req := &DeleteUserExampleByIDRequest{}
 
@@ -171,6 +221,27 @@ func (r *userExampleServiceRouter) UpdateByID_0(c *gin.Context) {
 	r.iResponse.Success(c, out)
 }
 
+func (r *userExampleServiceRouter) UpdateByIDs_0(c *gin.Context) {
+	req := &UpdateUserExampleByIDsRequest{}
+
+	if err := c.ShouldBindJSON(req); err != nil {
+		r.zapLog.Warn("ShouldBindJSON error", zap.Error(err), middleware.GCtxRequestIDField(c))
+		r.iResponse.ParamError(c, err)
+		return
+	}
+
+	out, err := r.iLogic.UpdateByIDs(c.Request.Context(), req)
+	if err != nil {
+		isIgnore := r.iResponse.Error(c, err)
+		if !isIgnore {
+			r.zapLog.Error("UpdateByIDs error", zap.Error(err), middleware.GCtxRequestIDField(c))
+		}
+		return
+	}
+
+	r.iResponse.Success(c, out)
+}
+
 func (r *userExampleServiceRouter) GetByID_0(c *gin.Context) {
 	req := &GetUserExampleByIDRequest{}
 
@@ -239,3 +310,30 @@ func (r *userExampleServiceRouter) List_0(c *gin.Context) {
 
 	r.iResponse.Success(c, out)
 }
+
+func (r *userExampleServiceRouter) GetByEmail_0(c *gin.Context) {
+	req := &GetUserExampleByEmailRequest{}
+
+	if err := c.ShouldBindUri(req); err != nil {
+		r.zapLog.Warn("ShouldBindUri error", zap.Error(err), middleware.GCtxRequestIDField(c))
+		r.iResponse.ParamError(c, err)
+		return
+	}
+
+	if err := c.ShouldBindQuery(req); err != nil {
+		r.zapLog.Warn("ShouldBindQuery error", zap.Error(err), middleware.GCtxRequestIDField(c))
+		r.iResponse.ParamError(c, err)
+		return
+	}
+
+	out, err := r.iLogic.GetByEmail(c.Request.Context(), req)
+	if err != nil {
+		isIgnore := r.iResponse.Error(c, err)
+		if !isIgnore {
+			r.zapLog.Error("GetByEmail error", zap.Error(err), middleware.GCtxRequestIDField(c))
+		}
+		return
+	}
+
+	r.iResponse.Success(c, out)
+}
@@ -4,24 +4,36 @@ import (
 	"math/rand"
 	"text/template"
 	"time"
+
+	"github.com/zhufuyi/sponge/cmd/protoc-gen-go-gin/internal/parse"
 )
 
 func init() {
-	var err error
-	handlerLogicTmpl, err = template.New("handlerLogic").Parse(handlerLogicTmplRaw)
-	if err != nil {
+	if err := SetTemplateDir(""); err != nil {
 		panic(err)
 	}
-	routerTmpl, err = template.New("handlerRouter").Parse(routerTmplRaw)
+
+	rand.Seed(time.Now().UnixNano())
+}
+
+// SetTemplateDir parse the templates, the user-supplied template files handlerLogic.tmpl, handlerRouter.tmpl and
+// httpErrCode.tmpl in the directory override the built-in templates
+func SetTemplateDir(dir string) error {
+	handlerLogic, err := parse.ParseTemplate(dir, "handlerLogic", handlerLogicTmplRaw)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	httpErrCodeTmpl, err = template.New("httpErrCode").Parse(httpErrCodeTmplRaw)
+	router, err := parse.ParseTemplate(dir, "handlerRouter", routerTmplRaw)
 	if err != nil {
-		panic(err)
+		return err
+	}
+	httpErrCode, err := parse.ParseTemplate(dir, "httpErrCode", httpErrCodeTmplRaw)
+	if err != nil {
+		return err
 	}
 
-	rand.Seed(time.Now().UnixNano())
+	handlerLogicTmpl, routerTmpl, httpErrCodeTmpl = handlerLogic, router, httpErrCode
+	return nil
 }
 
 var (
@@ -2,16 +2,28 @@ package router
 
 import (
 	"text/template"
+
+	"github.com/zhufuyi/sponge/cmd/protoc-gen-go-gin/internal/parse"
 )
 
 func init() {
-	var err error
-	handlerTmpl, err = template.New("iRouter").Parse(handlerTmplRaw)
-	if err != nil {
+	if err := SetTemplateDir(""); err != nil {
 		panic(err)
 	}
 }
 
+// SetTemplateDir parse the templates, the user-supplied template file iRouter.tmpl
+// in the directory overrides the built-in template
+func SetTemplateDir(dir string) error {
+	handler, err := parse.ParseTemplate(dir, "iRouter", handlerTmplRaw)
+	if err != nil {
+		return err
+	}
+
+	handlerTmpl = handler
+	return nil
+}
+
 var (
 	handlerTmpl    *template.Template
 	handlerTmplRaw = `
@@ -4,24 +4,36 @@ import (
 	"math/rand"
 	"text/template"
 	"time"
+
+	"github.com/zhufuyi/sponge/cmd/protoc-gen-go-gin/internal/parse"
 )
 
 func init() {
-	var err error
-	serviceLogicTmpl, err = template.New("serviceLogic").Parse(serviceLogicTmplRaw)
-	if err != nil {
+	if err := SetTemplateDir(""); err != nil {
 		panic(err)
 	}
-	routerTmpl, err = template.New("serviceRouter").Parse(routerTmplRaw)
+
+	rand.Seed(time.Now().UnixNano())
+}
+
+// SetTemplateDir parse the templates, the user-supplied template files serviceLogic.tmpl, serviceRouter.tmpl and
+// rpcErrCode.tmpl in the directory override the built-in templates
+func SetTemplateDir(dir string) error {
+	serviceLogic, err := parse.ParseTemplate(dir, "serviceLogic", serviceLogicTmplRaw)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	rpcErrCodeTmpl, err = template.New("rpcErrCode").Parse(rpcErrCodeTmplRaw)
+	router, err := parse.ParseTemplate(dir, "serviceRouter", routerTmplRaw)
 	if err != nil {
-		panic(err)
+		return err
+	}
+	rpcErrCode, err := parse.ParseTemplate(dir, "rpcErrCode", rpcErrCodeTmplRaw)
+	if err != nil {
+		return err
 	}
 
-	rand.Seed(time.Now().UnixNano())
+	serviceLogicTmpl, routerTmpl, rpcErrCodeTmpl = serviceLogic, router, rpcErrCode
+	return nil
 }
 
 var (
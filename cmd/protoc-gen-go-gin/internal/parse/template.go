@@ -0,0 +1,22 @@
+package parse
+
+import (
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+// ParseTemplate parse the template, if the user-supplied template file <name>.tmpl exists in dir,
+// it is used instead of the built-in template
+func ParseTemplate(dir string, name string, raw string) (*template.Template, error) {
+	if dir != "" {
+		data, err := os.ReadFile(filepath.Join(dir, name+".tmpl"))
+		if err == nil {
+			raw = string(data)
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	return template.New(name).Parse(raw)
+}
@@ -0,0 +1,33 @@
+package parse
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTemplate(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "foo.tmpl"), []byte("// license header\n{{.}}"), 0666)
+	assert.NoError(t, err)
+
+	tmpl, err := ParseTemplate(dir, "foo", "{{.}}")
+	assert.NoError(t, err)
+	buf := new(bytes.Buffer)
+	err = tmpl.Execute(buf, "hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "// license header\nhello", buf.String())
+
+	tmpl, err = ParseTemplate(dir, "bar", "{{.}}")
+	assert.NoError(t, err)
+	buf.Reset()
+	err = tmpl.Execute(buf, "hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", buf.String())
+
+	_, err = ParseTemplate("", "foo", "{{if .foo}}")
+	assert.Error(t, err)
+}
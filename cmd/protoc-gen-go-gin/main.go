@@ -55,7 +55,7 @@ func main() {
 
 	var flags flag.FlagSet
 
-	var plugin, moduleName, serverName, logicOut, routerOut, ecodeOut string
+	var plugin, moduleName, serverName, logicOut, routerOut, ecodeOut, templateDir string
 	flags.StringVar(&plugin, "plugin", "", "plugin name, supported values: handler or service")
 	flags.StringVar(&moduleName, "moduleName", "", "module name for plugin")
 	flags.StringVar(&serverName, "serverName", "", "server name for plugin")
@@ -63,6 +63,8 @@ func main() {
 		"the default value is internal/handler if the plugin is a handler, or internal/service if it is a service")
 	flags.StringVar(&routerOut, "routerOut", "", "directory of routing codes generated by the plugin, default is internal/routers")
 	flags.StringVar(&ecodeOut, "ecodeOut", "", "directory of error code generated by the plugin, default is internal/ecode")
+	flags.StringVar(&templateDir, "templateDir", "", "directory of the user-supplied templates that override the built-in templates, "+
+		"the file name is <template name>.tmpl, default is ~/.sponge/templates")
 
 	options := protogen.Options{
 		ParamFunc: flags.Set,
@@ -99,6 +101,10 @@ func main() {
 			return fmt.Errorf("protoc-gen-go-gin: unknown plugin %q", plugin)
 		}
 
+		if err := setTemplateDir(templateDir); err != nil {
+			return fmt.Errorf("protoc-gen-go-gin: parse templates error, %v", err)
+		}
+
 		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 		for _, f := range gen.Files {
 			if !f.Generate {
@@ -214,6 +220,28 @@ func saveFileSimple(out string, filePath string, content []byte, isNeedCovered b
 	return os.WriteFile(file, content, 0666)
 }
 
+// the user-supplied templates override the built-in templates with the same name
+func setTemplateDir(dir string) error {
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil // use the built-in templates
+		}
+		dir = filepath.Join(home, ".sponge", "templates")
+		if !isExists(dir) {
+			return nil
+		}
+	}
+
+	if err := router.SetTemplateDir(dir); err != nil {
+		return err
+	}
+	if err := handler.SetTemplateDir(dir); err != nil {
+		return err
+	}
+	return service.SetTemplateDir(dir)
+}
+
 func isExists(path string) bool {
 	_, err := os.Stat(path)
 	if err != nil {
@@ -24,13 +24,25 @@ func RegisterClose(servers []app.IServer) []app.Close {
 	closes = append(closes, func() error {
 		return model.CloseMysql()
 	})
+	for name := range config.Get().NamedMysql {
+		name := name
+		closes = append(closes, func() error {
+			return model.CloseMysql(name)
+		})
+	}
 
 	// close redis
-	if config.Get().App.CacheType == "redis" {
+	if config.Get().App.CacheType == "redis" || config.Get().App.CacheType == "multi" {
 		closes = append(closes, func() error {
 			return model.CloseRedis()
 		})
 	}
+	for name := range config.Get().NamedRedis {
+		name := name
+		closes = append(closes, func() error {
+			return model.CloseRedis(name)
+		})
+	}
 
 	// close tracing
 	if config.Get().App.EnableTrace {
@@ -16,28 +16,50 @@ import (
 const (
 	// TplNameSponge name of the template
 	TplNameSponge = "sponge"
+
+	defaultTemplateDirName = "templates"
 )
 
 var (
+	// the pristine generated files are saved in this directory of the project, used to merge the changes when regenerating,
+	// the directory name starts with '.' so that the go files in it are ignored by ./... of the go commands
+	pristineDir = "docs/.gen.pristine"
+
 	modelFile     = "model/userExample.go"
 	modelFileMark = "// todo generate model codes to here"
 
-	daoFile     = "dao/userExample.go"
-	daoFileMark = "// todo generate the update fields code to here"
-	daoTestFile = "dao/userExample_test.go"
-
-	handlerFile     = "types/userExample_types.go"
-	handlerFileMark = "// todo generate the request and response struct to here"
-	handlerTestFile = "handler/userExample_test.go"
+	daoFile                   = "dao/userExample.go"
+	daoFileMark               = "// todo generate the update fields code to here"
+	daoFileIndexInterfaceMark = "// todo generate the dao index methods interface code to here"
+	daoFileIndexMark          = "// todo generate the dao index methods code to here"
+	daoTestFile               = "dao/userExample_test.go"
+	daoTestFileIndexMark      = "// todo generate the dao index methods test code to here"
+
+	handlerFile                   = "types/userExample_types.go"
+	handlerFileMark               = "// todo generate the request and response struct to here"
+	handlerLogicFile              = "handler/userExample.go"
+	handlerFileIndexInterfaceMark = "// todo generate the handler index methods interface code to here"
+	handlerFileIndexMark          = "// todo generate the handler index methods code to here"
+	handlerTestFile               = "handler/userExample_test.go"
+
+	routerFile              = "routers/userExample.go"
+	routerFileIndexMark     = "// todo generate the index routes code to here"
+	routerTestFile          = "routers/routers_test.go"
+	routerTestFileIndexMark = "// todo generate the index methods of the mock handler to here"
 
 	httpFile = "server/http.go"
 
 	protoFile     = "v1/userExample.proto"
 	protoFileMark = "// todo generate the protobuf code here"
 
-	serviceTestFile   = "service/userExample_test.go"
-	serviceClientFile = "service/userExample_client_test.go"
-	serviceFileMark   = "// todo generate the service struct code here"
+	serviceLogicFile     = "service/userExample.go"
+	serviceTestFile      = "service/userExample_test.go"
+	serviceClientFile    = "service/userExample_client_test.go"
+	serviceFileMark      = "// todo generate the service struct code here"
+	serviceFileIndexMark = "// todo generate the service index methods code to here"
+
+	serviceFileConvertRequestMark = "// todo generate the conversion code of the request to here"
+	serviceFileConvertReplyMark   = "// todo generate the conversion code of the reply to here"
 
 	dockerFile     = "build/Dockerfile"
 	dockerFileMark = "# todo generate dockerfile code for http or grpc here"
@@ -70,10 +92,18 @@ var (
 	endMarkStr            = "// delete the templates code end"
 	startMark             = []byte(startMarkStr)
 	endMark               = []byte(endMarkStr)
+	startMark2            = []byte(startMarkStr + " 2")
+	endMark2              = []byte(endMarkStr + " 2")
 	wellStartMark         = symbolConvert(startMarkStr)
 	wellEndMark           = symbolConvert(endMarkStr)
 	wellStartMark2        = symbolConvert(startMarkStr, " 2")
 	wellEndMark2          = symbolConvert(endMarkStr, " 2")
+	indexStartMarkStr     = "// delete the templates index code start"
+	indexEndMarkStr       = "// delete the templates index code end"
+	indexStartMark        = []byte(indexStartMarkStr)
+	indexEndMark          = []byte(indexEndMarkStr)
+	indexStartMark2       = []byte(indexStartMarkStr + " 2")
+	indexEndMark2         = []byte(indexEndMarkStr + " 2")
 	onlyGrpcStartMarkStr  = "// only grpc use start"
 	onlyGrpcEndMarkStr    = "// only grpc use end\n"
 	wellOnlyGrpcStartMark = symbolConvert(onlyGrpcStartMarkStr)
@@ -83,6 +113,20 @@ var (
 	selfPackageName = "github.com/zhufuyi/sponge"
 )
 
+// bind the generated dao to the named mysql connection, dbName is the key of namedMysql in the config,
+// if empty, the default mysql is used
+func dbNameFields(dbName string) []replacer.Field {
+	if dbName == "" {
+		return nil
+	}
+	return []replacer.Field{
+		{
+			Old: `UserExampleDBName = ""`,
+			New: fmt.Sprintf(`UserExampleDBName = "%s"`, dbName),
+		},
+	}
+}
+
 func symbolConvert(str string, additionalChar ...string) []byte {
 	char := ""
 	if len(additionalChar) > 0 {
@@ -157,6 +201,85 @@ func replaceFileContentMark(r replacer.Replacer, filename string, newContent str
 	return fields
 }
 
+// save the generated files, the existing files are three-way merged with the newly generated files
+// based on the pristine files, the overlapping changes are marked as conflicts in the files.
+// if isDryRun is true, only print the files to be generated and the diff against the existing files.
+func saveFiles(r replacer.Replacer, isDryRun bool) error {
+	r.SetPristineDir(pristineDir)
+	if isDryRun {
+		changes, err := r.PreviewFiles()
+		if err != nil {
+			return err
+		}
+		printFileChanges(r.GetOutputDir(), changes)
+		return nil
+	}
+
+	if err := r.SaveFiles(); err != nil {
+		return err
+	}
+	if files := r.GetConflictFiles(); len(files) > 0 {
+		fmt.Printf("merge conflicts detected, please resolve the conflict markers in the files:\n    %s\n\n",
+			strings.Join(files, "\n    "))
+	}
+	return nil
+}
+
+// print the file tree to be written and the unified diff against the existing files
+func printFileChanges(outPath string, changes []replacer.FileChange) {
+	fmt.Printf("dry run, no files are written, the files to be generated in %s:\n\n", outPath)
+
+	count := make(map[string]int)
+	var prevDirs []string
+	for _, change := range changes {
+		rel, err := filepath.Rel(outPath, change.Path)
+		if err != nil {
+			rel = change.Path
+		}
+		names := strings.Split(filepath.ToSlash(rel), "/")
+		dirs, name := names[:len(names)-1], names[len(names)-1]
+
+		// print the directories that are different from the previous file
+		i := 0
+		for i < len(dirs) && i < len(prevDirs) && dirs[i] == prevDirs[i] {
+			i++
+		}
+		for ; i < len(dirs); i++ {
+			fmt.Printf("%s%s/\n", strings.Repeat("    ", i), dirs[i])
+		}
+		prevDirs = dirs
+
+		status := change.Status
+		if change.IsConflict {
+			status += ", conflict"
+		}
+		fmt.Printf("%s%s  [%s]\n", strings.Repeat("    ", len(dirs)), name, status)
+		count[change.Status]++
+	}
+
+	fmt.Printf("\ntotal %d files, %d new, %d modified, %d unchanged\n\n", len(changes),
+		count[replacer.FileStatusNew], count[replacer.FileStatusModified], count[replacer.FileStatusUnchanged])
+
+	for _, change := range changes {
+		if change.Diff != "" {
+			fmt.Println(change.Diff)
+		}
+	}
+}
+
+// get the directory of the user-supplied templates, the template file <name>.tmpl overrides the built-in code template
+// with the same name, the file with the same relative path overrides the file of the server template,
+// default is the templates directory in the sponge template directory
+func getTemplateDir(templateDir string) string {
+	if templateDir != "" {
+		return templateDir
+	}
+	if r := Replacers[TplNameSponge]; r != nil {
+		return filepath.Join(r.GetSourcePath(), defaultTemplateDirName)
+	}
+	return ""
+}
+
 // resolving mirror repository host and name
 func parseImageRepoAddr(addr string) (string, string) {
 	splits := strings.Split(addr, "/")
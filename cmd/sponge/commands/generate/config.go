@@ -10,6 +10,7 @@ import (
 
 	"github.com/zhufuyi/sponge/pkg/gofile"
 	"github.com/zhufuyi/sponge/pkg/jy2struct"
+	"github.com/zhufuyi/sponge/pkg/replacer"
 
 	"github.com/spf13/cobra"
 )
@@ -24,6 +25,7 @@ func ConfigCommand() *cobra.Command {
 		}
 		serverDir = ""
 		outPath   string // output directory
+		isDryRun  bool   // only print the files to be generated and the diff
 	)
 
 	cmd := &cobra.Command{
@@ -42,7 +44,7 @@ Examples:
 		SilenceUsage:  true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if ysArgs.InputFile != "" {
-				return convertToGoFile(ysArgs, outPath)
+				return convertToGoFile(ysArgs, outPath, isDryRun)
 			}
 
 			if serverDir == "" {
@@ -54,23 +56,28 @@ Examples:
 				return err
 			}
 
-			err = runGenConfigCommand(files, ysArgs)
+			err = runGenConfigCommand(files, ysArgs, isDryRun)
 			if err != nil {
 				return err
 			}
-			fmt.Println("covert yaml to go struct successfully.")
+			if !isDryRun {
+				fmt.Println("covert yaml to go struct successfully.")
+			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVarP(&serverDir, "server-dir", "d", "", "server directory")
 	cmd.Flags().StringVarP(&ysArgs.InputFile, "yaml-file", "f", "", "yaml file")
+	cmd.Flags().StringVarP(&ysArgs.MapTypes, "map-type", "", "namedMysql:Mysql,namedRedis:Redis", "the fields whose values are the map of named sub-structures, "+
+		"format key:Type, multiple separated by commas, e.g. the named connections of mysql and redis")
 	cmd.Flags().StringVarP(&outPath, "out", "o", "", "output directory, default is ./config_<time>")
+	cmd.Flags().BoolVarP(&isDryRun, "dry-run", "", false, "print the files to be generated and the diff against the existing files, no files are written")
 
 	return cmd
 }
 
-func runGenConfigCommand(files map[string]configType, ysArgs jy2struct.Args) error {
+func runGenConfigCommand(files map[string]configType, ysArgs jy2struct.Args, isDryRun bool) error {
 	for outputFile, config := range files {
 		ysArgs.Format = "yaml"
 		ysArgs.InputFile = config.configFile
@@ -87,7 +94,7 @@ func runGenConfigCommand(files map[string]configType, ysArgs jy2struct.Args) err
 		if err != nil {
 			return err
 		}
-		err = saveFile(config.configFile, outputFile, startCode+structCodes)
+		err = saveFile(config.configFile, outputFile, startCode+structCodes, isDryRun)
 		if err != nil {
 			return err
 		}
@@ -150,7 +157,10 @@ func getYAMLFile(serverDir string) (map[string]configType, error) {
 	return files, nil
 }
 
-func saveFile(inputFile string, outputFile string, code string) error {
+func saveFile(inputFile string, outputFile string, code string, isDryRun bool) error {
+	if isDryRun {
+		return previewFile(outputFile, []byte(code))
+	}
 	err := os.WriteFile(outputFile, []byte(code), 0666)
 	if err != nil {
 		return err
@@ -159,7 +169,7 @@ func saveFile(inputFile string, outputFile string, code string) error {
 	return nil
 }
 
-func convertToGoFile(ysArgs jy2struct.Args, outPath string) error {
+func convertToGoFile(ysArgs jy2struct.Args, outPath string, isDryRun bool) error {
 	ysArgs.Name = "Config"
 	data, err := jy2struct.Covert(&ysArgs)
 	if err != nil {
@@ -178,13 +188,16 @@ func convertToGoFile(ysArgs jy2struct.Args, outPath string) error {
 		}
 		outPath += "/internal/config"
 	}
-	_ = os.MkdirAll(outPath, 0766)
 	name := gofile.GetFilenameWithoutSuffix(ysArgs.InputFile)
 
 	outPath += "/" + name + ".go"
 	if gofile.IsWindows() {
 		outPath = strings.ReplaceAll(outPath, "/", "\\")
 	}
+	if isDryRun {
+		return previewFile(outPath, []byte(configFileCode+data))
+	}
+	_ = os.MkdirAll(filepath.Dir(outPath), 0766)
 
 	err = os.WriteFile(outPath, []byte(configFileCode+data), 0666)
 	if err != nil {
@@ -195,3 +208,13 @@ func convertToGoFile(ysArgs jy2struct.Args, outPath string) error {
 
 	return nil
 }
+
+// print the file to be written and the diff against the existing file, no file is written
+func previewFile(outputFile string, data []byte) error {
+	change, err := replacer.CompareFile(outputFile, data)
+	if err != nil {
+		return err
+	}
+	printFileChanges(filepath.Dir(outputFile), []replacer.FileChange{change})
+	return nil
+}
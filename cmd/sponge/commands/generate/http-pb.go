@@ -19,6 +19,8 @@ func HTTPPbCommand() *cobra.Command {
 		repoAddr     string // image repo address
 		outPath      string // output directory
 		protobufFile string // protobuf file, support * matching
+		templateDir  string // directory of the user-supplied templates
+		isDryRun     bool   // only print the files to be generated and the diff
 	)
 
 	//nolint
@@ -31,7 +33,7 @@ Examples:
   # generate http service codes.
   sponge web http-pb --module-name=yourModuleName --server-name=yourServerName --project-name=yourProjectName --protobuf-file=./test.proto
 
-  # generate http service codes and specify the output directory, Note: code generation will be canceled when the latest generated file already exists.
+  # generate http service codes and specify the output directory, Note: the existing files are merged with the newly generated codes, the conflicts are marked in the files.
   sponge web http-pb --module-name=yourModuleName --server-name=yourServerName --project-name=yourProjectName --protobuf-file=./test.proto --out=./yourServerDir
 
   # generate http service codes and specify the docker image repository address.
@@ -40,7 +42,7 @@ Examples:
 		SilenceErrors: true,
 		SilenceUsage:  true,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runGenHTTPPbCommand(moduleName, serverName, projectName, protobufFile, repoAddr, outPath)
+			return runGenHTTPPbCommand(moduleName, serverName, projectName, protobufFile, repoAddr, outPath, templateDir, isDryRun)
 		},
 	}
 
@@ -55,11 +57,14 @@ Examples:
 
 	cmd.Flags().StringVarP(&repoAddr, "repo-addr", "r", "", "docker image repository address, excluding http and repository names")
 	cmd.Flags().StringVarP(&outPath, "out", "o", "", "output directory, default is ./serverName_http-pb_<time>")
+	cmd.Flags().StringVarP(&templateDir, "template-dir", "", "", "directory of the user-supplied templates that override the built-in templates with the same name, default is ~/.sponge/templates")
+	cmd.Flags().BoolVarP(&isDryRun, "dry-run", "", false, "print the files to be generated and the diff against the existing files, no files are written")
 
 	return cmd
 }
 
-func runGenHTTPPbCommand(moduleName string, serverName string, projectName string, protobufFile string, repoAddr string, outPath string) error {
+func runGenHTTPPbCommand(moduleName string, serverName string, projectName string, protobufFile string, repoAddr string, outPath string,
+	templateDir string, isDryRun bool) error {
 	protobufFiles, isImportTypes, err := parseProtobufFiles(protobufFile)
 	if err != nil {
 		return err
@@ -70,6 +75,7 @@ func runGenHTTPPbCommand(moduleName string, serverName string, projectName strin
 	if r == nil {
 		return errors.New("replacer is nil")
 	}
+	r.SetOverrideDir(getTemplateDir(templateDir))
 
 	// setting up template information
 	subDirs := []string{ // processing-only subdirectories
@@ -100,14 +106,15 @@ func runGenHTTPPbCommand(moduleName string, serverName string, projectName strin
 	fields := addHTTPPbFields(moduleName, serverName, projectName, repoAddr, r)
 	r.SetReplacementFields(fields)
 	_ = r.SetOutputDir(outPath, serverName+"_"+subTplName)
-	if err = r.SaveFiles(); err != nil {
+	if err = saveFiles(r, isDryRun); err != nil {
 		return err
 	}
 
-	_ = saveProtobufFiles(moduleName, serverName, r.GetOutputDir(), protobufFiles)
-	_ = saveGenInfo(moduleName, serverName, r.GetOutputDir())
-
-	fmt.Printf("generate %s's http server codes successfully, out = %s\n\n", serverName, r.GetOutputDir())
+	if !isDryRun {
+		_ = saveProtobufFiles(moduleName, serverName, r.GetOutputDir(), protobufFiles)
+		_ = saveGenInfo(moduleName, serverName, r.GetOutputDir())
+		fmt.Printf("generate %s's http server codes successfully, out = %s\n\n", serverName, r.GetOutputDir())
+	}
 
 	return nil
 }
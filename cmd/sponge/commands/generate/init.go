@@ -46,6 +46,8 @@ func Init(name string, filepath string) error {
 	if err != nil {
 		return err
 	}
+	// the user-supplied templates in the template directory are not the server template files
+	Replacers[name].SetOverrideDir(filepath + gofile.GetPathDelimiter() + defaultTemplateDirName)
 
 	return nil
 }
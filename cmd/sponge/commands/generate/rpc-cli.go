@@ -16,6 +16,8 @@ func RPCClientCommand() *cobra.Command {
 		moduleName     string // module name for go.mod
 		outPath        string // output directory
 		rpcServerNames string // rpc server names
+		templateDir    string // directory of the user-supplied templates
+		isDryRun       bool   // only print the files to be generated and the diff
 	)
 
 	cmd := &cobra.Command{
@@ -30,7 +32,7 @@ Examples:
   # generate rpc client codes with multiple names.
   sponge micro rpc-cli --module-name=yourModuleName --rpc-server-name=name1,name2
 
-  # generate rpc client codes and specify the server directory, Note: code generation will be canceled when the latest generated file already exists.
+  # generate rpc client codes and specify the server directory, Note: the existing files are merged with the newly generated codes, the conflicts are marked in the files.
   sponge micro rpc-cli --rpc-server-name=user --out=./yourServerDir
 `,
 		SilenceErrors: true,
@@ -49,7 +51,7 @@ Examples:
 					continue
 				}
 
-				dir, err := runGenRPCClientCommand(moduleName, rpcName, outPath)
+				dir, err := runGenRPCClientCommand(moduleName, rpcName, outPath, templateDir, isDryRun)
 				if err != nil {
 					return err
 				}
@@ -58,7 +60,9 @@ Examples:
 				}
 			}
 
-			fmt.Printf("generate 'rpc-cli' codes successfully, out = %s\n\n", outPath)
+			if !isDryRun {
+				fmt.Printf("generate 'rpc-cli' codes successfully, out = %s\n\n", outPath)
+			}
 			return nil
 		},
 	}
@@ -68,16 +72,19 @@ Examples:
 	_ = cmd.MarkFlagRequired("rpc-server-name")
 	cmd.Flags().StringVarP(&outPath, "out", "o", "", "output directory, default is ./rpc-cli_<time>,"+
 		" if you specify the directory where the http or rcp server generated by sponge, the module-name flag can be ignored")
+	cmd.Flags().StringVarP(&templateDir, "template-dir", "", "", "directory of the user-supplied templates that override the built-in templates with the same name, default is ~/.sponge/templates")
+	cmd.Flags().BoolVarP(&isDryRun, "dry-run", "", false, "print the files to be generated and the diff against the existing files, no files are written")
 
 	return cmd
 }
 
-func runGenRPCClientCommand(moduleName string, rpcName string, outPath string) (string, error) {
+func runGenRPCClientCommand(moduleName string, rpcName string, outPath string, templateDir string, isDryRun bool) (string, error) {
 	subTplName := "rpc-cli"
 	r := Replacers[TplNameSponge]
 	if r == nil {
 		return "", errors.New("replacer is nil")
 	}
+	r.SetOverrideDir(getTemplateDir(templateDir))
 
 	// setting up template information
 	subDirs := []string{ // only the specified subdirectory is processed, if empty or no subdirectory is specified, it means all files
@@ -94,7 +101,7 @@ func runGenRPCClientCommand(moduleName string, rpcName string, outPath string) (
 	fields := addRPCClientFields(moduleName, rpcName)
 	r.SetReplacementFields(fields)
 	_ = r.SetOutputDir(outPath, subTplName)
-	if err := r.SaveFiles(); err != nil {
+	if err := saveFiles(r, isDryRun); err != nil {
 		return "", err
 	}
 
@@ -19,6 +19,8 @@ func RPCGwPbCommand() *cobra.Command {
 		repoAddr     string // image repo address
 		outPath      string // output directory
 		protobufFile string // protobuf file, support * matching
+		templateDir  string // directory of the user-supplied templates
+		isDryRun     bool   // only print the files to be generated and the diff
 	)
 
 	//nolint
@@ -31,7 +33,7 @@ Examples:
   # generate rpc gateway service codes.
   sponge micro rpc-gw-pb --module-name=yourModuleName --server-name=yourServerName --project-name=yourProjectName --protobuf-file=./demo.proto
 
-  # generate rpc gateway service codes and specify the output directory, Note: code generation will be canceled when the latest generated file already exists.
+  # generate rpc gateway service codes and specify the output directory, Note: the existing files are merged with the newly generated codes, the conflicts are marked in the files.
   sponge micro rpc-gw-pb --module-name=yourModuleName --server-name=yourServerName --project-name=yourProjectName --protobuf-file=./demo.proto --out=./yourServerDir
 
   # generate rpc gateway service codes and specify the docker image repository address.
@@ -40,7 +42,7 @@ Examples:
 		SilenceErrors: true,
 		SilenceUsage:  true,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runGenRPCGwCommand(moduleName, serverName, projectName, protobufFile, repoAddr, outPath)
+			return runGenRPCGwCommand(moduleName, serverName, projectName, protobufFile, repoAddr, outPath, templateDir, isDryRun)
 		},
 	}
 
@@ -55,11 +57,14 @@ Examples:
 
 	cmd.Flags().StringVarP(&repoAddr, "repo-addr", "r", "", "docker image repository address, excluding http and repository names")
 	cmd.Flags().StringVarP(&outPath, "out", "o", "", "output directory, default is ./serverName_rpc-gw-pb_<time>")
+	cmd.Flags().StringVarP(&templateDir, "template-dir", "", "", "directory of the user-supplied templates that override the built-in templates with the same name, default is ~/.sponge/templates")
+	cmd.Flags().BoolVarP(&isDryRun, "dry-run", "", false, "print the files to be generated and the diff against the existing files, no files are written")
 
 	return cmd
 }
 
-func runGenRPCGwCommand(moduleName string, serverName string, projectName string, protobufFile string, repoAddr string, outPath string) error {
+func runGenRPCGwCommand(moduleName string, serverName string, projectName string, protobufFile string, repoAddr string, outPath string,
+	templateDir string, isDryRun bool) error {
 	protobufFiles, isImportTypes, err := parseProtobufFiles(protobufFile)
 	if err != nil {
 		return err
@@ -70,6 +75,7 @@ func runGenRPCGwCommand(moduleName string, serverName string, projectName string
 	if r == nil {
 		return errors.New("replacer is nil")
 	}
+	r.SetOverrideDir(getTemplateDir(templateDir))
 
 	// setting up template information
 	subDirs := []string{ // processing-only subdirectories
@@ -100,14 +106,15 @@ func runGenRPCGwCommand(moduleName string, serverName string, projectName string
 	fields := addRPCGwFields(moduleName, serverName, projectName, repoAddr, r)
 	r.SetReplacementFields(fields)
 	_ = r.SetOutputDir(outPath, serverName+"_"+subTplName)
-	if err = r.SaveFiles(); err != nil {
+	if err = saveFiles(r, isDryRun); err != nil {
 		return err
 	}
 
-	_ = saveProtobufFiles(moduleName, serverName, r.GetOutputDir(), protobufFiles)
-	_ = saveGenInfo(moduleName, serverName, r.GetOutputDir())
-
-	fmt.Printf("generate %s's rpc gateway service codes successfully, out = %s\n\n", serverName, r.GetOutputDir())
+	if !isDryRun {
+		_ = saveProtobufFiles(moduleName, serverName, r.GetOutputDir(), protobufFiles)
+		_ = saveGenInfo(moduleName, serverName, r.GetOutputDir())
+		fmt.Printf("generate %s's rpc gateway service codes successfully, out = %s\n\n", serverName, r.GetOutputDir())
+	}
 
 	return nil
 }
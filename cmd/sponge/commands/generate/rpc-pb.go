@@ -19,6 +19,8 @@ func RPCPbCommand() *cobra.Command {
 		repoAddr     string // image repo address
 		outPath      string // output directory
 		protobufFile string // protobuf file, support * matching
+		templateDir  string // directory of the user-supplied templates
+		isDryRun     bool   // only print the files to be generated and the diff
 	)
 
 	//nolint
@@ -31,7 +33,7 @@ Examples:
   # generate rpc service codes.
   sponge micro rpc-pb --module-name=yourModuleName --server-name=yourServerName --project-name=yourProjectName --protobuf-file=./demo.proto
 
-  # generate rpc service codes and specify the output directory, Note: code generation will be canceled when the latest generated file already exists.
+  # generate rpc service codes and specify the output directory, Note: the existing files are merged with the newly generated codes, the conflicts are marked in the files.
   sponge micro rpc-pb --module-name=yourModuleName --server-name=yourServerName --project-name=yourProjectName --protobuf-file=./demo.proto --out=./yourServerDir
 
   # generate rpc service codes and specify the docker image repository address.
@@ -40,7 +42,7 @@ Examples:
 		SilenceErrors: true,
 		SilenceUsage:  true,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runGenRPCPbCommand(moduleName, serverName, projectName, protobufFile, repoAddr, outPath)
+			return runGenRPCPbCommand(moduleName, serverName, projectName, protobufFile, repoAddr, outPath, templateDir, isDryRun)
 		},
 	}
 
@@ -54,11 +56,14 @@ Examples:
 	_ = cmd.MarkFlagRequired("protobuf-file")
 	cmd.Flags().StringVarP(&repoAddr, "repo-addr", "r", "", "docker image repository address, excluding http and repository names")
 	cmd.Flags().StringVarP(&outPath, "out", "o", "", "output directory, default is ./serverName_rpc-pb_<time>")
+	cmd.Flags().StringVarP(&templateDir, "template-dir", "", "", "directory of the user-supplied templates that override the built-in templates with the same name, default is ~/.sponge/templates")
+	cmd.Flags().BoolVarP(&isDryRun, "dry-run", "", false, "print the files to be generated and the diff against the existing files, no files are written")
 
 	return cmd
 }
 
-func runGenRPCPbCommand(moduleName string, serverName string, projectName string, protobufFile string, repoAddr string, outPath string) error {
+func runGenRPCPbCommand(moduleName string, serverName string, projectName string, protobufFile string, repoAddr string, outPath string,
+	templateDir string, isDryRun bool) error {
 	protobufFiles, isImportTypes, err := parseProtobufFiles(protobufFile)
 	if err != nil {
 		return err
@@ -69,6 +74,7 @@ func runGenRPCPbCommand(moduleName string, serverName string, projectName string
 	if r == nil {
 		return errors.New("replacer is nil")
 	}
+	r.SetOverrideDir(getTemplateDir(templateDir))
 
 	// setting up template information
 	subDirs := []string{ // processing-only subdirectories
@@ -98,14 +104,15 @@ func runGenRPCPbCommand(moduleName string, serverName string, projectName string
 	fields := addRPCPbFields(moduleName, serverName, projectName, repoAddr, r)
 	r.SetReplacementFields(fields)
 	_ = r.SetOutputDir(outPath, serverName+"_"+subTplName)
-	if err = r.SaveFiles(); err != nil {
+	if err = saveFiles(r, isDryRun); err != nil {
 		return err
 	}
 
-	_ = saveProtobufFiles(moduleName, serverName, r.GetOutputDir(), protobufFiles)
-	_ = saveGenInfo(moduleName, serverName, r.GetOutputDir())
-
-	fmt.Printf("generate %s's rpc server codes successfully, out = %s\n\n", serverName, r.GetOutputDir())
+	if !isDryRun {
+		_ = saveProtobufFiles(moduleName, serverName, r.GetOutputDir(), protobufFiles)
+		_ = saveGenInfo(moduleName, serverName, r.GetOutputDir())
+		fmt.Printf("generate %s's rpc server codes successfully, out = %s\n\n", serverName, r.GetOutputDir())
+	}
 
 	return nil
 }
@@ -0,0 +1,320 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/zhufuyi/sponge/pkg/mysql"
+	"github.com/zhufuyi/sponge/pkg/mysql/migrate"
+	"github.com/zhufuyi/sponge/pkg/sql2code/parser"
+
+	"github.com/spf13/cobra"
+)
+
+const defaultMigrationDir = "./migrations"
+
+// MigrateCommand schema migration commands
+func MigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "migrate",
+		Short:         "Generate and apply versioned mysql schema migrations",
+		Long:          "generate the migration files by comparing the models or ddl file with the database, and apply the migration files.",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	cmd.AddCommand(
+		migrateDiffCommand(),
+		migrateUpCommand(),
+		migrateDownCommand(),
+		migrateStatusCommand(),
+	)
+
+	return cmd
+}
+
+func migrateDiffCommand() *cobra.Command {
+	var (
+		dsn      string
+		ddlFile  string
+		modelDir string
+		dbTables string
+		dir      string
+		name     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Generate the migration files by comparing the models or ddl file with the database",
+		Long: `generate the up and down migration files by comparing the models or ddl file with the database.
+
+Examples:
+  # generate the migration files from the models in ./internal/model.
+  sponge migrate diff --db-dsn=root:123456@(192.168.3.37:3306)/test --name=add_user_email
+
+  # generate the migration files from the ddl file, only the specified tables are compared.
+  sponge migrate diff --db-dsn=root:123456@(192.168.3.37:3306)/test --ddl-file=./test.sql --db-table=user,order
+`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				target string
+				opts   []parser.Option
+			)
+			if ddlFile != "" {
+				data, err := os.ReadFile(ddlFile)
+				if err != nil {
+					return err
+				}
+				target = string(data)
+			} else {
+				var err error
+				target, err = modelsToDDL(modelDir)
+				if err != nil {
+					return fmt.Errorf("convert models to ddl error, %v", err)
+				}
+				// the models may not declare all the indexes
+				opts = append(opts, parser.WithKeepIndexes())
+			}
+			opts = append(opts, parser.WithTableNames(strings.Split(dbTables, ",")...))
+
+			current, err := getCurrentDDL(dsn, target, opts...)
+			if err != nil {
+				return err
+			}
+			up, down, err := parser.DiffSQL(current, target, opts...)
+			if err != nil {
+				return err
+			}
+			if len(up) == 0 {
+				fmt.Println("the database schema is up to date, no migration files are generated.")
+				return nil
+			}
+
+			upFile, downFile, err := migrate.Save(dir, name, joinStatements(up), joinStatements(down))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("generate migration files successfully, please check the statements before applying them.\n    %s\n    %s\n\n", upFile, downFile)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&dsn, "db-dsn", "d", "", "db content addr, e.g. user:password@(host:port)/database")
+	_ = cmd.MarkFlagRequired("db-dsn")
+	cmd.Flags().StringVarP(&ddlFile, "ddl-file", "f", "", "the target ddl file, used instead of the models")
+	cmd.Flags().StringVarP(&modelDir, "model-dir", "m", "./internal/model", "directory of the model structs")
+	cmd.Flags().StringVarP(&dbTables, "db-table", "t", "", "only compare the specified tables, multiple names separated by commas, default is all the tables of the models or ddl file")
+	cmd.Flags().StringVarP(&dir, "dir", "", defaultMigrationDir, "directory of the migration files")
+	cmd.Flags().StringVarP(&name, "name", "n", "update_schema", "name of the migration")
+
+	return cmd
+}
+
+func migrateUpCommand() *cobra.Command {
+	var (
+		dsn   string
+		dir   string
+		steps int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "up",
+		Short: "Apply the pending migrations",
+		Long: `apply the pending migrations in order.
+
+Examples:
+  # apply all the pending migrations.
+  sponge migrate up --db-dsn=root:123456@(192.168.3.37:3306)/test
+
+  # apply the next pending migration.
+  sponge migrate up --db-dsn=root:123456@(192.168.3.37:3306)/test --steps=1
+`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrator(dsn, dir, func(m *migrate.Migrator) error {
+				done, err := m.Up(context.Background(), steps)
+				printMigrations("applied", done)
+				return err
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&dsn, "db-dsn", "d", "", "db content addr, e.g. user:password@(host:port)/database")
+	_ = cmd.MarkFlagRequired("db-dsn")
+	cmd.Flags().StringVarP(&dir, "dir", "", defaultMigrationDir, "directory of the migration files")
+	cmd.Flags().IntVarP(&steps, "steps", "s", 0, "the number of migrations to be applied, 0 means all the pending migrations")
+
+	return cmd
+}
+
+func migrateDownCommand() *cobra.Command {
+	var (
+		dsn   string
+		dir   string
+		steps int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "down",
+		Short: "Roll back the applied migrations",
+		Long: `roll back the applied migrations in the reverse order.
+
+Examples:
+  # roll back the last applied migration.
+  sponge migrate down --db-dsn=root:123456@(192.168.3.37:3306)/test
+
+  # roll back all the applied migrations.
+  sponge migrate down --db-dsn=root:123456@(192.168.3.37:3306)/test --steps=0
+`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrator(dsn, dir, func(m *migrate.Migrator) error {
+				done, err := m.Down(context.Background(), steps)
+				printMigrations("rolled back", done)
+				return err
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&dsn, "db-dsn", "d", "", "db content addr, e.g. user:password@(host:port)/database")
+	_ = cmd.MarkFlagRequired("db-dsn")
+	cmd.Flags().StringVarP(&dir, "dir", "", defaultMigrationDir, "directory of the migration files")
+	cmd.Flags().IntVarP(&steps, "steps", "s", 1, "the number of migrations to be rolled back, 0 means all the applied migrations")
+
+	return cmd
+}
+
+func migrateStatusCommand() *cobra.Command {
+	var (
+		dsn string
+		dir string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the status of the migrations",
+		Long: `show the status of the migrations.
+
+Examples:
+  sponge migrate status --db-dsn=root:123456@(192.168.3.37:3306)/test
+`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrator(dsn, dir, func(m *migrate.Migrator) error {
+				statuses, err := m.Status(context.Background())
+				if err != nil {
+					return err
+				}
+				if len(statuses) == 0 {
+					fmt.Printf("no migrations found in %s\n", dir)
+					return nil
+				}
+				for _, s := range statuses {
+					status := "pending"
+					if s.AppliedAt != nil {
+						status = "applied at " + s.AppliedAt.Format("2006-01-02 15:04:05")
+					}
+					if s.IsMissing {
+						status += ", file not found"
+					}
+					fmt.Printf("    %d_%s    %s\n", s.Version, s.Name, status)
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&dsn, "db-dsn", "d", "", "db content addr, e.g. user:password@(host:port)/database")
+	_ = cmd.MarkFlagRequired("db-dsn")
+	cmd.Flags().StringVarP(&dir, "dir", "", defaultMigrationDir, "directory of the migration files")
+
+	return cmd
+}
+
+func runMigrator(dsn string, dir string, fn func(m *migrate.Migrator) error) error {
+	db, err := mysql.Init(withParseTime(dsn))
+	if err != nil {
+		return err
+	}
+	if sqlDB, e := db.DB(); e == nil {
+		defer sqlDB.Close() //nolint
+	}
+
+	return fn(migrate.New(db, dir))
+}
+
+func printMigrations(action string, migrations []*migrate.Migration) {
+	if len(migrations) == 0 {
+		fmt.Printf("no migrations are %s.\n", action)
+		return
+	}
+	for _, m := range migrations {
+		fmt.Printf("    %s %d_%s\n", action, m.Version, m.Name)
+	}
+}
+
+// get the ddl of the tables in the target that exist in the database
+func getCurrentDDL(dsn string, target string, opts ...parser.Option) (string, error) {
+	tables, err := listTables(dsn)
+	if err != nil {
+		return "", err
+	}
+
+	targetTables, err := parser.GetTableNames(target, opts...)
+	if err != nil {
+		return "", err
+	}
+	exists := make(map[string]bool, len(tables))
+	for _, table := range tables {
+		exists[table] = true
+	}
+
+	var ddl []string
+	for _, table := range targetTables {
+		if !exists[table] {
+			continue
+		}
+		info, err := parser.GetTableInfo(dsn, table)
+		if err != nil {
+			return "", err
+		}
+		ddl = append(ddl, info+";")
+	}
+	return strings.Join(ddl, "\n"), nil
+}
+
+func listTables(dsn string) ([]string, error) {
+	db, err := mysql.Init(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if sqlDB, e := db.DB(); e == nil {
+		defer sqlDB.Close() //nolint
+	}
+
+	var tables []string
+	err = db.Raw("show tables").Scan(&tables).Error
+	return tables, err
+}
+
+// the applied time of the migrations is scanned into time.Time
+func withParseTime(dsn string) string {
+	if strings.Contains(dsn, "parseTime=") {
+		return dsn
+	}
+	if strings.Contains(dsn, "?") {
+		return dsn + "&parseTime=true"
+	}
+	return dsn + "?parseTime=true"
+}
+
+func joinStatements(statements []string) string {
+	return strings.Join(statements, ";\n\n") + ";\n"
+}
@@ -0,0 +1,399 @@
+package commands
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm/schema"
+)
+
+// the same naming strategy as mysql.Init
+var modelNamer = schema.NamingStrategy{SingularTable: true}
+
+// the columns of the embedded mysql.Model and gorm.Model
+var embeddedModelColumns = []*modelColumn{
+	{Name: "id", Type: "bigint unsigned", NotNull: true, AutoIncrement: true, PrimaryKey: true},
+	{Name: "created_at", Type: "datetime"},
+	{Name: "updated_at", Type: "datetime"},
+	{Name: "deleted_at", Type: "datetime", Index: "deleted_at"},
+}
+
+// the column types of the go types that are not declared in the gorm tag
+var goTypeToColumnType = map[string]string{
+	"bool":            "tinyint(1)",
+	"int8":            "tinyint",
+	"int16":           "smallint",
+	"int32":           "int",
+	"int":             "bigint",
+	"int64":           "bigint",
+	"uint8":           "tinyint unsigned",
+	"uint16":          "smallint unsigned",
+	"uint32":          "int unsigned",
+	"uint":            "bigint unsigned",
+	"uint64":          "bigint unsigned",
+	"float32":         "float",
+	"float64":         "double",
+	"string":          "varchar(255)",
+	"[]byte":          "blob",
+	"time.Time":       "datetime",
+	"gorm.DeletedAt":  "datetime",
+	"sql.NullTime":    "datetime",
+	"sql.NullString":  "varchar(255)",
+	"sql.NullInt64":   "bigint",
+	"sql.NullInt32":   "int",
+	"sql.NullInt16":   "smallint",
+	"sql.NullBool":    "tinyint(1)",
+	"sql.NullFloat64": "double",
+	"datatypes.JSON":  "json",
+	"datatypes.Date":  "date",
+}
+
+var sqlNumberRegexp = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+
+type modelColumn struct {
+	Name          string
+	Type          string
+	NotNull       bool
+	AutoIncrement bool
+	PrimaryKey    bool
+	Default       string
+	Comment       string
+	Unique        bool
+	Index         string // name of the index, the columns with the same index name are in a composite index
+	UniqueIndex   string
+}
+
+type modelTable struct {
+	Name    string
+	Columns []*modelColumn
+}
+
+// convert the model structs in the directory to the CREATE TABLE statements, the structs without gorm tags are ignored,
+// the column types that are not declared in the gorm tag are the common mysql types of the go types.
+func modelsToDDL(dir string) (string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+
+	structs := make(map[string]*ast.StructType)
+	tableNames := make(map[string]string)
+	var structNames []string
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				switch d := decl.(type) {
+				case *ast.GenDecl:
+					for _, spec := range d.Specs {
+						if ts, ok := spec.(*ast.TypeSpec); ok {
+							if st, ok := ts.Type.(*ast.StructType); ok {
+								structs[ts.Name.Name] = st
+								structNames = append(structNames, ts.Name.Name)
+							}
+						}
+					}
+				case *ast.FuncDecl:
+					if name, value, ok := getTableNameMethod(d); ok {
+						tableNames[name] = value
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(structNames)
+
+	var tables []*modelTable
+	for _, name := range structNames {
+		if !isModelStruct(structs[name]) {
+			continue
+		}
+		table := &modelTable{Name: tableNames[name]}
+		if table.Name == "" {
+			table.Name = modelNamer.TableName(name)
+		}
+		table.Columns, err = getModelColumns(structs[name], structs)
+		if err != nil {
+			return "", fmt.Errorf("%s: %v", name, err)
+		}
+		tables = append(tables, table)
+	}
+
+	ddl := make([]string, 0, len(tables))
+	for _, table := range tables {
+		ddl = append(ddl, table.createSQL())
+	}
+	return strings.Join(ddl, "\n\n"), nil
+}
+
+// the TableName method that returns a string literal
+func getTableNameMethod(fn *ast.FuncDecl) (string, string, bool) {
+	if fn.Name.Name != "TableName" || fn.Recv == nil || len(fn.Recv.List) != 1 || fn.Body == nil || len(fn.Body.List) != 1 {
+		return "", "", false
+	}
+	recv := fn.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	ident, ok := recv.(*ast.Ident)
+	if !ok {
+		return "", "", false
+	}
+	ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return "", "", false
+	}
+	lit, ok := ret.Results[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", "", false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", "", false
+	}
+	return ident.Name, value, true
+}
+
+func isModelStruct(st *ast.StructType) bool {
+	for _, field := range st.Fields.List {
+		if isEmbeddedModel(field) {
+			return true
+		}
+		if field.Tag != nil && getGormTag(field) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func isEmbeddedModel(field *ast.Field) bool {
+	if len(field.Names) > 0 {
+		return false
+	}
+	typ := exprString(field.Type)
+	return typ == "mysql.Model" || typ == "gorm.Model"
+}
+
+func getGormTag(field *ast.Field) string {
+	if field.Tag == nil {
+		return ""
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return ""
+	}
+	return reflect.StructTag(tag).Get("gorm")
+}
+
+func getModelColumns(st *ast.StructType, structs map[string]*ast.StructType) ([]*modelColumn, error) {
+	var columns []*modelColumn
+	for _, field := range st.Fields.List {
+		if isEmbeddedModel(field) {
+			for _, col := range embeddedModelColumns {
+				c := *col
+				columns = append(columns, &c)
+			}
+			continue
+		}
+
+		settings := schema.ParseTagSetting(getGormTag(field), ";")
+		if _, ok := settings["-"]; ok {
+			continue
+		}
+		typ := exprString(field.Type)
+
+		// the fields of the embedded struct are the columns of the table
+		if embedded, ok := structs[typ]; ok && len(field.Names) == 0 {
+			cols, err := getModelColumns(embedded, structs)
+			if err != nil {
+				return nil, err
+			}
+			columns = append(columns, cols...)
+			continue
+		}
+
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+			col, err := getModelColumn(name.Name, typ, settings, structs)
+			if err != nil {
+				return nil, fmt.Errorf("field %s, %v", name.Name, err)
+			}
+			if col == nil {
+				continue
+			}
+			if col.Comment == "" && field.Comment != nil {
+				col.Comment = strings.TrimSpace(field.Comment.Text())
+			}
+			columns = append(columns, col)
+		}
+	}
+	return columns, nil
+}
+
+// return nil if the field is not a column, e.g. the associations
+func getModelColumn(fieldName string, typ string, settings map[string]string, structs map[string]*ast.StructType) (*modelColumn, error) {
+	col := &modelColumn{
+		Name:    settings["COLUMN"],
+		Type:    settings["TYPE"],
+		Default: settings["DEFAULT"],
+		Comment: settings["COMMENT"],
+	}
+	if col.Name == "" {
+		col.Name = modelNamer.ColumnName("", fieldName)
+	}
+
+	if col.Type == "" {
+		baseType := strings.TrimPrefix(typ, "*")
+		_, isStruct := structs[strings.TrimLeft(typ, "*[]")]
+		_, isForeignKey := settings["FOREIGNKEY"]
+		switch {
+		case settings["SERIALIZER"] == "json":
+			col.Type = "json"
+		case isStruct || isForeignKey:
+			return nil, nil // the associations are not columns
+		case baseType == "string" && settings["SIZE"] != "":
+			col.Type = "varchar(" + settings["SIZE"] + ")"
+		default:
+			col.Type = goTypeToColumnType[baseType]
+		}
+		if col.Type == "" {
+			return nil, fmt.Errorf("can't get the column type of go type %s, set the type in the gorm tag, e.g. type:varchar(20)", typ)
+		}
+	}
+
+	for key, value := range settings {
+		if value == key {
+			value = "" // the setting without value, e.g. index
+		}
+		switch key {
+		case "PRIMARY_KEY", "PRIMARYKEY":
+			col.PrimaryKey = true
+			col.NotNull = true
+		case "AUTO_INCREMENT", "AUTOINCREMENT":
+			col.AutoIncrement = true
+		case "NOT NULL", "NOTNULL":
+			col.NotNull = true
+		case "UNIQUE":
+			col.Unique = true
+		case "INDEX":
+			col.Index = getIndexName(value, col.Name)
+			if strings.Contains(strings.ToUpper(value), "UNIQUE") {
+				col.UniqueIndex, col.Index = col.Index, ""
+			}
+		case "UNIQUEINDEX", "UNIQUE_INDEX":
+			col.UniqueIndex = getIndexName(value, col.Name)
+		}
+	}
+
+	return col, nil
+}
+
+// the first option is the name of the index, e.g. index:idx_name,unique
+func getIndexName(value string, column string) string {
+	name := strings.TrimSpace(strings.Split(value, ",")[0])
+	if name == "" || strings.Contains(name, ":") || strings.EqualFold(name, "unique") {
+		return column // the table name is added when creating the table
+	}
+	return name
+}
+
+func (t *modelTable) createSQL() string {
+	var lines, primaryKeys, uniqueKeys []string
+	indexes := make(map[string][]string)
+	uniqueIndexes := make(map[string]bool)
+	var indexNames []string
+	addIndex := func(name string, column string, isUnique bool) {
+		if name == column {
+			name = modelNamer.IndexName(t.Name, column)
+		}
+		if _, ok := indexes[name]; !ok {
+			indexNames = append(indexNames, name)
+		}
+		indexes[name] = append(indexes[name], "`"+column+"`")
+		uniqueIndexes[name] = isUnique
+	}
+
+	for _, col := range t.Columns {
+		lines = append(lines, "  "+col.definition())
+		if col.PrimaryKey {
+			primaryKeys = append(primaryKeys, "`"+col.Name+"`")
+		}
+		if col.Index != "" {
+			addIndex(col.Index, col.Name, false)
+		}
+		if col.UniqueIndex != "" {
+			addIndex(col.UniqueIndex, col.Name, true)
+		}
+		if col.Unique {
+			uniqueKeys = append(uniqueKeys, "  UNIQUE KEY `"+col.Name+"` (`"+col.Name+"`)")
+		}
+	}
+	if len(primaryKeys) > 0 {
+		lines = append(lines, "  PRIMARY KEY ("+strings.Join(primaryKeys, ",")+")")
+	}
+	lines = append(lines, uniqueKeys...)
+	for _, name := range indexNames {
+		key := "KEY"
+		if uniqueIndexes[name] {
+			key = "UNIQUE KEY"
+		}
+		lines = append(lines, fmt.Sprintf("  %s `%s` (%s)", key, name, strings.Join(indexes[name], ",")))
+	}
+
+	return fmt.Sprintf("CREATE TABLE `%s` (\n%s\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;", t.Name, strings.Join(lines, ",\n"))
+}
+
+func (c *modelColumn) definition() string {
+	def := "`" + c.Name + "` " + c.Type
+	if c.NotNull {
+		def += " NOT NULL"
+	}
+	if c.AutoIncrement {
+		def += " AUTO_INCREMENT"
+	}
+	if c.Default != "" {
+		def += " DEFAULT " + sqlDefaultValue(c.Default)
+	}
+	if c.Comment != "" {
+		def += " COMMENT '" + strings.ReplaceAll(strings.ReplaceAll(c.Comment, `\`, `\\`), "'", "''") + "'"
+	}
+	return def
+}
+
+// the numbers, quoted strings, NULL and functions are kept, the other values are quoted
+func sqlDefaultValue(value string) string {
+	upper := strings.ToUpper(value)
+	if sqlNumberRegexp.MatchString(value) || strings.HasPrefix(value, "'") || upper == "NULL" ||
+		strings.HasPrefix(upper, "CURRENT_TIMESTAMP") || strings.HasSuffix(value, ")") {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+func exprString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		return exprString(t.X) + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + exprString(t.X)
+	case *ast.ArrayType:
+		return "[]" + exprString(t.Elt)
+	case *ast.MapType:
+		return "map[" + exprString(t.Key) + "]" + exprString(t.Value)
+	}
+	return ""
+}
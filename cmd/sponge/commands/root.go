@@ -30,6 +30,7 @@ func NewRootCMD() *cobra.Command {
 		ToolsCommand(),
 		NewWebCommand(),
 		MicroCommand(),
+		MigrateCommand(),
 		generate.ConfigCommand(),
 		NewRunCommand(),
 	)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"time"
@@ -60,7 +61,7 @@ func runUpgradeCommand() error {
 }
 
 // copy the template files to a temporary directory
-func copyToTempDir() (string, error) {
+func copyToTempDir() (_ string, err error) {
 	result, err := gobash.Exec("go", "env", "GOPATH")
 	if err != nil {
 		return "", fmt.Errorf("execute command failed, %v", err)
@@ -86,6 +87,25 @@ func copyToTempDir() (string, error) {
 	destDir := adaptPathDelimiter(GetSpongeDir() + "/")
 	targetDir := adaptPathDelimiter(destDir + ".sponge")
 
+	// keep the user-supplied templates, they are moved back on every exit path
+	templatesDir := adaptPathDelimiter(targetDir + "/templates")
+	tmpTemplatesDir := adaptPathDelimiter(destDir + ".sponge_templates")
+	err = recoverTemplates(tmpTemplatesDir, templatesDir)
+	if err != nil {
+		return "", err
+	}
+	if gofile.IsExists(templatesDir) {
+		err = os.Rename(templatesDir, tmpTemplatesDir)
+		if err != nil {
+			return "", fmt.Errorf("move '%s' error, %v", templatesDir, err)
+		}
+		defer func() {
+			if e := restoreTemplates(tmpTemplatesDir, templatesDir); e != nil && err == nil {
+				err = e
+			}
+		}()
+	}
+
 	err = executeCommand("rm", "-rf", targetDir)
 	if err != nil {
 		return "", err
@@ -111,6 +131,52 @@ func copyToTempDir() (string, error) {
 	return versionNum, nil
 }
 
+// move the templates back to the templates directory, its parent directory may have been removed if the upgrade failed
+func restoreTemplates(tmpDir string, templatesDir string) error {
+	err := os.MkdirAll(filepath.Dir(templatesDir), 0766)
+	if err != nil {
+		return fmt.Errorf("create '%s' error, %v", filepath.Dir(templatesDir), err)
+	}
+	err = os.Rename(tmpDir, templatesDir)
+	if err != nil {
+		return fmt.Errorf("move '%s' error, %v, the templates are kept in it", tmpDir, err)
+	}
+	return nil
+}
+
+// the templates left in the temporary directory by an earlier failed upgrade are moved back before upgrading,
+// the files that already exist in the templates directory are not overwritten
+func recoverTemplates(tmpDir string, templatesDir string) error {
+	if !gofile.IsExists(tmpDir) {
+		return nil
+	}
+	if !gofile.IsExists(templatesDir) {
+		return restoreTemplates(tmpDir, templatesDir)
+	}
+
+	err := filepath.Walk(tmpDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(tmpDir, path)
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(templatesDir, rel)
+		if gofile.IsExists(dst) {
+			return nil
+		}
+		if err = os.MkdirAll(filepath.Dir(dst), 0766); err != nil {
+			return err
+		}
+		return os.Rename(path, dst)
+	})
+	if err != nil {
+		return fmt.Errorf("merge '%s' into '%s' error, %v", tmpDir, templatesDir, err)
+	}
+	return os.RemoveAll(tmpDir)
+}
+
 func executeCommand(name string, args ...string) error {
 	ctx, _ := context.WithTimeout(context.Background(), time.Second*30) //nolint
 	result := gobash.Run(ctx, name, args...)
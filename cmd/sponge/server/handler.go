@@ -3,6 +3,7 @@ package server
 import (
 	"archive/zip"
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"io"
@@ -19,6 +20,7 @@ import (
 	"github.com/zhufuyi/sponge/pkg/gofile"
 	"github.com/zhufuyi/sponge/pkg/krand"
 	"github.com/zhufuyi/sponge/pkg/mysql"
+	"github.com/zhufuyi/sponge/pkg/sql2code/parser"
 
 	"github.com/gin-gonic/gin"
 )
@@ -29,7 +31,8 @@ var (
 )
 
 type mysqlForm struct {
-	Dsn string `json:"dsn" binding:"required"`
+	Dsn      string `json:"dsn" binding:"required"`
+	DBDriver string `json:"dbDriver"`
 }
 
 type kv struct {
@@ -46,14 +49,13 @@ func ListTables(c *gin.Context) {
 		return
 	}
 
-	db, err := mysql.Init(form.Dsn)
-	if err != nil {
-		response.Error(c, ecode.InternalServerError.WithDetails(err.Error()))
-		return
-	}
-
 	var tables []string
-	err = db.Raw("show tables").Scan(&tables).Error
+	switch form.DBDriver {
+	case parser.DBDriverPostgresql:
+		tables, err = listPostgresqlTables(form.Dsn)
+	default:
+		tables, err = listMysqlTables(form.Dsn)
+	}
 	if err != nil {
 		response.Error(c, ecode.InternalServerError.WithDetails(err.Error()))
 		return
@@ -70,6 +72,44 @@ func ListTables(c *gin.Context) {
 	response.Success(c, data)
 }
 
+func listMysqlTables(dsn string) ([]string, error) {
+	db, err := mysql.Init(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if sqlDB, e := db.DB(); e == nil {
+		defer sqlDB.Close() //nolint
+	}
+
+	var tables []string
+	err = db.Raw("show tables").Scan(&tables).Error
+	return tables, err
+}
+
+func listPostgresqlTables(dsn string) ([]string, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close() //nolint
+
+	rows, err := db.Query("SELECT tablename FROM pg_tables WHERE schemaname = current_schema() ORDER BY tablename")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err = rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	return tables, rows.Err()
+}
+
 // GenerateCodeForm generate code form
 type GenerateCodeForm struct {
 	Arg  string `json:"arg" binding:"required"`
@@ -92,20 +132,9 @@ func GenerateCode(c *gin.Context) {
 }
 
 func generateCode(c *gin.Context, path string, arg string) {
-	out := "-" + time.Now().Format("150405")
-	if len(path) > 1 {
-		if path[0] == '/' {
-			out = path[1:] + out
-		} else {
-			out = path + out
-		}
-	}
-
 	args := strings.Split(arg, " ")
 	params := parseCommandArgs(args)
-	if params.ModuleName != "" {
-		out = params.ModuleName + "-" + out
-	}
+	out := getOutDir(path, params)
 	args = append(args, fmt.Sprintf("--out=%s", out))
 
 	ctx, _ := context.WithTimeout(context.Background(), time.Second*10) // nolint
@@ -145,6 +174,60 @@ func generateCode(c *gin.Context, path string, arg string) {
 	}
 }
 
+// output directory of the generated code
+func getOutDir(path string, params *parameters) string {
+	out := "-" + time.Now().Format("150405")
+	if len(path) > 1 {
+		if path[0] == '/' {
+			out = path[1:] + out
+		} else {
+			out = path + out
+		}
+	}
+	if params.ModuleName != "" {
+		out = params.ModuleName + "-" + out
+	}
+	return out
+}
+
+type previewRespond struct {
+	Content string `json:"content"` // the file tree and the unified diff of the generated code
+}
+
+// PreviewCode preview the files of the generated code before downloading, no files are written,
+// the content is plain text, it must be rendered as escaped text by the web ui, not as html
+func PreviewCode(c *gin.Context) {
+	form := &GenerateCodeForm{}
+	err := c.ShouldBindJSON(form)
+	if err != nil {
+		response.Error(c, ecode.InvalidParams.WithDetails(err.Error()))
+		return
+	}
+
+	args := strings.Split(form.Arg, " ")
+	out := getOutDir(form.Path, parseCommandArgs(args))
+	args = append(args, fmt.Sprintf("--out=%s", out), "--dry-run")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	result := gobash.Run(ctx, "sponge", args...)
+	content := &strings.Builder{}
+	isFirstLine := true
+	for line := range result.StdOut {
+		if isFirstLine { // the first line is the command
+			isFirstLine = false
+			continue
+		}
+		content.WriteString(line)
+	}
+	if result.Err != nil {
+		response.Error(c, ecode.InternalServerError.WithDetails(result.Err.Error()))
+		return
+	}
+
+	response.Success(c, &previewRespond{Content: content.String()})
+}
+
 // GetRecord generate run command record
 func GetRecord(c *gin.Context) {
 	pathParam := c.Param("path")
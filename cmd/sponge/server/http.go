@@ -35,6 +35,7 @@ func NewRouter() *gin.Engine {
 
 	apiV1 := r.Group("/api/v1")
 	apiV1.POST("/generate", GenerateCode)
+	apiV1.POST("/preview", PreviewCode)
 	apiV1.POST("/uploadFiles", UploadFiles)
 	apiV1.POST("/listTables", ListTables)
 	apiV1.GET("/record/:path", GetRecord)
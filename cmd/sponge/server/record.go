@@ -25,6 +25,7 @@ type parameters struct {
 	RepoAddr      string `json:"repoAddr"`
 	ProtobufFile  string `json:"-"`
 	YamlFile      string `json:"-"`
+	DBDriver      string `json:"dbDriver"`
 	Dsn           string `json:"dsn"`
 	TableName     string `json:"tableName"`
 	Embed         bool   `json:"embed"`
@@ -112,6 +113,8 @@ func parseCommandArgs(args []string) *parameters {
 		} else {
 			val := ss[1]
 			switch ss[0] {
+			case "--db-driver":
+				params.DBDriver = val
 			case "--db-dsn":
 				params.Dsn = val
 			case "--db-table":
@@ -17,6 +17,38 @@ const docTemplate = `{
     "basePath": "{{.BasePath}}",
     "paths": {
         "/api/v1/userExample": {
+            "put": {
+                "description": "create userExample, or update all the fields of userExample if the unique key already exists",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "userExample"
+                ],
+                "summary": "create or update userExample",
+                "parameters": [
+                    {
+                        "description": "userExample information",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.CreateUserExampleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/types.Result"
+                        }
+                    }
+                }
+            },
             "post": {
                 "description": "submit information to create userExample",
                 "consumes": [
@@ -185,6 +217,40 @@ const docTemplate = `{
                 }
             }
         },
+        "/api/v1/userExamples/batch": {
+            "post": {
+                "description": "submit information to create multiple userExamples, the records are inserted in chunks",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "userExample"
+                ],
+                "summary": "create userExamples in batch",
+                "parameters": [
+                    {
+                        "description": "userExample information array",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.CreateUserExamplesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/types.Result"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/userExamples/delete/ids": {
             "post": {
                 "description": "delete userExamples by multiple id using a post request",
@@ -220,6 +286,38 @@ const docTemplate = `{
             }
         },
         "/api/v1/userExamples/ids": {
+            "put": {
+                "description": "update the same information of userExamples by multiple id, fields are updated with non-zero values",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "userExample"
+                ],
+                "summary": "update userExamples information by multiple id",
+                "parameters": [
+                    {
+                        "description": "id array and userExample information",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.UpdateUserExamplesByIDsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/types.Result"
+                        }
+                    }
+                }
+            },
             "post": {
                 "description": "get userExamples by multiple id using a post request",
                 "consumes": [
@@ -308,12 +406,19 @@ const docTemplate = `{
         "types.Column": {
             "type": "object",
             "properties": {
+                "columns": {
+                    "description": "nested conditions in parentheses, if not empty, the name, exp and value are ignored",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.Column"
+                    }
+                },
                 "exp": {
-                    "description": "expressions, which default to = when the value is null, have =, ! =, \u003e, \u003e=, \u003c, \u003c=, like",
+                    "description": "expressions, which default to = when the value is null, have =, ! =, \u003e, \u003e=, \u003c, \u003c=, like, prefix, suffix, in, notin, between, isnull, notnull",
                     "type": "string"
                 },
                 "logic": {
-                    "description": "logical type, defaults to and when value is null, only \u0026(and), ||(or)",
+                    "description": "logical type connecting with the next column, defaults to and when value is null, only \u0026(and), ||(or)",
                     "type": "string"
                 },
                 "name": {
@@ -321,7 +426,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "value": {
-                    "description": "column value"
+                    "description": "column value, the value of in, notin and between is an array or a string separated by commas"
                 }
             }
         },
@@ -362,6 +467,19 @@ const docTemplate = `{
                 }
             }
         },
+        "types.CreateUserExamplesRequest": {
+            "type": "object",
+            "properties": {
+                "userExamples": {
+                    "description": "userExample list",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/types.CreateUserExampleRequest"
+                    }
+                }
+            }
+        },
         "types.DeleteUserExamplesByIDsRequest": {
             "type": "object",
             "properties": {
@@ -398,6 +516,10 @@ const docTemplate = `{
                         "$ref": "#/definitions/types.Column"
                     }
                 },
+                "cursor": {
+                    "description": "the nextCursor returned by the previous page, if not empty, the records after the cursor are queried and page is ignored",
+                    "type": "string"
+                },
                 "page": {
                     "description": "page number, starting from page 0",
                     "type": "integer",
@@ -465,6 +587,51 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "types.UpdateUserExamplesByIDsRequest": {
+            "type": "object",
+            "properties": {
+                "age": {
+                    "description": "age",
+                    "type": "integer"
+                },
+                "avatar": {
+                    "description": "avatar",
+                    "type": "string"
+                },
+                "email": {
+                    "description": "email",
+                    "type": "string"
+                },
+                "gender": {
+                    "description": "gender, 1:Male, 2:Female, other values:unknown",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "id",
+                    "type": "integer"
+                },
+                "ids": {
+                    "description": "id list",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "name": {
+                    "description": "username",
+                    "type": "string"
+                },
+                "password": {
+                    "description": "password",
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "phone number",
+                    "type": "string"
+                }
+            }
         }
     }
 }`
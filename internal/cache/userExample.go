@@ -9,6 +9,8 @@ import (
 
 	"github.com/zhufuyi/sponge/pkg/cache"
 	"github.com/zhufuyi/sponge/pkg/encoding"
+	"github.com/zhufuyi/sponge/pkg/mysql"
+	"github.com/zhufuyi/sponge/pkg/tenant"
 	"github.com/zhufuyi/sponge/pkg/utils"
 
 	"github.com/spf13/cast"
@@ -27,13 +29,22 @@ type UserExampleCache interface {
 	Get(ctx context.Context, id uint64) (ret *model.UserExample, err error)
 	MultiGet(ctx context.Context, ids []uint64) (map[string]*model.UserExample, error)
 	MultiSet(ctx context.Context, data []*model.UserExample, duration time.Duration) error
-	Del(ctx context.Context, id uint64) error
+	Del(ctx context.Context, ids ...uint64) error
 	SetCacheWithNotFound(ctx context.Context, id uint64) error
+	SetWithSoftTTL(ctx context.Context, id uint64, data *model.UserExample, softTTL time.Duration, hardTTL time.Duration, delta time.Duration) error
+	MultiSetWithSoftTTL(ctx context.Context, data []*model.UserExample, softTTL time.Duration, hardTTL time.Duration, delta time.Duration) error
+	GetWithSoftTTL(ctx context.Context, id uint64) (*model.UserExample, *cache.SoftExpiration, error)
+
+	GetIndexIDs(ctx context.Context, indexKey string) ([]uint64, error)
+	SetIndexIDs(ctx context.Context, indexKey string, ids []uint64, duration time.Duration) error
+	DelIndexIDs(ctx context.Context, indexKeys ...string) error
+	SetIndexCacheWithNotFound(ctx context.Context, indexKey string) error
 }
 
 // userExampleCache define a cache struct
 type userExampleCache struct {
-	cache cache.Cache
+	cache    cache.Cache
+	isTenant bool // the table has the tenant_id column, the keys are separated by the tenant id in the context
 }
 
 // NewUserExampleCache new a cache
@@ -41,24 +52,43 @@ func NewUserExampleCache(cacheType *model.CacheType) UserExampleCache {
 	jsonEncoding := encoding.JSONEncoding{}
 	cachePrefix := ""
 	var c cache.Cache
-	if strings.ToLower(cacheType.CType) == "redis" {
+	switch strings.ToLower(cacheType.CType) {
+	case "redis":
 		c = cache.NewRedisCache(cacheType.Rdb, cachePrefix, jsonEncoding, func() interface{} {
 			return &model.UserExample{}
 		})
-	} else {
+	case "multi":
+		c = cache.NewMultiLevelCache(cacheType.Rdb, cachePrefix, jsonEncoding, func() interface{} {
+			return &model.UserExample{}
+		}, cache.WithLocalExpiration(cacheType.LocalExpiration))
+	default:
 		c = cache.NewMemoryCache(cachePrefix, jsonEncoding, func() interface{} {
 			return &model.UserExample{}
 		})
 	}
 
 	return &userExampleCache{
-		cache: c,
+		// the metrics are labeled by the key prefix of the table
+		cache:    cache.NewObservedCache(c, strings.TrimSuffix(PrefixUserExampleCacheKey, ":"), cacheType.ObserveOptions...),
+		isTenant: mysql.IsTenantTable(&model.UserExample{}),
 	}
 }
 
-// GetUserExampleCacheKey cache key
-func (c *userExampleCache) GetUserExampleCacheKey(id uint64) string {
-	return PrefixUserExampleCacheKey + utils.Uint64ToStr(id)
+// GetUserExampleCacheKey cache key, it is prefixed with the tenant id in the context if the table is isolated by tenant
+func (c *userExampleCache) GetUserExampleCacheKey(ctx context.Context, id uint64) string {
+	return c.tenantKey(ctx, PrefixUserExampleCacheKey+utils.Uint64ToStr(id))
+}
+
+// GetUserExampleIndexCacheKey cache key of the index, the value of the key is the ids of the records
+func (c *userExampleCache) GetUserExampleIndexCacheKey(ctx context.Context, indexKey string) string {
+	return c.tenantKey(ctx, PrefixUserExampleCacheKey+"index:"+indexKey)
+}
+
+func (c *userExampleCache) tenantKey(ctx context.Context, key string) string {
+	if c.isTenant {
+		return tenant.Key(ctx, key)
+	}
+	return key
 }
 
 // Set write to cache
@@ -66,7 +96,7 @@ func (c *userExampleCache) Set(ctx context.Context, id uint64, data *model.UserE
 	if data == nil || id == 0 {
 		return nil
 	}
-	cacheKey := c.GetUserExampleCacheKey(id)
+	cacheKey := c.GetUserExampleCacheKey(ctx, id)
 	err := c.cache.Set(ctx, cacheKey, data, duration)
 	if err != nil {
 		return err
@@ -77,7 +107,7 @@ func (c *userExampleCache) Set(ctx context.Context, id uint64, data *model.UserE
 // Get cache value
 func (c *userExampleCache) Get(ctx context.Context, id uint64) (*model.UserExample, error) {
 	var data *model.UserExample
-	cacheKey := c.GetUserExampleCacheKey(id)
+	cacheKey := c.GetUserExampleCacheKey(ctx, id)
 	err := c.cache.Get(ctx, cacheKey, &data)
 	if err != nil {
 		return nil, err
@@ -89,7 +119,7 @@ func (c *userExampleCache) Get(ctx context.Context, id uint64) (*model.UserExamp
 func (c *userExampleCache) MultiSet(ctx context.Context, data []*model.UserExample, duration time.Duration) error {
 	valMap := make(map[string]interface{})
 	for _, v := range data {
-		cacheKey := c.GetUserExampleCacheKey(v.ID)
+		cacheKey := c.GetUserExampleCacheKey(ctx, v.ID)
 		valMap[cacheKey] = v
 	}
 
@@ -105,7 +135,7 @@ func (c *userExampleCache) MultiSet(ctx context.Context, data []*model.UserExamp
 func (c *userExampleCache) MultiGet(ctx context.Context, ids []uint64) (map[string]*model.UserExample, error) {
 	var keys []string
 	for _, v := range ids {
-		cacheKey := c.GetUserExampleCacheKey(v)
+		cacheKey := c.GetUserExampleCacheKey(ctx, v)
 		keys = append(keys, cacheKey)
 	}
 
@@ -117,7 +147,7 @@ func (c *userExampleCache) MultiGet(ctx context.Context, ids []uint64) (map[stri
 
 	retMap := make(map[string]*model.UserExample)
 	for _, v := range ids {
-		val, ok := itemMap[c.GetUserExampleCacheKey(v)]
+		val, ok := itemMap[c.GetUserExampleCacheKey(ctx, v)]
 		if ok {
 			retMap[cast.ToString(v)] = val
 		}
@@ -126,10 +156,16 @@ func (c *userExampleCache) MultiGet(ctx context.Context, ids []uint64) (map[stri
 	return retMap, nil
 }
 
-// Del delete cache
-func (c *userExampleCache) Del(ctx context.Context, id uint64) error {
-	cacheKey := c.GetUserExampleCacheKey(id)
-	err := c.cache.Del(ctx, cacheKey)
+// Del delete the cache of multiple ids at once
+func (c *userExampleCache) Del(ctx context.Context, ids ...uint64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	cacheKeys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		cacheKeys = append(cacheKeys, c.GetUserExampleCacheKey(ctx, id))
+	}
+	err := c.cache.Del(ctx, cacheKeys...)
 	if err != nil {
 		return err
 	}
@@ -138,7 +174,110 @@ func (c *userExampleCache) Del(ctx context.Context, id uint64) error {
 
 // SetCacheWithNotFound set empty cache
 func (c *userExampleCache) SetCacheWithNotFound(ctx context.Context, id uint64) error {
-	cacheKey := c.GetUserExampleCacheKey(id)
+	cacheKey := c.GetUserExampleCacheKey(ctx, id)
+	err := c.cache.SetCacheWithNotFound(ctx, cacheKey)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetWithSoftTTL write to cache with the soft expiration, the data is stale after softTTL and deleted after hardTTL
+func (c *userExampleCache) SetWithSoftTTL(ctx context.Context, id uint64, data *model.UserExample, softTTL time.Duration, hardTTL time.Duration, delta time.Duration) error {
+	sc, ok := c.cache.(cache.SoftCache)
+	if !ok {
+		return c.Set(ctx, id, data, hardTTL)
+	}
+	if data == nil || id == 0 {
+		return nil
+	}
+	cacheKey := c.GetUserExampleCacheKey(ctx, id)
+	err := sc.SetWithSoftTTL(ctx, cacheKey, data, softTTL, hardTTL, delta)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// MultiSetWithSoftTTL multiple set cache with the soft expiration
+func (c *userExampleCache) MultiSetWithSoftTTL(ctx context.Context, data []*model.UserExample, softTTL time.Duration, hardTTL time.Duration, delta time.Duration) error {
+	sc, ok := c.cache.(cache.SoftCache)
+	if !ok {
+		return c.MultiSet(ctx, data, hardTTL)
+	}
+	valMap := make(map[string]interface{})
+	for _, v := range data {
+		cacheKey := c.GetUserExampleCacheKey(ctx, v.ID)
+		valMap[cacheKey] = v
+	}
+
+	err := sc.MultiSetWithSoftTTL(ctx, valMap, softTTL, hardTTL, delta)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetWithSoftTTL get cache value and the soft expiration, the soft expiration is nil if the value is set without soft ttl
+func (c *userExampleCache) GetWithSoftTTL(ctx context.Context, id uint64) (*model.UserExample, *cache.SoftExpiration, error) {
+	sc, ok := c.cache.(cache.SoftCache)
+	if !ok {
+		data, err := c.Get(ctx, id)
+		return data, nil, err
+	}
+	var data *model.UserExample
+	cacheKey := c.GetUserExampleCacheKey(ctx, id)
+	softExpiration, err := sc.GetWithSoftTTL(ctx, cacheKey, &data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, softExpiration, nil
+}
+
+// GetIndexIDs get the ids of the records from the index cache
+func (c *userExampleCache) GetIndexIDs(ctx context.Context, indexKey string) ([]uint64, error) {
+	var ids []uint64
+	cacheKey := c.GetUserExampleIndexCacheKey(ctx, indexKey)
+	err := c.cache.Get(ctx, cacheKey, &ids)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// SetIndexIDs write the ids of the records to the index cache
+func (c *userExampleCache) SetIndexIDs(ctx context.Context, indexKey string, ids []uint64, duration time.Duration) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	cacheKey := c.GetUserExampleIndexCacheKey(ctx, indexKey)
+	err := c.cache.Set(ctx, cacheKey, &ids, duration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DelIndexIDs delete index cache
+func (c *userExampleCache) DelIndexIDs(ctx context.Context, indexKeys ...string) error {
+	if len(indexKeys) == 0 {
+		return nil
+	}
+	cacheKeys := make([]string, 0, len(indexKeys))
+	for _, indexKey := range indexKeys {
+		cacheKeys = append(cacheKeys, c.GetUserExampleIndexCacheKey(ctx, indexKey))
+	}
+	err := c.cache.Del(ctx, cacheKeys...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetIndexCacheWithNotFound set empty index cache
+func (c *userExampleCache) SetIndexCacheWithNotFound(ctx context.Context, indexKey string) error {
+	cacheKey := c.GetUserExampleIndexCacheKey(ctx, indexKey)
 	err := c.cache.SetCacheWithNotFound(ctx, cacheKey)
 	if err != nil {
 		return err
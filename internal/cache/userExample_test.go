@@ -1,12 +1,14 @@
 package cache
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/zhufuyi/sponge/internal/model"
 
 	"github.com/zhufuyi/sponge/pkg/gotest"
+	"github.com/zhufuyi/sponge/pkg/tenant"
 	"github.com/zhufuyi/sponge/pkg/utils"
 
 	"github.com/stretchr/testify/assert"
@@ -115,6 +117,26 @@ func Test_userExampleCache_Del(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	// delete multiple ids at once
+	var ids []uint64
+	for _, data := range c.TestDataSlice {
+		record := data.(*model.UserExample)
+		ids = append(ids, record.ID)
+		err = c.ICache.(UserExampleCache).Set(c.Ctx, record.ID, record, time.Hour)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = c.ICache.(UserExampleCache).Del(c.Ctx, ids...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range ids {
+		_, err = c.ICache.(UserExampleCache).Get(c.Ctx, id)
+		assert.Error(t, err)
+	}
+	assert.NoError(t, c.ICache.(UserExampleCache).Del(c.Ctx))
 }
 
 func Test_userExampleCache_SetCacheWithNotFound(t *testing.T) {
@@ -128,10 +150,96 @@ func Test_userExampleCache_SetCacheWithNotFound(t *testing.T) {
 	}
 }
 
+func Test_userExampleCache_SoftTTL(t *testing.T) {
+	c := newUserExampleCache()
+	defer c.Close()
+
+	record := c.TestDataSlice[0].(*model.UserExample)
+	err := c.ICache.(UserExampleCache).SetWithSoftTTL(c.Ctx, record.ID, record, time.Minute, time.Hour, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, softExpiration, err := c.ICache.(UserExampleCache).GetWithSoftTTL(c.Ctx, record.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, record.ID, got.ID)
+	assert.False(t, softExpiration.IsStale(0))
+
+	var records []*model.UserExample
+	for _, data := range c.TestDataSlice {
+		records = append(records, data.(*model.UserExample))
+	}
+	err = c.ICache.(UserExampleCache).MultiSetWithSoftTTL(c.Ctx, records, -time.Second, time.Hour, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, softExpiration, err = c.ICache.(UserExampleCache).GetWithSoftTTL(c.Ctx, record.ID)
+	assert.NoError(t, err)
+	assert.True(t, softExpiration.IsStale(0))
+}
+
+func Test_userExampleCache_IndexIDs(t *testing.T) {
+	c := newUserExampleCache()
+	defer c.Close()
+
+	record := c.TestDataSlice[0].(*model.UserExample)
+	indexKey := "email:foo@bar.com"
+	err := c.ICache.(UserExampleCache).SetIndexIDs(c.Ctx, indexKey, []uint64{record.ID}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids, err := c.ICache.(UserExampleCache).GetIndexIDs(c.Ctx, indexKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []uint64{record.ID}, ids)
+
+	err = c.ICache.(UserExampleCache).DelIndexIDs(c.Ctx, indexKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.ICache.(UserExampleCache).GetIndexIDs(c.Ctx, indexKey)
+	assert.Error(t, err)
+
+	err = c.ICache.(UserExampleCache).SetIndexCacheWithNotFound(c.Ctx, indexKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.ICache.(UserExampleCache).GetIndexIDs(c.Ctx, indexKey)
+	assert.Error(t, err)
+
+	// empty ids are not cached
+	err = c.ICache.(UserExampleCache).SetIndexIDs(c.Ctx, indexKey, nil, time.Hour)
+	assert.NoError(t, err)
+}
+
 func TestNewUserExampleCache(t *testing.T) {
 	c := NewUserExampleCache(&model.CacheType{
 		CType: "memory",
 	})
 
 	assert.NotNil(t, c)
+
+	gc := gotest.NewCache(nil)
+	defer gc.Close()
+	c = NewUserExampleCache(&model.CacheType{
+		CType:           "multi",
+		Rdb:             gc.RedisClient,
+		LocalExpiration: time.Minute,
+	})
+	assert.NotNil(t, c)
+}
+
+func Test_userExampleCache_tenantKey(t *testing.T) {
+	ctx := tenant.NewContext(context.Background(), "t1")
+
+	c := &userExampleCache{isTenant: true}
+	assert.Equal(t, "t1:"+PrefixUserExampleCacheKey+"1", c.GetUserExampleCacheKey(ctx, 1))
+	assert.Equal(t, "t1:"+PrefixUserExampleCacheKey+"index:foo", c.GetUserExampleIndexCacheKey(ctx, "foo"))
+
+	// the table is not isolated by tenant
+	c = &userExampleCache{}
+	assert.Equal(t, PrefixUserExampleCacheKey+"1", c.GetUserExampleCacheKey(ctx, 1))
 }
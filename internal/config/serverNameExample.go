@@ -30,17 +30,19 @@ func Set(conf *Config) {
 }
 
 type Config struct {
-	App        App          `yaml:"app" json:"app"`
-	Consul     Consul       `yaml:"consul" json:"consul"`
-	Etcd       Etcd         `yaml:"etcd" json:"etcd"`
-	Grpc       Grpc         `yaml:"grpc" json:"grpc"`
-	GrpcClient []GrpcClient `yaml:"grpcClient" json:"grpcClient"`
-	HTTP       HTTP         `yaml:"http" json:"http"`
-	Jaeger     Jaeger       `yaml:"jaeger" json:"jaeger"`
-	Logger     Logger       `yaml:"logger" json:"logger"`
-	Mysql      Mysql        `yaml:"mysql" json:"mysql"`
-	NacosRd    NacosRd      `yaml:"nacosRd" json:"nacosRd"`
-	Redis      Redis        `yaml:"redis" json:"redis"`
+	App        App              `yaml:"app" json:"app"`
+	Consul     Consul           `yaml:"consul" json:"consul"`
+	Etcd       Etcd             `yaml:"etcd" json:"etcd"`
+	Grpc       Grpc             `yaml:"grpc" json:"grpc"`
+	GrpcClient []GrpcClient     `yaml:"grpcClient" json:"grpcClient"`
+	HTTP       HTTP             `yaml:"http" json:"http"`
+	Jaeger     Jaeger           `yaml:"jaeger" json:"jaeger"`
+	Logger     Logger           `yaml:"logger" json:"logger"`
+	Mysql      Mysql            `yaml:"mysql" json:"mysql"`
+	NacosRd    NacosRd          `yaml:"nacosRd" json:"nacosRd"`
+	NamedMysql map[string]Mysql `yaml:"namedMysql" json:"namedMysql"`
+	NamedRedis map[string]Redis `yaml:"namedRedis" json:"namedRedis"`
+	Redis      Redis            `yaml:"redis" json:"redis"`
 }
 
 type Consul struct {
@@ -63,9 +65,11 @@ type App struct {
 	EnableMetrics         bool    `yaml:"enableMetrics" json:"enableMetrics"`
 	EnableHTTPProfile     bool    `yaml:"enableHTTPProfile" json:"enableHTTPProfile"`
 	EnableStat            bool    `yaml:"enableStat" json:"enableStat"`
+	EnableTenant          bool    `yaml:"enableTenant" json:"enableTenant"`
 	EnableTrace           bool    `yaml:"enableTrace" json:"enableTrace"`
 	Env                   string  `yaml:"env" json:"env"`
 	Host                  string  `yaml:"host" json:"host"`
+	LocalCacheExpiration  int     `yaml:"localCacheExpiration" json:"localCacheExpiration"`
 	Name                  string  `yaml:"name" json:"name"`
 	RegistryDiscoveryType string  `yaml:"registryDiscoveryType" json:"registryDiscoveryType"`
 	TracingSamplingRate   float64 `yaml:"tracingSamplingRate" json:"tracingSamplingRate"`
@@ -73,12 +77,17 @@ type App struct {
 }
 
 type Mysql struct {
-	ConnMaxLifetime int    `yaml:"connMaxLifetime" json:"connMaxLifetime"`
-	Dsn             string `yaml:"dsn" json:"dsn"`
-	EnableLog       bool   `yaml:"enableLog" json:"enableLog"`
-	MaxIdleConns    int    `yaml:"maxIdleConns" json:"maxIdleConns"`
-	MaxOpenConns    int    `yaml:"maxOpenConns" json:"maxOpenConns"`
-	SlowThreshold   int    `yaml:"slowThreshold" json:"slowThreshold"`
+	ConnMaxLifetime int      `yaml:"connMaxLifetime" json:"connMaxLifetime"`
+	CursorSecret    string   `yaml:"cursorSecret" json:"cursorSecret"`
+	Dsn             string   `yaml:"dsn" json:"dsn"`
+	EnableLog       bool     `yaml:"enableLog" json:"enableLog"`
+	EnableMigration bool     `yaml:"enableMigration" json:"enableMigration"`
+	MaxIdleConns    int      `yaml:"maxIdleConns" json:"maxIdleConns"`
+	MaxOpenConns    int      `yaml:"maxOpenConns" json:"maxOpenConns"`
+	MigrationDir    string   `yaml:"migrationDir" json:"migrationDir"`
+	ReplicaPolicy   string   `yaml:"replicaPolicy" json:"replicaPolicy"`
+	Replicas        []string `yaml:"replicas" json:"replicas"`
+	SlowThreshold   int      `yaml:"slowThreshold" json:"slowThreshold"`
 }
 
 type Redis struct {
@@ -10,79 +10,297 @@ import (
 	"github.com/zhufuyi/sponge/internal/model"
 
 	cacheBase "github.com/zhufuyi/sponge/pkg/cache"
+	"github.com/zhufuyi/sponge/pkg/mysql"
 	"github.com/zhufuyi/sponge/pkg/mysql/query"
+	"github.com/zhufuyi/sponge/pkg/tenant"
 	"github.com/zhufuyi/sponge/pkg/utils"
 
 	"github.com/spf13/cast"
 	"golang.org/x/sync/singleflight"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var _ UserExampleDao = (*userExampleDao)(nil)
 
+// UserExampleDBName the name of the database connection used by the dao, it is the key of namedMysql in the config,
+// if empty, the default mysql is used, get the connection by model.GetDB(UserExampleDBName)
+const UserExampleDBName = ""
+
 // UserExampleDao defining the dao interface
 type UserExampleDao interface {
 	Create(ctx context.Context, table *model.UserExample) error
+	CreateBatch(ctx context.Context, tables []*model.UserExample) error
+	Upsert(ctx context.Context, table *model.UserExample) error
 	DeleteByID(ctx context.Context, id uint64) error
 	DeleteByIDs(ctx context.Context, ids []uint64) error
 	UpdateByID(ctx context.Context, table *model.UserExample) error
+	UpdateByIDs(ctx context.Context, ids []uint64, table *model.UserExample) error
 	GetByID(ctx context.Context, id uint64) (*model.UserExample, error)
 	GetByIDs(ctx context.Context, ids []uint64) ([]*model.UserExample, error)
-	GetByColumns(ctx context.Context, params *query.Params) ([]*model.UserExample, int64, error)
+	GetByIDWithAssociations(ctx context.Context, id uint64, associations ...string) (*model.UserExample, error)
+	GetByColumns(ctx context.Context, params *query.Params) ([]*model.UserExample, int64, string, error)
+	// todo generate the dao index methods interface code to here
+	// delete the templates index code start
+	Restore(ctx context.Context, ids []uint64) error
+	HardDelete(ctx context.Context, ids []uint64) error
+	GetByEmail(ctx context.Context, email string) (*model.UserExample, error)
+	// delete the templates index code end
 }
 
 type userExampleDao struct {
 	db    *gorm.DB
 	cache cache.UserExampleCache
 	sfg   *singleflight.Group
+
+	isTenant bool // the table has the tenant_id column, the cache keys are prefixed with the tenant id
+
+	softTTL time.Duration // if greater than 0, the stale record is returned and refreshed in the background
+	hardTTL time.Duration
+	beta    float64 // if greater than 0, the record is refreshed before the soft ttl by XFetch
+}
+
+// UserExampleDaoOption set the options of the dao
+type UserExampleDaoOption func(*userExampleDao)
+
+// WithUserExampleCacheTTL set the soft and hard ttl of the cache, the record read after the soft ttl is returned
+// and refreshed by one request in the background, the cache is deleted after the hard ttl, if softTTL is 0,
+// the record is not refreshed until the hard ttl, default hardTTL is 24 hours.
+func WithUserExampleCacheTTL(softTTL time.Duration, hardTTL time.Duration) UserExampleDaoOption {
+	return func(d *userExampleDao) {
+		d.softTTL = softTTL
+		if hardTTL > 0 {
+			d.hardTTL = hardTTL
+		}
+	}
+}
+
+// WithUserExampleEarlyRefresh refresh the record before the soft ttl by the probabilistic early expiration (XFetch),
+// the larger the beta, the earlier the refresh, 1.0 is the recommended beta, it takes effect if the soft ttl is set.
+func WithUserExampleEarlyRefresh(beta float64) UserExampleDaoOption {
+	return func(d *userExampleDao) {
+		d.beta = beta
+	}
+}
+
+// NewUserExampleDao creating the dao interface, the methods take part in the transaction of
+// the ctx started by mysql.WithTx, and the cache is deleted after the transaction is committed.
+func NewUserExampleDao(db *gorm.DB, cache cache.UserExampleCache, opts ...UserExampleDaoOption) UserExampleDao {
+	d := &userExampleDao{
+		db:       db,
+		cache:    cache,
+		sfg:      new(singleflight.Group),
+		isTenant: mysql.IsTenantTable(&model.UserExample{}),
+		hardTTL:  cacheBase.DefaultExpireTime,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// the database handle of the ctx, the data is isolated by the tenant id in the ctx if the table has the tenant_id column
+func (d *userExampleDao) getDB(ctx context.Context) *gorm.DB {
+	return mysql.GetDB(ctx, d.db).Scopes(mysql.TenantScope)
+}
+
+// delete the cache of the records and their index cache, the cache keys of the tenant table are prefixed with
+// the tenant id in the ctx, the ctx bypassing the tenant scope has no tenant id, so the cache is deleted by the
+// tenant id of each record, it is called after the transaction is committed
+func (d *userExampleDao) deleteCache(ctx context.Context, tables ...*model.UserExample) {
+	groups := map[string][]*model.UserExample{"": tables}
+	if d.isTenant && tenant.IsBypass(ctx) {
+		groups = map[string][]*model.UserExample{}
+		for _, table := range tables {
+			tenantID := mysql.GetTenantID(table)
+			groups[tenantID] = append(groups[tenantID], table)
+		}
+	}
+	for tenantID, records := range groups {
+		tenantCtx := ctx
+		if tenantID != "" {
+			tenantCtx = tenant.NewContext(ctx, tenantID)
+		}
+		ids := make([]uint64, 0, len(records))
+		for _, record := range records {
+			ids = append(ids, record.ID)
+		}
+		_ = d.cache.Del(tenantCtx, ids...)
+		d.deleteIndexCache(tenantCtx, records...)
+	}
+}
+
+// get the records of ids before they are deleted, the tenant ids of the records are needed to delete the cache
+// if the ctx bypasses the tenant scope, nil is returned otherwise
+func (d *userExampleDao) getBypassRecords(ctx context.Context, ids ...uint64) ([]*model.UserExample, error) {
+	if !d.isTenant || !tenant.IsBypass(ctx) {
+		return nil, nil
+	}
+	records := []*model.UserExample{}
+	// read from the primary, the replicas may not have the modification yet
+	err := d.getDB(mysql.WithPrimary(ctx)).Unscoped().Where("id IN (?)", ids).Find(&records).Error
+	return records, err
 }
 
-// NewUserExampleDao creating the dao interface
-func NewUserExampleDao(db *gorm.DB, cache cache.UserExampleCache) UserExampleDao {
-	return &userExampleDao{db: db, cache: cache, sfg: new(singleflight.Group)}
+// delete the cache of the deleted records, records are got by getBypassRecords before deleting
+func (d *userExampleDao) deleteRecordCache(ctx context.Context, records []*model.UserExample, ids ...uint64) {
+	if records != nil {
+		d.deleteCache(ctx, records...)
+		return
+	}
+	_ = d.cache.Del(ctx, ids...)
+}
+
+// delete the cache of the updated records, the index cache is also deleted because the index columns may have
+// been modified, it is deferred until the transaction is committed
+func (d *userExampleDao) deleteCacheByIDs(ctx context.Context, ids ...uint64) {
+	if d.isTenant && tenant.IsBypass(ctx) {
+		records, err := d.getBypassRecords(ctx, ids...)
+		if err != nil {
+			return
+		}
+		mysql.AfterCommit(ctx, d.db, func() {
+			d.deleteCache(ctx, records...)
+		})
+		return
+	}
+	mysql.AfterCommit(ctx, d.db, func() {
+		_ = d.cache.Del(ctx, ids...)
+	})
+	d.deleteIndexCacheByIDs(ctx, ids...)
 }
 
 // Create a record, insert the record and the id value is written back to the table
 func (d *userExampleDao) Create(ctx context.Context, table *model.UserExample) error {
-	err := d.db.WithContext(ctx).Create(table).Error
-	_ = d.cache.Del(ctx, table.ID)
-	return err
+	err := d.getDB(ctx).Create(table).Error
+	if err != nil {
+		return err
+	}
+
+	// delete cache, it is deferred until the transaction is committed
+	mysql.AfterCommit(ctx, d.db, func() {
+		d.deleteCache(ctx, table)
+	})
+
+	return nil
+}
+
+// CreateBatch create multiple records in chunks of mysql.DefaultBatchSize, the id values are written back to the tables
+func (d *userExampleDao) CreateBatch(ctx context.Context, tables []*model.UserExample) error {
+	if len(tables) == 0 {
+		return nil
+	}
+	err := mysql.CreateBatch(ctx, d.getDB(ctx), tables, mysql.DefaultBatchSize)
+	if err != nil {
+		return err
+	}
+
+	// delete cache, it is deferred until the transaction is committed
+	mysql.AfterCommit(ctx, d.db, func() {
+		d.deleteCache(ctx, tables...)
+	})
+
+	return nil
+}
+
+// Upsert create a record, or update all the columns of the record if a record with the same unique key already exists,
+// the id value of the created or updated record is written back to the table
+func (d *userExampleDao) Upsert(ctx context.Context, table *model.UserExample) error {
+	err := mysql.Upsert(ctx, d.getDB(ctx), table, userExampleConflictColumns...)
+	if err != nil {
+		return err
+	}
+
+	// delete cache, it is deferred until the transaction is committed
+	mysql.AfterCommit(ctx, d.db, func() {
+		d.deleteCache(ctx, table)
+	})
+
+	return nil
 }
 
 // DeleteByID delete a record based on id
 func (d *userExampleDao) DeleteByID(ctx context.Context, id uint64) error {
-	err := d.db.WithContext(ctx).Where("id = ?", id).Delete(&model.UserExample{}).Error
+	records, err := d.getBypassRecords(ctx, id)
+	if err != nil {
+		return err
+	}
+	err = d.getDB(ctx).Where("id = ?", id).Delete(&model.UserExample{}).Error
 	if err != nil {
 		return err
 	}
 
-	// delete cache
-	_ = d.cache.Del(ctx, id)
+	// delete cache, it is deferred until the transaction is committed
+	mysql.AfterCommit(ctx, d.db, func() {
+		d.deleteRecordCache(ctx, records, id)
+	})
 
 	return nil
 }
 
 // DeleteByIDs batch delete multiple records
 func (d *userExampleDao) DeleteByIDs(ctx context.Context, ids []uint64) error {
-	err := d.db.WithContext(ctx).Where("id IN (?)", ids).Delete(&model.UserExample{}).Error
+	records, err := d.getBypassRecords(ctx, ids...)
 	if err != nil {
 		return err
 	}
-
-	// delete cache
-	for _, id := range ids {
-		_ = d.cache.Del(ctx, id)
+	err = d.getDB(ctx).Where("id IN (?)", ids).Delete(&model.UserExample{}).Error
+	if err != nil {
+		return err
 	}
 
+	// delete cache, it is deferred until the transaction is committed
+	mysql.AfterCommit(ctx, d.db, func() {
+		d.deleteRecordCache(ctx, records, ids...)
+	})
+
 	return nil
 }
 
-// UpdateByID update records by id
+// UpdateByID update records by id, if the table has a version column, the record is updated only if
+// the version has not been modified since it was read, otherwise model.ErrVersionConflict is returned,
+// or model.ErrRecordNotFound if the record does not exist
 func (d *userExampleDao) UpdateByID(ctx context.Context, table *model.UserExample) error {
 	if table.ID < 1 {
 		return errors.New("id cannot be 0")
 	}
 
+	update := d.getUpdateFields(table)
+
+	err := mysql.UpdateByID(ctx, d.getDB(ctx), table, update)
+	if err != nil {
+		return err
+	}
+
+	// delete cache, it is deferred until the transaction is committed
+	d.deleteCacheByIDs(ctx, table.ID)
+
+	return nil
+}
+
+// UpdateByIDs update the non-zero fields of the table to the records of multiple ids
+func (d *userExampleDao) UpdateByIDs(ctx context.Context, ids []uint64, table *model.UserExample) error {
+	if len(ids) == 0 {
+		return errors.New("ids cannot be empty")
+	}
+	update := d.getUpdateFields(table)
+	if len(update) == 0 {
+		return errors.New("no fields to update")
+	}
+
+	err := mysql.UpdateByIDs(ctx, d.getDB(ctx), &model.UserExample{}, ids, update)
+	if err != nil {
+		return err
+	}
+
+	// delete cache, it is deferred until the transaction is committed
+	d.deleteCacheByIDs(ctx, ids...)
+
+	return nil
+}
+
+// the columns and values of the non-zero fields of the table
+func (d *userExampleDao) getUpdateFields(table *model.UserExample) map[string]interface{} {
 	update := map[string]interface{}{}
 	// todo generate the update fields code to here
 	// delete the templates code start
@@ -112,46 +330,38 @@ func (d *userExampleDao) UpdateByID(ctx context.Context, table *model.UserExampl
 	}
 	// delete the templates code end
 
-	err := d.db.WithContext(ctx).Model(table).Updates(update).Error
-	if err != nil {
-		return err
-	}
-
-	// delete cache
-	_ = d.cache.Del(ctx, table.ID)
-
-	return nil
+	return update
 }
 
 // GetByID get a record based on id
 func (d *userExampleDao) GetByID(ctx context.Context, id uint64) (*model.UserExample, error) {
-	record, err := d.cache.Get(ctx, id)
+	// the uncommitted data in the transaction and the data of all tenants are not cached
+	if mysql.InTx(ctx, d.db) || tenant.IsBypass(ctx) {
+		table := &model.UserExample{}
+		err := d.getDB(ctx).Where("id = ?", id).First(table).Error
+		if err != nil {
+			return nil, err
+		}
+		return table, nil
+	}
+
+	record, softExpiration, err := d.cache.GetWithSoftTTL(ctx, id)
 	if err == nil {
+		if softExpiration.IsStale(d.beta) {
+			// the stale record is returned, only one request refreshes it in the background
+			cacheBase.RefreshAsync(ctx, tenant.Key(ctx, cache.PrefixUserExampleCacheKey+utils.Uint64ToStr(id)), func(ctx context.Context) {
+				_, _, _ = d.sfg.Do(tenant.Key(ctx, utils.Uint64ToStr(id)), func() (interface{}, error) {
+					return d.loadByID(ctx, id)
+				})
+			})
+		}
 		return record, nil
 	}
 
 	if errors.Is(err, model.ErrCacheNotFound) {
 		// for the same id, prevent high concurrent simultaneous access to mysql
-		val, err, _ := d.sfg.Do(utils.Uint64ToStr(id), func() (interface{}, error) { //nolint
-			table := &model.UserExample{}
-			err = d.db.WithContext(ctx).Where("id = ?", id).First(table).Error
-			if err != nil {
-				// if data is empty, set not found cache to prevent cache penetration, default expiration time 10 minutes
-				if errors.Is(err, model.ErrRecordNotFound) {
-					err = d.cache.SetCacheWithNotFound(ctx, id)
-					if err != nil {
-						return nil, err
-					}
-					return nil, model.ErrRecordNotFound
-				}
-				return nil, err
-			}
-			// set cache
-			err = d.cache.Set(ctx, id, table, cacheBase.DefaultExpireTime)
-			if err != nil {
-				return nil, fmt.Errorf("cache.Set error: %v, id=%d", err, id)
-			}
-			return table, nil
+		val, err, _ := d.sfg.Do(tenant.Key(ctx, utils.Uint64ToStr(id)), func() (interface{}, error) { //nolint
+			return d.loadByID(ctx, id)
 		})
 		if err != nil {
 			return nil, err
@@ -169,10 +379,58 @@ func (d *userExampleDao) GetByID(ctx context.Context, id uint64) (*model.UserExa
 	return nil, err
 }
 
+// get the record from mysql and set it to the cache
+func (d *userExampleDao) loadByID(ctx context.Context, id uint64) (*model.UserExample, error) {
+	start := time.Now()
+	table := &model.UserExample{}
+	err := d.getDB(ctx).Where("id = ?", id).First(table).Error
+	if err != nil {
+		// if data is empty, set not found cache to prevent cache penetration, default expiration time 10 minutes
+		if errors.Is(err, model.ErrRecordNotFound) {
+			err = d.cache.SetCacheWithNotFound(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return nil, model.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	// set cache
+	err = d.setCache(ctx, time.Since(start), table)
+	if err != nil {
+		return nil, fmt.Errorf("cache.Set error: %v, id=%d", err, id)
+	}
+	return table, nil
+}
+
+// set the cache of the records with the soft ttl if it is set, delta is the time spent loading the records
+func (d *userExampleDao) setCache(ctx context.Context, delta time.Duration, tables ...*model.UserExample) error {
+	if len(tables) == 1 {
+		if d.softTTL > 0 {
+			return d.cache.SetWithSoftTTL(ctx, tables[0].ID, tables[0], d.softTTL, d.hardTTL, delta)
+		}
+		return d.cache.Set(ctx, tables[0].ID, tables[0], d.hardTTL)
+	}
+
+	if d.softTTL > 0 {
+		return d.cache.MultiSetWithSoftTTL(ctx, tables, d.softTTL, d.hardTTL, delta)
+	}
+	return d.cache.MultiSet(ctx, tables, d.hardTTL)
+}
+
 // GetByIDs get multiple rows by ids
 func (d *userExampleDao) GetByIDs(ctx context.Context, ids []uint64) ([]*model.UserExample, error) {
 	records := []*model.UserExample{}
 
+	// the uncommitted data in the transaction and the data of all tenants are not cached
+	if mysql.InTx(ctx, d.db) || tenant.IsBypass(ctx) {
+		err := d.getDB(ctx).Where("id IN (?)", ids).Find(&records).Error
+		if err != nil {
+			return nil, err
+		}
+		return records, nil
+	}
+
 	itemMap, err := d.cache.MultiGet(ctx, ids)
 	if err != nil {
 		return nil, err
@@ -202,15 +460,16 @@ func (d *userExampleDao) GetByIDs(ctx context.Context, ids []uint64) ([]*model.U
 		}
 
 		if len(realMissedIDs) > 0 {
+			start := time.Now()
 			var missedData []*model.UserExample
-			err = d.db.WithContext(ctx).Where("id IN (?)", realMissedIDs).Find(&missedData).Error
+			err = d.getDB(ctx).Where("id IN (?)", realMissedIDs).Find(&missedData).Error
 			if err != nil {
 				return nil, err
 			}
 
 			if len(missedData) > 0 {
 				records = append(records, missedData...)
-				err = d.cache.MultiSet(ctx, missedData, time.Hour*24)
+				err = d.setCache(ctx, time.Since(start), missedData...)
 				if err != nil {
 					return nil, err
 				}
@@ -224,6 +483,27 @@ func (d *userExampleDao) GetByIDs(ctx context.Context, ids []uint64) ([]*model.U
 	return records, nil
 }
 
+// GetByIDWithAssociations get a record based on id and preload the associations, the record is not cached,
+// if the associations is empty, all the associations of the record are preloaded.
+func (d *userExampleDao) GetByIDWithAssociations(ctx context.Context, id uint64, associations ...string) (*model.UserExample, error) {
+	db := d.getDB(ctx)
+	if len(associations) == 0 {
+		db = db.Preload(clause.Associations)
+	} else {
+		for _, association := range associations {
+			db = db.Preload(association)
+		}
+	}
+
+	table := &model.UserExample{}
+	err := db.Where("id = ?", id).First(table).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return table, nil
+}
+
 // GetByColumns filter multiple rows based on paging and column information
 //
 // params includes paging parameters and query parameters
@@ -232,13 +512,15 @@ func (d *userExampleDao) GetByIDs(ctx context.Context, ids []uint64) ([]*model.U
 //	page: page number, starting from 0
 //	size: lines per page
 //	sort: sort fields, default is id backwards, you can add - sign before the field to indicate reverse order, no - sign to indicate ascending order, multiple fields separated by comma
+//	cursor: the nextCursor returned by the previous page, if not empty, the records after the cursor are queried and page is ignored
 //
-// query parameters (not required):
+// query parameters (not required), only the columns in model.UserExampleColumns can be filtered and sorted:
 //
 //	name: column name
-//	exp: expressions, which default to = when the value is null, have =, ! =, >, >=, <, <=, like
-//	value: column name
-//	logic: logical type, defaults to and when value is null, only &(and), ||(or)
+//	exp: expressions, which default to = when the value is null, have =, ! =, >, >=, <, <=, like, prefix, suffix, in, notin, between, isnull, notnull
+//	value: column value, the value of in, notin and between is an array or a string separated by commas
+//	logic: logical type connecting with the next column, defaults to and when value is null, only &(and), ||(or)
+//	columns: nested conditions in parentheses, if not empty, the name, exp and value are ignored
 //
 // example: search for a male over 20 years of age
 //
@@ -256,29 +538,188 @@ func (d *userExampleDao) GetByIDs(ctx context.Context, ids []uint64) ([]*model.U
 //			Value: "male",
 //		},
 //	}
-func (d *userExampleDao) GetByColumns(ctx context.Context, params *query.Params) ([]*model.UserExample, int64, error) {
+//
+// the nextCursor is returned when the page is full, it is used as the cursor parameter to query the next page.
+func (d *userExampleDao) GetByColumns(ctx context.Context, params *query.Params) ([]*model.UserExample, int64, string, error) {
+	isCount := params.Sort != "ignore count" // determine if count is required
+	if !isCount {
+		params.Sort = ""
+	}
+	// only the columns in the allow-list can be filtered and sorted, and the values are converted to the column types
+	err := params.CheckColumns(model.UserExampleColumns)
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("query params error: %w", err)
+	}
 	queryStr, args, err := params.ConvertToGormConditions()
 	if err != nil {
-		return nil, 0, errors.New("query params error: " + err.Error())
+		return nil, 0, "", fmt.Errorf("query params error: %w", &query.ParamsError{Field: "columns", Reason: err.Error()})
+	}
+	cursorStr, cursorArgs, err := params.ConvertToCursor()
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("query params error: %w", &query.ParamsError{Field: "cursor", Reason: err.Error()})
 	}
 
 	var total int64
-	if params.Sort != "ignore count" { // determine if count is required
-		err = d.db.WithContext(ctx).Model(&model.UserExample{}).Select([]string{"id"}).Where(queryStr, args...).Count(&total).Error
+	if isCount {
+		err = d.getDB(ctx).Model(&model.UserExample{}).Select([]string{"id"}).Where(queryStr, args...).Count(&total).Error
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, "", err
 		}
 		if total == 0 {
-			return nil, total, nil
+			return nil, total, "", nil
 		}
 	}
 
 	records := []*model.UserExample{}
-	order, limit, offset := params.ConvertToPage()
-	err = d.db.WithContext(ctx).Order(order).Limit(limit).Offset(offset).Where(queryStr, args...).Find(&records).Error
+	order, limit, offset := params.ConvertToCursorPage()
+	db := d.getDB(ctx).Order(order).Limit(limit).Where(queryStr, args...)
+	if cursorStr != "" {
+		db = db.Where(cursorStr, cursorArgs...)
+	} else {
+		db = db.Offset(offset)
+	}
+	err = db.Find(&records).Error
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
-	return records, total, err
+	var nextCursor string
+	if len(records) > 0 && len(records) == limit {
+		nextCursor, err = params.NextCursor(records[len(records)-1])
+		if err != nil {
+			return nil, 0, "", err
+		}
+	}
+
+	return records, total, nextCursor, nil
 }
+
+// todo generate the dao index methods code to here
+// delete the templates index code start 2
+
+// the columns of the unique key that determine whether the record exists in Upsert, the primary key is used if empty
+var userExampleConflictColumns = []string{"email"}
+
+// Restore restore the soft deleted records by ids
+func (d *userExampleDao) Restore(ctx context.Context, ids []uint64) error {
+	err := mysql.Restore(ctx, d.getDB(ctx), &model.UserExample{}, ids)
+	if err != nil {
+		return err
+	}
+
+	// delete the not found cache of the records, it is deferred until the transaction is committed
+	d.deleteCacheByIDs(ctx, ids...)
+
+	return nil
+}
+
+// HardDelete delete the records by ids permanently, including the soft deleted records
+func (d *userExampleDao) HardDelete(ctx context.Context, ids []uint64) error {
+	records, err := d.getBypassRecords(ctx, ids...)
+	if err != nil {
+		return err
+	}
+	err = mysql.HardDelete(ctx, d.getDB(ctx), &model.UserExample{}, ids)
+	if err != nil {
+		return err
+	}
+
+	// delete cache, it is deferred until the transaction is committed
+	mysql.AfterCommit(ctx, d.db, func() {
+		d.deleteRecordCache(ctx, records, ids...)
+	})
+
+	return nil
+}
+
+// GetByEmail get a record based on the unique index email
+func (d *userExampleDao) GetByEmail(ctx context.Context, email string) (*model.UserExample, error) {
+	// the uncommitted data in the transaction and the data of all tenants are not cached
+	if mysql.InTx(ctx, d.db) || tenant.IsBypass(ctx) {
+		table := &model.UserExample{}
+		err := d.getDB(ctx).Where("email = ?", email).First(table).Error
+		if err != nil {
+			return nil, err
+		}
+		return table, nil
+	}
+
+	indexKey := "email:" + cast.ToString(email)
+	ids, err := d.cache.GetIndexIDs(ctx, indexKey)
+	if err == nil && len(ids) > 0 {
+		record, err := d.GetByID(ctx, ids[0])
+		if err == nil && record.Email == email {
+			return record, nil
+		}
+		// the record has been deleted or the index column has been modified
+		_ = d.cache.DelIndexIDs(ctx, indexKey)
+	} else if errors.Is(err, cacheBase.ErrPlaceholder) {
+		return nil, model.ErrRecordNotFound
+	} else if err != nil && !errors.Is(err, model.ErrCacheNotFound) {
+		// fail fast, if cache error return, don't request to db
+		return nil, err
+	}
+
+	// for the same index value, prevent high concurrent simultaneous access to mysql
+	val, err, _ := d.sfg.Do(tenant.Key(ctx, indexKey), func() (interface{}, error) { //nolint
+		start := time.Now()
+		table := &model.UserExample{}
+		err = d.getDB(ctx).Where("email = ?", email).First(table).Error
+		if err != nil {
+			// if data is empty, set not found cache to prevent cache penetration
+			if errors.Is(err, model.ErrRecordNotFound) {
+				err = d.cache.SetIndexCacheWithNotFound(ctx, indexKey)
+				if err != nil {
+					return nil, err
+				}
+				return nil, model.ErrRecordNotFound
+			}
+			return nil, err
+		}
+		// set cache
+		err = d.cache.SetIndexIDs(ctx, indexKey, []uint64{table.ID}, d.hardTTL)
+		if err != nil {
+			return nil, fmt.Errorf("cache.SetIndexIDs error: %v, index=%s", err, indexKey)
+		}
+		err = d.setCache(ctx, time.Since(start), table)
+		if err != nil {
+			return nil, fmt.Errorf("cache.Set error: %v, id=%d", err, table.ID)
+		}
+		return table, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	table, ok := val.(*model.UserExample)
+	if !ok {
+		return nil, model.ErrRecordNotFound
+	}
+	return table, nil
+}
+
+// delete the index cache of the records, the stale not found cache of the index values is also cleared,
+// it is called after the transaction is committed
+func (d *userExampleDao) deleteIndexCache(ctx context.Context, tables ...*model.UserExample) {
+	indexKeys := make([]string, 0, len(tables))
+	for _, table := range tables {
+		indexKeys = append(indexKeys,
+			"email:"+cast.ToString(table.Email),
+		)
+	}
+	_ = d.cache.DelIndexIDs(ctx, indexKeys...)
+}
+
+// delete the index cache of the records whose index columns may have been modified
+func (d *userExampleDao) deleteIndexCacheByIDs(ctx context.Context, ids ...uint64) {
+	tables := []*model.UserExample{}
+	// read from the primary, the replicas may not have the modification yet
+	err := d.getDB(mysql.WithPrimary(ctx)).Where("id IN (?)", ids).Find(&tables).Error
+	if err != nil || len(tables) == 0 {
+		return
+	}
+	mysql.AfterCommit(ctx, d.db, func() {
+		d.deleteIndexCache(ctx, tables...)
+	})
+}
+
+// delete the templates index code end 2
@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/zhufuyi/sponge/internal/model"
 
 	"github.com/zhufuyi/sponge/pkg/gotest"
+	"github.com/zhufuyi/sponge/pkg/mysql"
 	"github.com/zhufuyi/sponge/pkg/mysql/query"
 	"github.com/zhufuyi/sponge/pkg/utils"
 
@@ -53,6 +55,71 @@ func Test_userExampleDao_Create(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	// the insert failed, the cache is not deleted
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").
+		WillReturnError(errors.New("duplicate entry"))
+	d.SQLMock.ExpectRollback()
+	err = d.IDao.(UserExampleDao).Create(d.Ctx, &model.UserExample{})
+	assert.Error(t, err)
+}
+
+func Test_userExampleDao_CreateBatch(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	tables := []*model.UserExample{{}, {}}
+
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	d.SQLMock.ExpectCommit()
+
+	err := d.IDao.(UserExampleDao).CreateBatch(d.Ctx, tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, uint64(1), tables[0].ID)
+	assert.Equal(t, uint64(2), tables[1].ID)
+
+	err = d.IDao.(UserExampleDao).CreateBatch(d.Ctx, nil)
+	assert.NoError(t, err)
+}
+
+func Test_userExampleDao_Upsert(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	testData := d.TestData.(*model.UserExample)
+	iCache := d.Cache.ICache.(cache.UserExampleCache)
+	err := iCache.Set(d.Ctx, testData.ID, testData, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the record is created
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .* ON DUPLICATE KEY UPDATE .*").
+		WillReturnResult(sqlmock.NewResult(int64(testData.ID), 1))
+	d.SQLMock.ExpectCommit()
+
+	err = d.IDao.(UserExampleDao).Upsert(d.Ctx, testData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = iCache.Get(d.Ctx, testData.ID)
+	assert.ErrorIs(t, err, model.ErrCacheNotFound)
+
+	// upsert error
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").WillReturnError(errors.New("mock error"))
+	d.SQLMock.ExpectRollback()
+	err = d.IDao.(UserExampleDao).Upsert(d.Ctx, &model.UserExample{})
+	assert.Error(t, err)
+
+	err = d.SQLMock.ExpectationsWereMet()
+	if err != nil {
+		t.Fatal(err)
+	}
 }
 
 func Test_userExampleDao_DeleteByID(t *testing.T) {
@@ -145,6 +212,47 @@ func Test_userExampleDao_UpdateByID(t *testing.T) {
 	// delete the templates code end
 }
 
+func Test_userExampleDao_UpdateByIDs(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	testData := d.TestData.(*model.UserExample)
+
+	// empty ids error
+	err := d.IDao.(UserExampleDao).UpdateByIDs(d.Ctx, nil, testData)
+	assert.Error(t, err)
+	// no fields error
+	err = d.IDao.(UserExampleDao).UpdateByIDs(d.Ctx, []uint64{testData.ID}, &model.UserExample{})
+	assert.Error(t, err)
+	// delete the templates code start 2
+	iCache := d.Cache.ICache.(cache.UserExampleCache)
+	err = iCache.Set(d.Ctx, testData.ID, testData, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .* WHERE id IN .*").
+		WithArgs(10, d.AnyTime, testData.ID, 2).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	d.SQLMock.ExpectCommit()
+	d.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs(testData.ID, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(testData.ID, "foo@bar.com").AddRow(2, "bar@bar.com"))
+
+	err = d.IDao.(UserExampleDao).UpdateByIDs(d.Ctx, []uint64{testData.ID, 2}, &model.UserExample{Age: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = iCache.Get(d.Ctx, testData.ID)
+	assert.ErrorIs(t, err, model.ErrCacheNotFound)
+
+	err = d.SQLMock.ExpectationsWereMet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// delete the templates code end 2
+}
+
 func Test_userExampleDao_GetByID(t *testing.T) {
 	d := newUserExampleDao()
 	defer d.Close()
@@ -181,6 +289,59 @@ func Test_userExampleDao_GetByID(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func Test_userExampleDao_GetByIDWithSoftTTL(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	testData := d.TestData.(*model.UserExample)
+	iDao := NewUserExampleDao(d.DB, d.Cache.ICache.(cache.UserExampleCache),
+		WithUserExampleCacheTTL(time.Millisecond*100, time.Hour),
+		WithUserExampleEarlyRefresh(1),
+	)
+
+	updatedAt := testData.UpdatedAt.Add(time.Hour)
+	d.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs(testData.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "updated_at"}).AddRow(testData.ID, testData.UpdatedAt))
+	record, err := iDao.GetByID(d.Ctx, testData.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, testData.UpdatedAt.Equal(record.UpdatedAt))
+
+	// get from cache before the soft ttl
+	record, err = iDao.GetByID(d.Ctx, testData.ID)
+	assert.NoError(t, err)
+	assert.True(t, testData.UpdatedAt.Equal(record.UpdatedAt))
+	err = d.SQLMock.ExpectationsWereMet()
+	assert.NoError(t, err)
+
+	// the stale record is returned and refreshed in the background
+	time.Sleep(time.Millisecond * 150)
+	d.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs(testData.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "updated_at"}).AddRow(testData.ID, updatedAt))
+	record, err = iDao.GetByID(d.Ctx, testData.ID)
+	assert.NoError(t, err)
+	assert.True(t, testData.UpdatedAt.Equal(record.UpdatedAt))
+	time.Sleep(time.Millisecond * 50)
+	record, err = iDao.GetByID(d.Ctx, testData.ID)
+	assert.NoError(t, err)
+	assert.True(t, updatedAt.Equal(record.UpdatedAt))
+	err = d.SQLMock.ExpectationsWereMet()
+	assert.NoError(t, err)
+
+	// the records got by ids are cached with the soft ttl
+	d.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs(2, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2).AddRow(3))
+	records, err := iDao.GetByIDs(d.Ctx, []uint64{2, 3})
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+	_, softExpiration, err := d.Cache.ICache.(cache.UserExampleCache).GetWithSoftTTL(d.Ctx, 2)
+	assert.NoError(t, err)
+	assert.NotNil(t, softExpiration)
+}
+
 func Test_userExampleDao_GetByIDs(t *testing.T) {
 	d := newUserExampleDao()
 	defer d.Close()
@@ -207,6 +368,40 @@ func Test_userExampleDao_GetByIDs(t *testing.T) {
 	}
 }
 
+func Test_userExampleDao_GetByIDWithAssociations(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	testData := d.TestData.(*model.UserExample)
+
+	rows := sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+		AddRow(testData.ID, testData.CreatedAt, testData.UpdatedAt)
+
+	d.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs(testData.ID).
+		WillReturnRows(rows)
+
+	_, err := d.IDao.(UserExampleDao).GetByIDWithAssociations(d.Ctx, testData.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = d.SQLMock.ExpectationsWereMet()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// notfound error
+	d.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs(2).
+		WillReturnRows(rows)
+	_, err = d.IDao.(UserExampleDao).GetByIDWithAssociations(d.Ctx, 2)
+	assert.Error(t, err)
+
+	// unknown association error
+	_, err = d.IDao.(UserExampleDao).GetByIDWithAssociations(d.Ctx, testData.ID, "Unknown")
+	assert.Error(t, err)
+}
+
 func Test_userExampleDao_GetByColumns(t *testing.T) {
 	d := newUserExampleDao()
 	defer d.Close()
@@ -217,7 +412,7 @@ func Test_userExampleDao_GetByColumns(t *testing.T) {
 
 	d.SQLMock.ExpectQuery("SELECT .*").WillReturnRows(rows)
 
-	_, _, err := d.IDao.(UserExampleDao).GetByColumns(d.Ctx, &query.Params{
+	_, _, _, err := d.IDao.(UserExampleDao).GetByColumns(d.Ctx, &query.Params{
 		Page: 0,
 		Size: 10,
 		Sort: "ignore count", // ignore test count(*)
@@ -231,8 +426,38 @@ func Test_userExampleDao_GetByColumns(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// cursor test
+	d.SQLMock.ExpectQuery("SELECT COUNT.*").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	rows = sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+		AddRow(testData.ID, testData.CreatedAt, testData.UpdatedAt)
+	d.SQLMock.ExpectQuery("SELECT .*").WillReturnRows(rows)
+	params := &query.Params{Size: 1}
+	_, _, nextCursor, err := d.IDao.(UserExampleDao).GetByColumns(d.Ctx, params)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, nextCursor)
+
+	d.SQLMock.ExpectQuery("SELECT COUNT.*").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	rows = sqlmock.NewRows([]string{"id", "created_at", "updated_at"})
+	d.SQLMock.ExpectQuery("SELECT .*").WithArgs(testData.ID).WillReturnRows(rows)
+	params.Cursor = nextCursor
+	_, _, nextCursor, err = d.IDao.(UserExampleDao).GetByColumns(d.Ctx, params)
+	assert.NoError(t, err)
+	assert.Empty(t, nextCursor)
+
+	params.Cursor = "invalid cursor"
+	_, _, _, err = d.IDao.(UserExampleDao).GetByColumns(d.Ctx, params)
+	var paramsErr *query.ParamsError
+	assert.True(t, errors.As(err, &paramsErr))
+
+	// the column is not in the allow-list
+	_, _, _, err = d.IDao.(UserExampleDao).GetByColumns(d.Ctx, &query.Params{
+		Size:    10,
+		Columns: []query.Column{{Name: "password", Value: "123456"}},
+	})
+	assert.True(t, errors.As(err, &paramsErr))
+
 	// err test
-	_, _, err = d.IDao.(UserExampleDao).GetByColumns(d.Ctx, &query.Params{
+	_, _, _, err = d.IDao.(UserExampleDao).GetByColumns(d.Ctx, &query.Params{
 		Page: 0,
 		Size: 10,
 		Columns: []query.Column{
@@ -247,6 +472,197 @@ func Test_userExampleDao_GetByColumns(t *testing.T) {
 
 	// error test
 	dao := &userExampleDao{}
-	_, _, err = dao.GetByColumns(context.Background(), &query.Params{Columns: []query.Column{{}}})
+	_, _, _, err = dao.GetByColumns(context.Background(), &query.Params{Columns: []query.Column{{}}})
 	t.Log(err)
 }
+
+func Test_userExampleDao_WithTx(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	testData := d.TestData.(*model.UserExample)
+	iDao := d.IDao.(UserExampleDao)
+	iCache := d.Cache.ICache.(cache.UserExampleCache)
+	updatedAt := testData.UpdatedAt.Add(time.Hour)
+	err := iCache.Set(d.Ctx, testData.ID, testData, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// rollback, the cache is not deleted
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").
+		WithArgs(d.GetAnyArgs(testData)...).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs(testData.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "updated_at"}).AddRow(testData.ID, updatedAt))
+	d.SQLMock.ExpectRollback()
+	mockErr := errors.New("mock error")
+	err = mysql.WithTx(d.Ctx, d.DB, func(ctx context.Context) error {
+		if err := iDao.Create(ctx, testData); err != nil {
+			return err
+		}
+		// read the uncommitted data from the transaction instead of the cache
+		record, err := iDao.GetByID(ctx, testData.ID)
+		if err != nil {
+			return err
+		}
+		assert.True(t, updatedAt.Equal(record.UpdatedAt))
+		return mockErr
+	})
+	assert.ErrorIs(t, err, mockErr)
+	_, err = iCache.Get(d.Ctx, testData.ID)
+	assert.NoError(t, err)
+
+	// commit, the cache is deleted
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").
+		WithArgs(d.GetAnyArgs(testData)...).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectCommit()
+	err = mysql.WithTx(d.Ctx, d.DB, func(ctx context.Context) error {
+		return iDao.Create(ctx, testData)
+	})
+	assert.NoError(t, err)
+	_, err = iCache.Get(d.Ctx, testData.ID)
+	assert.ErrorIs(t, err, model.ErrCacheNotFound)
+
+	err = d.SQLMock.ExpectationsWereMet()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// todo generate the dao index methods test code to here
+// delete the templates index code start
+func Test_userExampleDao_Restore(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	testData := d.TestData.(*model.UserExample)
+
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .* SET `deleted_at`=.*").
+		WithArgs(nil, d.AnyTime, testData.ID).
+		WillReturnResult(sqlmock.NewResult(int64(testData.ID), 1))
+	d.SQLMock.ExpectCommit()
+	d.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs(testData.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(testData.ID))
+
+	err := d.IDao.(UserExampleDao).Restore(d.Ctx, []uint64{testData.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+
+	// restore error
+	err = d.IDao.(UserExampleDao).Restore(d.Ctx, []uint64{testData.ID})
+	assert.Error(t, err)
+}
+
+func Test_userExampleDao_HardDelete(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	testData := d.TestData.(*model.UserExample)
+
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("DELETE FROM .*").
+		WithArgs(testData.ID).
+		WillReturnResult(sqlmock.NewResult(int64(testData.ID), 1))
+	d.SQLMock.ExpectCommit()
+
+	err := d.IDao.(UserExampleDao).HardDelete(d.Ctx, []uint64{testData.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+
+	// delete error
+	err = d.IDao.(UserExampleDao).HardDelete(d.Ctx, []uint64{testData.ID})
+	assert.Error(t, err)
+}
+
+func Test_userExampleDao_UpsertConflict(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	testData := d.TestData.(*model.UserExample)
+	iCache := d.Cache.ICache.(cache.UserExampleCache)
+	err := iCache.Set(d.Ctx, testData.ID, testData, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the record of the same email already exists, the id is read by the values of the columns
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .* ON DUPLICATE KEY UPDATE .*").
+		WillReturnResult(sqlmock.NewResult(2, 2))
+	d.SQLMock.ExpectCommit()
+	d.SQLMock.ExpectQuery("SELECT `id` FROM .*").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(testData.ID))
+
+	table := &model.UserExample{}
+	table.Email = "foo"
+	err = d.IDao.(UserExampleDao).Upsert(d.Ctx, table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, testData.ID, table.ID)
+	_, err = iCache.Get(d.Ctx, testData.ID)
+	assert.ErrorIs(t, err, model.ErrCacheNotFound)
+
+	err = d.SQLMock.ExpectationsWereMet()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_userExampleDao_GetByEmail(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	testData := d.TestData.(*model.UserExample)
+	testData.Email = "foo"
+
+	// notfound error
+	d.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs(testData.Email).
+		WillReturnError(gorm.ErrRecordNotFound)
+	_, err := d.IDao.(UserExampleDao).GetByEmail(d.Ctx, testData.Email)
+	assert.ErrorIs(t, err, model.ErrRecordNotFound)
+
+	// notfound error from the placeholder cache
+	_, err = d.IDao.(UserExampleDao).GetByEmail(d.Ctx, testData.Email)
+	assert.ErrorIs(t, err, model.ErrRecordNotFound)
+
+	// the not found cache of the index is deleted after the record is created
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").
+		WillReturnResult(sqlmock.NewResult(int64(testData.ID), 1))
+	d.SQLMock.ExpectCommit()
+	err = d.IDao.(UserExampleDao).CreateBatch(d.Ctx, []*model.UserExample{testData})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs(testData.Email).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(testData.ID, testData.Email))
+	record, err := d.IDao.(UserExampleDao).GetByEmail(d.Ctx, testData.Email)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, testData.ID, record.ID)
+
+	// get from cache
+	record, err = d.IDao.(UserExampleDao).GetByEmail(d.Ctx, testData.Email)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, testData.ID, record.ID)
+
+	err = d.SQLMock.ExpectationsWereMet()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// delete the templates index code end
@@ -13,10 +13,14 @@ var (
 	userExampleName     = "userExample"
 	userExampleBaseCode = errcode.HCode(userExampleNO)
 
-	ErrCreateUserExample = errcode.NewError(userExampleBaseCode+1, "failed to create "+userExampleName)
-	ErrDeleteUserExample = errcode.NewError(userExampleBaseCode+2, "failed to delete "+userExampleName)
-	ErrUpdateUserExample = errcode.NewError(userExampleBaseCode+3, "failed to update "+userExampleName)
-	ErrGetUserExample    = errcode.NewError(userExampleBaseCode+4, "failed to get "+userExampleName+" details")
-	ErrListUserExample   = errcode.NewError(userExampleBaseCode+5, "failed to get list of "+userExampleName)
+	ErrCreateUserExample      = errcode.NewError(userExampleBaseCode+1, "failed to create "+userExampleName)
+	ErrDeleteUserExample      = errcode.NewError(userExampleBaseCode+2, "failed to delete "+userExampleName)
+	ErrUpdateUserExample      = errcode.NewError(userExampleBaseCode+3, "failed to update "+userExampleName)
+	ErrGetUserExample         = errcode.NewError(userExampleBaseCode+4, "failed to get "+userExampleName+" details")
+	ErrListUserExample        = errcode.NewError(userExampleBaseCode+5, "failed to get list of "+userExampleName)
+	ErrCreateBatchUserExample = errcode.NewError(userExampleBaseCode+6, "failed to create "+userExampleName+" in batch")
+	ErrUpsertUserExample      = errcode.NewError(userExampleBaseCode+7, "failed to create or update "+userExampleName)
+	ErrUpdateBatchUserExample = errcode.NewError(userExampleBaseCode+8, "failed to update "+userExampleName+" in batch")
+	ErrConflictUserExample    = errcode.NewError(userExampleBaseCode+9, userExampleName+" has been modified by others, please get it again and retry")
 	// for each error code added, add +1 to the previous error code
 )
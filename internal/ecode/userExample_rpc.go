@@ -13,10 +13,14 @@ var (
 	_userExampleName     = "userExample"
 	_userExampleBaseCode = errcode.RCode(_userExampleNO)
 
-	StatusCreateUserExample = errcode.NewRPCStatus(_userExampleBaseCode+1, "failed to create "+_userExampleName)
-	StatusDeleteUserExample = errcode.NewRPCStatus(_userExampleBaseCode+2, "failed to delete "+_userExampleName)
-	StatusUpdateUserExample = errcode.NewRPCStatus(_userExampleBaseCode+3, "failed to update "+_userExampleName)
-	StatusGetUserExample    = errcode.NewRPCStatus(_userExampleBaseCode+4, "failed to get "+_userExampleName+" details")
-	StatusListUserExample   = errcode.NewRPCStatus(_userExampleBaseCode+5, "failed to get list of "+_userExampleName)
+	StatusCreateUserExample      = errcode.NewRPCStatus(_userExampleBaseCode+1, "failed to create "+_userExampleName)
+	StatusDeleteUserExample      = errcode.NewRPCStatus(_userExampleBaseCode+2, "failed to delete "+_userExampleName)
+	StatusUpdateUserExample      = errcode.NewRPCStatus(_userExampleBaseCode+3, "failed to update "+_userExampleName)
+	StatusGetUserExample         = errcode.NewRPCStatus(_userExampleBaseCode+4, "failed to get "+_userExampleName+" details")
+	StatusListUserExample        = errcode.NewRPCStatus(_userExampleBaseCode+5, "failed to get list of "+_userExampleName)
+	StatusCreateBatchUserExample = errcode.NewRPCStatus(_userExampleBaseCode+6, "failed to create "+_userExampleName+" in batch")
+	StatusUpsertUserExample      = errcode.NewRPCStatus(_userExampleBaseCode+7, "failed to create or update "+_userExampleName)
+	StatusUpdateBatchUserExample = errcode.NewRPCStatus(_userExampleBaseCode+8, "failed to update "+_userExampleName+" in batch")
+	StatusConflictUserExample    = errcode.NewRPCStatus(_userExampleBaseCode+9, _userExampleName+" has been modified by others, please get it again and retry")
 	// for each error code added, add +1 to the previous error code
 )
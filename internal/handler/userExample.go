@@ -24,12 +24,19 @@ var _ UserExampleHandler = (*userExampleHandler)(nil)
 // UserExampleHandler defining the handler interface
 type UserExampleHandler interface {
 	Create(c *gin.Context)
+	CreateBatch(c *gin.Context)
+	Upsert(c *gin.Context)
 	DeleteByID(c *gin.Context)
 	DeleteByIDs(c *gin.Context)
 	UpdateByID(c *gin.Context)
+	UpdateByIDs(c *gin.Context)
 	GetByID(c *gin.Context)
 	ListByIDs(c *gin.Context)
 	List(c *gin.Context)
+	// todo generate the handler index methods interface code to here
+	// delete the templates index code start
+	GetByEmail(c *gin.Context)
+	// delete the templates index code end
 }
 
 type userExampleHandler struct {
@@ -40,7 +47,7 @@ type userExampleHandler struct {
 func NewUserExampleHandler() UserExampleHandler {
 	return &userExampleHandler{
 		iDao: dao.NewUserExampleDao(
-			model.GetDB(),
+			model.GetDB(dao.UserExampleDBName),
 			cache.NewUserExampleCache(model.GetCacheType()),
 		),
 	}
@@ -81,6 +88,80 @@ func (h *userExampleHandler) Create(c *gin.Context) {
 	response.Success(c, gin.H{"id": userExample.ID})
 }
 
+// CreateBatch create multiple records
+// @Summary create userExamples in batch
+// @Description submit information to create multiple userExamples, the records are inserted in chunks
+// @Tags userExample
+// @accept json
+// @Produce json
+// @Param data body types.CreateUserExamplesRequest true "userExample information array"
+// @Success 200 {object} types.Result{}
+// @Router /api/v1/userExamples/batch [post]
+func (h *userExampleHandler) CreateBatch(c *gin.Context) {
+	form := &types.CreateUserExamplesRequest{}
+	err := c.ShouldBindJSON(form)
+	if err != nil {
+		logger.Warn("ShouldBindJSON error: ", logger.Err(err), middleware.GCtxRequestIDField(c))
+		response.Error(c, ecode.InvalidParams)
+		return
+	}
+
+	userExamples := []*model.UserExample{}
+	err = copier.Copy(&userExamples, form.UserExamples)
+	if err != nil {
+		response.Error(c, ecode.ErrCreateBatchUserExample)
+		return
+	}
+
+	err = h.iDao.CreateBatch(c.Request.Context(), userExamples)
+	if err != nil {
+		logger.Error("CreateBatch error", logger.Err(err), logger.Any("form", form), middleware.GCtxRequestIDField(c))
+		response.Output(c, ecode.InternalServerError.ToHTTPCode())
+		return
+	}
+
+	ids := make([]uint64, 0, len(userExamples))
+	for _, userExample := range userExamples {
+		ids = append(ids, userExample.ID)
+	}
+	response.Success(c, gin.H{"ids": ids})
+}
+
+// Upsert create a record, or update it if the record with the same unique key already exists
+// @Summary create or update userExample
+// @Description create userExample, or update all the fields of userExample if the unique key already exists
+// @Tags userExample
+// @accept json
+// @Produce json
+// @Param data body types.CreateUserExampleRequest true "userExample information"
+// @Success 200 {object} types.Result{}
+// @Router /api/v1/userExample [put]
+func (h *userExampleHandler) Upsert(c *gin.Context) {
+	form := &types.CreateUserExampleRequest{}
+	err := c.ShouldBindJSON(form)
+	if err != nil {
+		logger.Warn("ShouldBindJSON error: ", logger.Err(err), middleware.GCtxRequestIDField(c))
+		response.Error(c, ecode.InvalidParams)
+		return
+	}
+
+	userExample := &model.UserExample{}
+	err = copier.Copy(userExample, form)
+	if err != nil {
+		response.Error(c, ecode.ErrUpsertUserExample)
+		return
+	}
+
+	err = h.iDao.Upsert(c.Request.Context(), userExample)
+	if err != nil {
+		logger.Error("Upsert error", logger.Err(err), logger.Any("form", form), middleware.GCtxRequestIDField(c))
+		response.Output(c, ecode.InternalServerError.ToHTTPCode())
+		return
+	}
+
+	response.Success(c, gin.H{"id": userExample.ID})
+}
+
 // DeleteByID delete a record by ID
 // @Summary delete userExample
 // @Description delete userExample by id
@@ -168,6 +249,16 @@ func (h *userExampleHandler) UpdateByID(c *gin.Context) {
 
 	err = h.iDao.UpdateByID(c.Request.Context(), userExample)
 	if err != nil {
+		if errors.Is(err, model.ErrVersionConflict) {
+			logger.Warn("UpdateByID version conflict", logger.Err(err), logger.Any("form", form), middleware.GCtxRequestIDField(c))
+			response.Error(c, ecode.ErrConflictUserExample)
+			return
+		}
+		if errors.Is(err, query.ErrNotFound) {
+			logger.Warn("UpdateByID not found", logger.Err(err), logger.Any("form", form), middleware.GCtxRequestIDField(c))
+			response.Error(c, ecode.NotFound)
+			return
+		}
 		logger.Error("UpdateByID error", logger.Err(err), logger.Any("form", form), middleware.GCtxRequestIDField(c))
 		response.Output(c, ecode.InternalServerError.ToHTTPCode())
 		return
@@ -176,6 +267,42 @@ func (h *userExampleHandler) UpdateByID(c *gin.Context) {
 	response.Success(c)
 }
 
+// UpdateByIDs update information of multiple id
+// @Summary update userExamples information by multiple id
+// @Description update the same information of userExamples by multiple id, fields are updated with non-zero values
+// @Tags userExample
+// @accept json
+// @Produce json
+// @Param data body types.UpdateUserExamplesByIDsRequest true "id array and userExample information"
+// @Success 200 {object} types.Result{}
+// @Router /api/v1/userExamples/ids [put]
+func (h *userExampleHandler) UpdateByIDs(c *gin.Context) {
+	form := &types.UpdateUserExamplesByIDsRequest{}
+	err := c.ShouldBindJSON(form)
+	if err != nil {
+		logger.Warn("ShouldBindJSON error: ", logger.Err(err), middleware.GCtxRequestIDField(c))
+		response.Error(c, ecode.InvalidParams)
+		return
+	}
+
+	userExample := &model.UserExample{}
+	err = copier.Copy(userExample, &form.UpdateUserExampleByIDRequest)
+	if err != nil {
+		response.Error(c, ecode.ErrUpdateBatchUserExample)
+		return
+	}
+	userExample.ID = 0
+
+	err = h.iDao.UpdateByIDs(c.Request.Context(), form.IDs, userExample)
+	if err != nil {
+		logger.Error("UpdateByIDs error", logger.Err(err), logger.Any("form", form), middleware.GCtxRequestIDField(c))
+		response.Output(c, ecode.InternalServerError.ToHTTPCode())
+		return
+	}
+
+	response.Success(c)
+}
+
 // GetByID get a record by id
 // @Summary get userExample details
 // @Description get userExample details by id
@@ -269,8 +396,14 @@ func (h *userExampleHandler) List(c *gin.Context) {
 		return
 	}
 
-	userExamples, total, err := h.iDao.GetByColumns(c.Request.Context(), &form.Params)
+	userExamples, total, nextCursor, err := h.iDao.GetByColumns(c.Request.Context(), &form.Params)
 	if err != nil {
+		var paramsErr *query.ParamsError
+		if errors.As(err, &paramsErr) {
+			logger.Warn("GetByColumns error", logger.Err(err), logger.Any("form", form), middleware.GCtxRequestIDField(c))
+			response.Error(c, ecode.InvalidParams.WithDetails(paramsErr.Error()))
+			return
+		}
 		logger.Error("GetByColumns error", logger.Err(err), logger.Any("form", form), middleware.GCtxRequestIDField(c))
 		response.Output(c, ecode.InternalServerError.ToHTTPCode())
 		return
@@ -285,9 +418,56 @@ func (h *userExampleHandler) List(c *gin.Context) {
 	response.Success(c, gin.H{
 		"userExamples": data,
 		"total":        total,
+		"nextCursor":   nextCursor,
 	})
 }
 
+// todo generate the handler index methods code to here
+// delete the templates index code start 2
+
+// GetByEmail get a record by the unique index email
+// @Summary get userExample details by email
+// @Description get userExample details by the unique index email
+// @Tags userExample
+// @Param email path string true "email"
+// @Accept json
+// @Produce json
+// @Success 200 {object} types.Result{}
+// @Router /api/v1/userExample/email/{email} [get]
+func (h *userExampleHandler) GetByEmail(c *gin.Context) {
+	form := &types.GetUserExampleByEmailRequest{}
+	err := c.ShouldBindUri(form)
+	if err != nil {
+		logger.Warn("ShouldBindUri error: ", logger.Err(err), middleware.GCtxRequestIDField(c))
+		response.Error(c, ecode.InvalidParams)
+		return
+	}
+
+	userExample, err := h.iDao.GetByEmail(c.Request.Context(), form.Email)
+	if err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			logger.Warn("GetByEmail not found", logger.Err(err), logger.Any("form", form), middleware.GCtxRequestIDField(c))
+			response.Error(c, ecode.NotFound)
+		} else {
+			logger.Error("GetByEmail error", logger.Err(err), logger.Any("form", form), middleware.GCtxRequestIDField(c))
+			response.Output(c, ecode.InternalServerError.ToHTTPCode())
+		}
+		return
+	}
+
+	data := &types.GetUserExampleByIDRespond{}
+	err = copier.Copy(data, userExample)
+	if err != nil {
+		response.Error(c, ecode.ErrGetUserExample)
+		return
+	}
+	data.ID = utils.Uint64ToStr(userExample.ID)
+
+	response.Success(c, gin.H{"userExample": data})
+}
+
+// delete the templates index code end 2
+
 func getUserExampleIDFromPath(c *gin.Context) (string, uint64, bool) {
 	idStr := c.Param("id")
 	id, err := utils.StrToUint64E(idStr)
@@ -49,6 +49,18 @@ func newUserExampleHandler() *gotest.Handler {
 			Path:        "/userExample",
 			HandlerFunc: h.IHandler.(UserExampleHandler).Create,
 		},
+		{
+			FuncName:    "CreateBatch",
+			Method:      http.MethodPost,
+			Path:        "/userExamples/batch",
+			HandlerFunc: h.IHandler.(UserExampleHandler).CreateBatch,
+		},
+		{
+			FuncName:    "Upsert",
+			Method:      http.MethodPut,
+			Path:        "/userExample",
+			HandlerFunc: h.IHandler.(UserExampleHandler).Upsert,
+		},
 		{
 			FuncName:    "DeleteByID",
 			Method:      http.MethodDelete,
@@ -67,6 +79,12 @@ func newUserExampleHandler() *gotest.Handler {
 			Path:        "/userExample/:id",
 			HandlerFunc: h.IHandler.(UserExampleHandler).UpdateByID,
 		},
+		{
+			FuncName:    "UpdateByIDs",
+			Method:      http.MethodPut,
+			Path:        "/userExamples/ids",
+			HandlerFunc: h.IHandler.(UserExampleHandler).UpdateByIDs,
+		},
 		{
 			FuncName:    "GetByID",
 			Method:      http.MethodGet,
@@ -85,6 +103,14 @@ func newUserExampleHandler() *gotest.Handler {
 			Path:        "/userExamples",
 			HandlerFunc: h.IHandler.(UserExampleHandler).List,
 		},
+		// delete the templates index code start
+		{
+			FuncName:    "GetByEmail",
+			Method:      http.MethodGet,
+			Path:        "/userExample/email/:email",
+			HandlerFunc: h.IHandler.(UserExampleHandler).GetByEmail,
+		},
+		// delete the templates index code end
 	}
 
 	h.GoRunHTTPServer(testFns)
@@ -138,6 +164,49 @@ func Test_userExampleHandler_Create(t *testing.T) {
 	// delete the templates code end
 }
 
+func Test_userExampleHandler_CreateBatch(t *testing.T) {
+	h := newUserExampleHandler()
+	defer h.Close()
+	testData := &types.CreateUserExampleRequest{}
+	_ = copier.Copy(testData, h.TestData.(*model.UserExample))
+
+	h.MockDao.SQLMock.ExpectBegin()
+	h.MockDao.SQLMock.ExpectExec("INSERT INTO .*").
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	h.MockDao.SQLMock.ExpectCommit()
+
+	result := &gohttp.StdResult{}
+	form := &types.CreateUserExamplesRequest{UserExamples: []types.CreateUserExampleRequest{*testData, *testData}}
+	err := gohttp.Post(result, h.GetRequestURL("CreateBatch"), form)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("%+v", result)
+
+	// empty list error test
+	err = gohttp.Post(result, h.GetRequestURL("CreateBatch"), &types.CreateUserExamplesRequest{})
+	assert.NoError(t, err)
+}
+
+func Test_userExampleHandler_Upsert(t *testing.T) {
+	h := newUserExampleHandler()
+	defer h.Close()
+	testData := &types.CreateUserExampleRequest{}
+	_ = copier.Copy(testData, h.TestData.(*model.UserExample))
+
+	h.MockDao.SQLMock.ExpectBegin()
+	h.MockDao.SQLMock.ExpectExec("INSERT INTO .* ON DUPLICATE KEY UPDATE .*").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	h.MockDao.SQLMock.ExpectCommit()
+
+	result := &gohttp.StdResult{}
+	err := gohttp.Put(result, h.GetRequestURL("Upsert"), testData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("%+v", result)
+}
+
 func Test_userExampleHandler_DeleteByID(t *testing.T) {
 	h := newUserExampleHandler()
 	defer h.Close()
@@ -226,6 +295,43 @@ func Test_userExampleHandler_UpdateByID(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func Test_userExampleHandler_UpdateByIDs(t *testing.T) {
+	h := newUserExampleHandler()
+	defer h.Close()
+	testData := h.TestData.(*model.UserExample)
+
+	// empty ids error test
+	result := &gohttp.StdResult{}
+	err := gohttp.Put(result, h.GetRequestURL("UpdateByIDs"), &types.UpdateUserExamplesByIDsRequest{})
+	assert.NoError(t, err)
+
+	// no fields error test
+	err = gohttp.Put(result, h.GetRequestURL("UpdateByIDs"), &types.UpdateUserExamplesByIDsRequest{IDs: []uint64{testData.ID}})
+	assert.Error(t, err)
+	// delete the templates code start 2
+
+	h.MockDao.SQLMock.ExpectBegin()
+	h.MockDao.SQLMock.ExpectExec("UPDATE .*").
+		WithArgs("foo", h.MockDao.AnyTime, testData.ID).
+		WillReturnResult(sqlmock.NewResult(int64(testData.ID), 1))
+	h.MockDao.SQLMock.ExpectCommit()
+
+	form := &types.UpdateUserExamplesByIDsRequest{IDs: []uint64{testData.ID}}
+	form.Name = "foo"
+	err = gohttp.Put(result, h.GetRequestURL("UpdateByIDs"), form)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Code != 0 {
+		t.Fatalf("%+v", result)
+	}
+
+	// update error test
+	err = gohttp.Put(result, h.GetRequestURL("UpdateByIDs"), form)
+	assert.Error(t, err)
+	// delete the templates code end 2
+}
+
 func Test_userExampleHandler_GetByID(t *testing.T) {
 	h := newUserExampleHandler()
 	defer h.Close()
@@ -317,6 +423,36 @@ func Test_userExampleHandler_List(t *testing.T) {
 	}})
 }
 
+// delete the templates index code start 2
+func Test_userExampleHandler_GetByEmail(t *testing.T) {
+	h := newUserExampleHandler()
+	defer h.Close()
+	testData := h.TestData.(*model.UserExample)
+	testData.Email = "foo@bar.com"
+
+	rows := sqlmock.NewRows([]string{"id", "email", "created_at", "updated_at"}).
+		AddRow(testData.ID, testData.Email, testData.CreatedAt, testData.UpdatedAt)
+
+	h.MockDao.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs(testData.Email).
+		WillReturnRows(rows)
+
+	result := &gohttp.StdResult{}
+	err := gohttp.Get(result, h.GetRequestURL("GetByEmail", testData.Email))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Code != 0 {
+		t.Fatalf("%+v", result)
+	}
+
+	// get error test
+	err = gohttp.Get(result, h.GetRequestURL("GetByEmail", "unknown@bar.com"))
+	assert.Error(t, err)
+}
+
+// delete the templates index code end 2
+
 func TestNewUserExampleHandler(t *testing.T) {
 	defer func() {
 		recover()
@@ -1,15 +1,24 @@
 package model
 
 import (
+	"context"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/zhufuyi/sponge/internal/config"
 
+	"github.com/zhufuyi/sponge/pkg/cache"
 	"github.com/zhufuyi/sponge/pkg/goredis"
+	"github.com/zhufuyi/sponge/pkg/grpc/metrics"
+	"github.com/zhufuyi/sponge/pkg/logger"
 	"github.com/zhufuyi/sponge/pkg/mysql"
+	"github.com/zhufuyi/sponge/pkg/mysql/migrate"
+	"github.com/zhufuyi/sponge/pkg/mysql/query"
+	"github.com/zhufuyi/sponge/pkg/sqlite"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus"
 	"gorm.io/gorm"
 )
 
@@ -19,44 +28,134 @@ var (
 
 	// ErrRecordNotFound no records found
 	ErrRecordNotFound = gorm.ErrRecordNotFound
+
+	// ErrVersionConflict the record has been modified by others since it was read
+	ErrVersionConflict = mysql.ErrVersionConflict
 )
 
 var (
 	db    *gorm.DB
 	once1 sync.Once
 
-	redisCli *redis.Client
+	redisCli redis.UniversalClient
 	once2    sync.Once
 
 	cacheType *CacheType
 	once3     sync.Once
+
+	// the named connections of mysql and redis in the config, the key is the name of the connection
+	namedDBs      = make(map[string]*gorm.DB)
+	namedRedisCli = make(map[string]redis.UniversalClient)
+	namedMutex    sync.Mutex
 )
 
-// InitMysql connect mysql
+// InitMysql connect mysql and the named mysql in the config, if the dsn is a sqlite database file, connect sqlite
 func InitMysql() {
+	// the cursors of the list api are signed by the secret, all instances of the service must use the same secret
+	query.SetCursorSecret(config.Get().Mysql.CursorSecret)
+
+	if sqlite.IsDsn(config.Get().Mysql.Dsn) {
+		InitSqlite()
+	} else {
+		db = openMysql("", &config.Get().Mysql)
+	}
+
+	for name := range config.Get().NamedMysql {
+		getNamedDB(name)
+	}
+}
+
+// InitSqlite connect sqlite, the dsn is the database file path
+func InitSqlite() {
+	db = openSqlite(&config.Get().Mysql)
+}
+
+// the name distinguishes the connection pools in the metrics, empty means the default mysql
+func openMysql(name string, cfg *config.Mysql) *gorm.DB {
+	if sqlite.IsDsn(cfg.Dsn) {
+		return openSqlite(cfg)
+	}
+
 	opts := []mysql.Option{
-		mysql.WithSlowThreshold(time.Duration(config.Get().Mysql.SlowThreshold) * time.Millisecond),
-		mysql.WithMaxIdleConns(config.Get().Mysql.MaxIdleConns),
-		mysql.WithMaxOpenConns(config.Get().Mysql.MaxOpenConns),
-		mysql.WithConnMaxLifetime(time.Duration(config.Get().Mysql.ConnMaxLifetime) * time.Minute),
+		mysql.WithLogger(logger.Get()),
+		mysql.WithSlowThreshold(time.Duration(cfg.SlowThreshold) * time.Millisecond),
+		mysql.WithMaxIdleConns(cfg.MaxIdleConns),
+		mysql.WithMaxOpenConns(cfg.MaxOpenConns),
+		mysql.WithConnMaxLifetime(time.Duration(cfg.ConnMaxLifetime) * time.Minute),
+		mysql.WithAudit(),
 	}
-	if config.Get().Mysql.EnableLog {
+	if cfg.EnableLog {
 		opts = append(opts, mysql.WithLog())
 	}
+	if len(cfg.Replicas) > 0 {
+		opts = append(opts,
+			mysql.WithReplicas(cfg.Replicas...),
+			mysql.WithReplicaPolicy(cfg.ReplicaPolicy),
+		)
+	}
 
 	if config.Get().App.EnableTrace {
 		opts = append(opts, mysql.WithEnableTrace())
 	}
+	if config.Get().App.EnableMetrics {
+		// exported by the /metrics of both http and grpc server
+		opts = append(opts, mysql.WithMetrics(prometheus.DefaultRegisterer, metrics.ServerRegisterer()))
+		if name != "" {
+			opts = append(opts, mysql.WithName(name))
+		}
+	}
 
-	var err error
-	db, err = mysql.Init(config.Get().Mysql.Dsn, opts...)
+	gdb, err := mysql.Init(cfg.Dsn, opts...)
 	if err != nil {
 		panic("mysql.Init error: " + err.Error())
 	}
+
+	runMigrations(gdb, cfg)
+	return gdb
+}
+
+func openSqlite(cfg *config.Mysql) *gorm.DB {
+	opts := []sqlite.Option{
+		// the connections of sqlite are not closed due to age, connMaxLifetime of the configuration is only for mysql
+		sqlite.WithSlowThreshold(time.Duration(cfg.SlowThreshold) * time.Millisecond),
+	}
+	if cfg.EnableLog {
+		opts = append(opts, sqlite.WithLog())
+	}
+
+	if config.Get().App.EnableTrace {
+		opts = append(opts, sqlite.WithEnableTrace())
+	}
+
+	gdb, err := sqlite.Init(cfg.Dsn, opts...)
+	if err != nil {
+		panic("sqlite.Init error: " + err.Error())
+	}
+
+	runMigrations(gdb, cfg)
+	return gdb
+}
+
+// apply the pending migrations if enableMigration is true, the replicas of the service starting at the same time
+// wait for the lock of the migrations, so the migrations are applied only once
+func runMigrations(gdb *gorm.DB, cfg *config.Mysql) {
+	if !cfg.EnableMigration {
+		return
+	}
+
+	_, err := migrate.New(gdb, cfg.MigrationDir).Up(context.Background(), 0)
+	if err != nil {
+		panic("migrate.Up error: " + err.Error())
+	}
 }
 
-// GetDB get db
-func GetDB() *gorm.DB {
+// GetDB get db, the name is the key of the named mysql in the config, e.g. GetDB("order"),
+// if the name is empty, the default mysql is returned
+func GetDB(name ...string) *gorm.DB {
+	if len(name) > 0 && name[0] != "" {
+		return getNamedDB(name[0])
+	}
+
 	if db == nil {
 		once1.Do(func() {
 			InitMysql()
@@ -66,28 +165,48 @@ func GetDB() *gorm.DB {
 	return db
 }
 
-// CloseMysql close mysql
-func CloseMysql() error {
-	if db == nil {
-		return nil
+// connect the named mysql at the first time it is used
+func getNamedDB(name string) *gorm.DB {
+	namedMutex.Lock()
+	defer namedMutex.Unlock()
+
+	if gdb, ok := namedDBs[name]; ok {
+		return gdb
+	}
+	cfg, ok := config.Get().NamedMysql[name]
+	if !ok {
+		panic(fmt.Sprintf("not found mysql name '%s' in yaml config file (field namedMysql)", name))
 	}
+	gdb := openMysql(name, &cfg)
+	namedDBs[name] = gdb
+	return gdb
+}
 
-	sqlDB, err := db.DB()
-	if err != nil {
-		return err
+// CloseMysql close mysql, the name is the key of the named mysql in the config, if empty, the default mysql is closed
+func CloseMysql(name ...string) error {
+	gdb := db
+	if len(name) > 0 && name[0] != "" {
+		namedMutex.Lock()
+		gdb = namedDBs[name[0]]
+		delete(namedDBs, name[0])
+		namedMutex.Unlock()
 	}
-	if sqlDB != nil {
-		return sqlDB.Close()
+	if gdb == nil {
+		return nil
 	}
-	return nil
+
+	// close the connections of the primary and replicas
+	return mysql.Close(gdb)
 }
 
 // ------------------------------------------------------------------------------------------
 
 // CacheType cache type
 type CacheType struct {
-	CType string        // cache type  memory or redis
-	Rdb   *redis.Client // if CType=redis, Rdb cannot be empty
+	CType           string                // cache type  memory, redis or multi
+	Rdb             redis.UniversalClient // if CType=redis or multi, Rdb cannot be empty
+	LocalExpiration time.Duration         // if CType=multi, the expiration of the local cache, default is 1 minute
+	ObserveOptions  []cache.ObserveOption // the metrics and tracing of the cache operations
 }
 
 // InitCache initial cache
@@ -96,8 +215,20 @@ func InitCache(cType string) {
 		CType: cType,
 	}
 
-	if cType == "redis" {
+	switch cType {
+	case "redis":
+		cacheType.Rdb = GetRedisCli()
+	case "multi":
 		cacheType.Rdb = GetRedisCli()
+		cacheType.LocalExpiration = time.Duration(config.Get().App.LocalCacheExpiration) * time.Second
+	}
+
+	if config.Get().App.EnableMetrics {
+		// exported by the /metrics of both http and grpc server
+		cacheType.ObserveOptions = append(cacheType.ObserveOptions, cache.WithMetrics(prometheus.DefaultRegisterer, metrics.ServerRegisterer()))
+	}
+	if config.Get().App.EnableTrace {
+		cacheType.ObserveOptions = append(cacheType.ObserveOptions, cache.WithTrace())
 	}
 }
 
@@ -114,24 +245,33 @@ func GetCacheType() *CacheType {
 
 // InitRedis connect redis
 func InitRedis() {
+	redisCli = openRedis(&config.Get().Redis)
+}
+
+func openRedis(cfg *config.Redis) redis.UniversalClient {
 	opts := []goredis.Option{
-		goredis.WithDialTimeout(time.Duration(config.Get().Redis.DialTimeout) * time.Second),
-		goredis.WithReadTimeout(time.Duration(config.Get().Redis.ReadTimeout) * time.Second),
-		goredis.WithWriteTimeout(time.Duration(config.Get().Redis.WriteTimeout) * time.Second),
+		goredis.WithDialTimeout(time.Duration(cfg.DialTimeout) * time.Second),
+		goredis.WithReadTimeout(time.Duration(cfg.ReadTimeout) * time.Second),
+		goredis.WithWriteTimeout(time.Duration(cfg.WriteTimeout) * time.Second),
 	}
 	if config.Get().App.EnableTrace {
 		opts = append(opts, goredis.WithEnableTrace())
 	}
 
-	var err error
-	redisCli, err = goredis.Init(config.Get().Redis.Dsn, opts...)
+	cli, err := goredis.Init(cfg.Dsn, opts...)
 	if err != nil {
 		panic("goredis.Init error: " + err.Error())
 	}
+	return cli
 }
 
-// GetRedisCli get redis client
-func GetRedisCli() *redis.Client {
+// GetRedisCli get redis client, the name is the key of the named redis in the config, e.g. GetRedisCli("session"),
+// if the name is empty, the default redis client is returned
+func GetRedisCli(name ...string) redis.UniversalClient {
+	if len(name) > 0 && name[0] != "" {
+		return getNamedRedisCli(name[0])
+	}
+
 	if redisCli == nil {
 		once2.Do(func() {
 			InitRedis()
@@ -141,13 +281,37 @@ func GetRedisCli() *redis.Client {
 	return redisCli
 }
 
-// CloseRedis close redis
-func CloseRedis() error {
-	if redisCli == nil {
+// connect the named redis at the first time it is used
+func getNamedRedisCli(name string) redis.UniversalClient {
+	namedMutex.Lock()
+	defer namedMutex.Unlock()
+
+	if cli, ok := namedRedisCli[name]; ok {
+		return cli
+	}
+	cfg, ok := config.Get().NamedRedis[name]
+	if !ok {
+		panic(fmt.Sprintf("not found redis name '%s' in yaml config file (field namedRedis)", name))
+	}
+	cli := openRedis(&cfg)
+	namedRedisCli[name] = cli
+	return cli
+}
+
+// CloseRedis close redis, the name is the key of the named redis in the config, if empty, the default redis is closed
+func CloseRedis(name ...string) error {
+	cli := redisCli
+	if len(name) > 0 && name[0] != "" {
+		namedMutex.Lock()
+		cli = namedRedisCli[name[0]]
+		delete(namedRedisCli, name[0])
+		namedMutex.Unlock()
+	}
+	if cli == nil {
 		return nil
 	}
 
-	err := redisCli.Close()
+	err := cli.Close()
 	if err != nil && err.Error() != redis.ErrClosed.Error() {
 		return err
 	}
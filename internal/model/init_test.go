@@ -2,6 +2,8 @@ package model
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -10,6 +12,8 @@ import (
 
 	"github.com/zhufuyi/sponge/pkg/utils"
 
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 )
@@ -51,6 +55,46 @@ func TestInitMysqlError(t *testing.T) {
 	})
 }
 
+func TestInitSqlite(t *testing.T) {
+	err := config.Init(configs.Path("serverNameExample.yml"))
+	if err != nil {
+		panic(err)
+	}
+
+	config.Get().Mysql.Dsn = filepath.Join(t.TempDir(), "test.db")
+	InitMysql()
+	assert.NotNil(t, db)
+	assert.Equal(t, "sqlite", db.Dialector.Name())
+	err = CloseMysql()
+	assert.NoError(t, err)
+	db = nil
+}
+
+func TestInitSqliteWithMigration(t *testing.T) {
+	err := config.Init(configs.Path("serverNameExample.yml"))
+	if err != nil {
+		panic(err)
+	}
+
+	dir := t.TempDir()
+	err = os.WriteFile(filepath.Join(dir, "1_create_foo.up.sql"), []byte("CREATE TABLE foo (id INTEGER PRIMARY KEY);"), 0666)
+	assert.NoError(t, err)
+	config.Get().Mysql.Dsn = filepath.Join(t.TempDir(), "test.db")
+	config.Get().Mysql.EnableMigration = true
+	config.Get().Mysql.MigrationDir = dir
+	InitMysql()
+	assert.True(t, db.Migrator().HasTable("foo"))
+	err = CloseMysql()
+	assert.NoError(t, err)
+	db = nil
+
+	// the migration directory is not found
+	config.Get().Mysql.MigrationDir = filepath.Join(dir, "not_found")
+	assert.Panics(t, func() { InitMysql() })
+	_ = CloseMysql()
+	db = nil
+}
+
 func TestCloseMysql(t *testing.T) {
 	defer func() { recover() }()
 	db = &gorm.DB{}
@@ -85,20 +129,43 @@ func TestInitRedis(t *testing.T) {
 	_ = GetRedisCli()
 }
 
+func TestInitRedisCluster(t *testing.T) {
+	s, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	err = config.Init(configs.Path("serverNameExample.yml"))
+	if err != nil {
+		panic(err)
+	}
+
+	config.Get().Redis.Dsn = "cluster://" + s.Addr()
+	InitRedis()
+	cli := GetRedisCli()
+	assert.IsType(t, &redis.ClusterClient{}, cli)
+	err = cli.Set(context.Background(), "foo", "bar", time.Minute).Err()
+	assert.NoError(t, err)
+	err = CloseRedis()
+	assert.NoError(t, err)
+	redisCli = nil
+}
+
 func TestTableName(t *testing.T) {
 	t.Log(new(UserExample).TableName())
 }
 
 func TestGetCacheType(t *testing.T) {
-	InitCache("memory")
-	ct := GetCacheType()
-	assert.NotNil(t, ct)
-
 	err := config.Init(configs.Path("serverNameExample.yml"))
 	if err != nil {
 		panic(err)
 	}
 
+	InitCache("memory")
+	ct := GetCacheType()
+	assert.NotNil(t, ct)
+
 	cacheType = nil
 	defer func() { recover() }()
 	ct = GetCacheType()
@@ -109,3 +176,59 @@ func TestGetCacheType(t *testing.T) {
 	ct = GetCacheType()
 	assert.NotNil(t, ct)
 }
+
+func TestGetNamedDB(t *testing.T) {
+	err := config.Init(configs.Path("serverNameExample.yml"))
+	if err != nil {
+		panic(err)
+	}
+
+	config.Get().Mysql.Dsn = filepath.Join(t.TempDir(), "test.db")
+	config.Get().NamedMysql = map[string]config.Mysql{
+		"order": {Dsn: filepath.Join(t.TempDir(), "order.db")},
+	}
+	InitMysql()
+	gdb := GetDB("order")
+	assert.NotNil(t, gdb)
+	assert.Equal(t, gdb, GetDB("order"))
+	assert.NotEqual(t, db, gdb)
+	assert.Equal(t, db, GetDB(""))
+
+	// the name is not found in the config
+	assert.Panics(t, func() { GetDB("not_found") })
+
+	err = CloseMysql("order")
+	assert.NoError(t, err)
+	err = CloseMysql("order")
+	assert.NoError(t, err)
+	err = CloseMysql()
+	assert.NoError(t, err)
+	db = nil
+	config.Get().NamedMysql = nil
+}
+
+func TestGetNamedRedisCli(t *testing.T) {
+	defer func() {
+		if e := recover(); e != nil {
+			t.Log("ignore connect redis error info")
+		}
+	}()
+
+	err := config.Init(configs.Path("serverNameExample.yml"))
+	if err != nil {
+		panic(err)
+	}
+
+	assert.Panics(t, func() { GetRedisCli("not_found") })
+	err = CloseRedis("not_found")
+	assert.NoError(t, err)
+
+	config.Get().NamedRedis = map[string]config.Redis{
+		"session": config.Get().Redis,
+	}
+	defer func() { config.Get().NamedRedis = nil }()
+	cli := GetRedisCli("session")
+	assert.NotNil(t, cli)
+	err = CloseRedis("session")
+	assert.NoError(t, err)
+}
@@ -27,4 +27,21 @@ func (table *UserExample) TableName() string {
 	return "user_example"
 }
 
+// UserExampleColumns the columns allowed to be filtered and sorted in the list query and their types,
+// the sensitive columns are excluded
+var UserExampleColumns = map[string]string{
+	"id":         "uint64",
+	"created_at": "time.Time",
+	"updated_at": "time.Time",
+	"deleted_at": "time.Time",
+	"name":       "string",
+	"email":      "string",
+	"phone":      "string",
+	"avatar":     "string",
+	"age":        "int",
+	"gender":     "int",
+	"status":     "int",
+	"login_at":   "int64",
+}
+
 // delete the templates code end
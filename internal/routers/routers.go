@@ -80,6 +80,10 @@ func NewRouter() *gin.Engine {
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	apiV1 := r.Group("/api/v1")
+	// tenant middleware, the tenant id is stored in the context of request, the data of dao is scoped by it
+	if config.Get().App.EnableTenant {
+		apiV1.Use(middleware.Tenant())
+	}
 	// register/api/v1 prefix routing group
 	for _, fn := range routerFns {
 		fn(apiV1)
@@ -76,6 +76,11 @@ func NewRouter_pbExample() *gin.Engine { //nolint
 	// access path /apis/swagger/index.html
 	swagger.CustomRouter(r, "apis", docs.ApiDocs)
 
+	// tenant middleware, only the routes registered after it are affected, the tenant id is passed to the rpc server
+	if config.Get().App.EnableTenant {
+		r.Use(middleware.Tenant())
+	}
+
 	// registration/Prefix Routing Groups
 	for _, fn := range rootRouterFns {
 		fn(r)
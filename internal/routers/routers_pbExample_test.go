@@ -47,6 +47,10 @@ func (m mockGw) Create(ctx context.Context, req *serverNameExampleV1.CreateUserE
 	return nil, nil
 }
 
+func (m mockGw) CreateBatch(ctx context.Context, req *serverNameExampleV1.CreateBatchUserExampleRequest) (*serverNameExampleV1.CreateBatchUserExampleReply, error) {
+	return nil, nil
+}
+
 func (m mockGw) DeleteByID(ctx context.Context, req *serverNameExampleV1.DeleteUserExampleByIDRequest) (*serverNameExampleV1.DeleteUserExampleByIDReply, error) {
 	return nil, nil
 }
@@ -55,6 +59,10 @@ func (m mockGw) GetByID(ctx context.Context, req *serverNameExampleV1.GetUserExa
 	return nil, nil
 }
 
+func (m mockGw) GetByEmail(ctx context.Context, req *serverNameExampleV1.GetUserExampleByEmailRequest) (*serverNameExampleV1.GetUserExampleByEmailReply, error) {
+	return nil, nil
+}
+
 func (m mockGw) List(ctx context.Context, req *serverNameExampleV1.ListUserExampleRequest) (*serverNameExampleV1.ListUserExampleReply, error) {
 	return nil, nil
 }
@@ -63,6 +71,14 @@ func (m mockGw) ListByIDs(ctx context.Context, req *serverNameExampleV1.ListUser
 	return nil, nil
 }
 
+func (m mockGw) UpdateByIDs(ctx context.Context, req *serverNameExampleV1.UpdateUserExampleByIDsRequest) (*serverNameExampleV1.UpdateUserExampleByIDsReply, error) {
+	return nil, nil
+}
+
+func (m mockGw) Upsert(ctx context.Context, req *serverNameExampleV1.CreateUserExampleRequest) (*serverNameExampleV1.UpsertUserExampleReply, error) {
+	return nil, nil
+}
+
 func (m mockGw) UpdateByID(ctx context.Context, req *serverNameExampleV1.UpdateUserExampleByIDRequest) (*serverNameExampleV1.UpdateUserExampleByIDReply, error) {
 	return nil, nil
 }
@@ -53,13 +53,22 @@ func TestNewRouter2(t *testing.T) {
 type mock struct{}
 
 func (u mock) Create(c *gin.Context)      { return }
+func (u mock) CreateBatch(c *gin.Context) { return }
+func (u mock) Upsert(c *gin.Context)      { return }
 func (u mock) DeleteByID(c *gin.Context)  { return }
 func (u mock) DeleteByIDs(c *gin.Context) { return }
 func (u mock) UpdateByID(c *gin.Context)  { return }
+func (u mock) UpdateByIDs(c *gin.Context) { return }
 func (u mock) GetByID(c *gin.Context)     { return }
 func (u mock) ListByIDs(c *gin.Context)   { return }
 func (u mock) List(c *gin.Context)        { return }
 
+// todo generate the index methods of the mock handler to here
+// delete the templates index code start
+func (u mock) GetByEmail(c *gin.Context) { return }
+
+// delete the templates index code end
+
 func Test_userExampleRouter(t *testing.T) {
 	gin.SetMode(gin.ReleaseMode)
 	r := gin.Default()
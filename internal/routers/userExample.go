@@ -14,10 +14,17 @@ func init() {
 
 func userExampleRouter(group *gin.RouterGroup, h handler.UserExampleHandler) {
 	group.POST("/userExample", h.Create)
+	group.POST("/userExamples/batch", h.CreateBatch)
+	group.PUT("/userExample", h.Upsert)
 	group.DELETE("/userExample/:id", h.DeleteByID)
 	group.POST("/userExamples/delete/ids", h.DeleteByIDs)
 	group.PUT("/userExample/:id", h.UpdateByID)
+	group.PUT("/userExamples/ids", h.UpdateByIDs)
 	group.GET("/userExample/:id", h.GetByID)
 	group.POST("/userExamples/ids", h.ListByIDs)
 	group.POST("/userExamples", h.List)
+	// todo generate the index routes code to here
+	// delete the templates index code start
+	group.GET("/userExample/email/:email", h.GetByEmail)
+	// delete the templates index code end
 }
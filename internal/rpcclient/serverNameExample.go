@@ -96,6 +96,9 @@ func NewServerNameExampleRPCConn() {
 	if cfg.App.EnableMetrics {
 		cliOptions = append(cliOptions, grpccli.WithEnableMetrics())
 	}
+	if cfg.App.EnableTenant {
+		cliOptions = append(cliOptions, grpccli.WithEnableTenant())
+	}
 
 	// If a secure connection is required, use grpccli.Dial(ctx, endpoint, cliOptions...) and
 	// cliOptions sets WithCredentials to specify the certificate path
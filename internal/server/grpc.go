@@ -118,6 +118,12 @@ func (s *grpcServer) serverOptions() []grpc.ServerOption {
 		logger.Get(),
 	))
 
+	// tenant interceptor
+	if config.Get().App.EnableTenant {
+		unaryServerInterceptors = append(unaryServerInterceptors, interceptor.UnaryServerTenant())
+		streamServerInterceptors = append(streamServerInterceptors, interceptor.StreamServerTenant())
+	}
+
 	// metrics interceptor
 	if config.Get().App.EnableMetrics {
 		unaryServerInterceptors = append(unaryServerInterceptors, interceptor.UnaryServerMetrics())
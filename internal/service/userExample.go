@@ -2,8 +2,8 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
-	"strings"
 
 	serverNameExampleV1 "github.com/zhufuyi/sponge/api/serverNameExample/v1"
 	"github.com/zhufuyi/sponge/internal/cache"
@@ -17,6 +17,13 @@ import (
 
 	"github.com/jinzhu/copier"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// used to convert the typed JSON columns
+var (
+	_ = json.Marshal
+	_ *structpb.Struct
 )
 
 // nolint
@@ -38,7 +45,7 @@ type userExampleService struct {
 func NewUserExampleServiceServer() serverNameExampleV1.UserExampleServiceServer {
 	return &userExampleService{
 		iDao: dao.NewUserExampleDao(
-			model.GetDB(),
+			model.GetDB(dao.UserExampleDBName),
 			cache.NewUserExampleCache(model.GetCacheType()),
 		),
 	}
@@ -57,6 +64,7 @@ func (s *userExampleService) Create(ctx context.Context, req *serverNameExampleV
 	if err != nil {
 		return nil, ecode.StatusCreateUserExample.Err()
 	}
+	// todo generate the conversion code of the request to here
 
 	err = s.iDao.Create(ctx, userExample)
 	if err != nil {
@@ -67,6 +75,63 @@ func (s *userExampleService) Create(ctx context.Context, req *serverNameExampleV
 	return &serverNameExampleV1.CreateUserExampleReply{Id: userExample.ID}, nil
 }
 
+// CreateBatch create multiple records
+func (s *userExampleService) CreateBatch(ctx context.Context, req *serverNameExampleV1.CreateBatchUserExampleRequest) (*serverNameExampleV1.CreateBatchUserExampleReply, error) {
+	err := req.Validate()
+	if err != nil {
+		logger.Warn("req.Validate error", logger.Err(err), logger.Any("req", req), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusInvalidParams.Err()
+	}
+
+	userExamples := make([]*model.UserExample, 0, len(req.UserExamples))
+	for _, req := range req.UserExamples {
+		userExample := &model.UserExample{}
+		err = copier.Copy(userExample, req)
+		if err != nil {
+			return nil, ecode.StatusCreateBatchUserExample.Err()
+		}
+		// todo generate the conversion code of the request to here
+		userExamples = append(userExamples, userExample)
+	}
+
+	err = s.iDao.CreateBatch(ctx, userExamples)
+	if err != nil {
+		logger.Error("s.iDao.CreateBatch error", logger.Err(err), logger.Any("size", len(userExamples)), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusInternalServerError.ToRPCErr()
+	}
+
+	ids := make([]uint64, 0, len(userExamples))
+	for _, userExample := range userExamples {
+		ids = append(ids, userExample.ID)
+	}
+
+	return &serverNameExampleV1.CreateBatchUserExampleReply{Ids: ids}, nil
+}
+
+// Upsert create a record, or update it if it already exists
+func (s *userExampleService) Upsert(ctx context.Context, req *serverNameExampleV1.CreateUserExampleRequest) (*serverNameExampleV1.UpsertUserExampleReply, error) {
+	err := req.Validate()
+	if err != nil {
+		logger.Warn("req.Validate error", logger.Err(err), logger.Any("req", req), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusInvalidParams.Err()
+	}
+
+	userExample := &model.UserExample{}
+	err = copier.Copy(userExample, req)
+	if err != nil {
+		return nil, ecode.StatusUpsertUserExample.Err()
+	}
+	// todo generate the conversion code of the request to here
+
+	err = s.iDao.Upsert(ctx, userExample)
+	if err != nil {
+		logger.Error("s.iDao.Upsert error", logger.Err(err), logger.Any("userExample", userExample), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusInternalServerError.ToRPCErr()
+	}
+
+	return &serverNameExampleV1.UpsertUserExampleReply{Id: userExample.ID}, nil
+}
+
 // DeleteByID delete a record based on id
 func (s *userExampleService) DeleteByID(ctx context.Context, req *serverNameExampleV1.DeleteUserExampleByIDRequest) (*serverNameExampleV1.DeleteUserExampleByIDReply, error) {
 	err := req.Validate()
@@ -98,9 +163,18 @@ func (s *userExampleService) UpdateByID(ctx context.Context, req *serverNameExam
 		return nil, ecode.StatusUpdateUserExample.Err()
 	}
 	userExample.ID = req.Id
+	// todo generate the conversion code of the request to here
 
 	err = s.iDao.UpdateByID(ctx, userExample)
 	if err != nil {
+		if errors.Is(err, model.ErrVersionConflict) {
+			logger.Warn("s.iDao.UpdateByID version conflict", logger.Err(err), logger.Any("userExample", userExample), interceptor.ServerCtxRequestIDField(ctx))
+			return nil, ecode.StatusConflictUserExample.Err()
+		}
+		if errors.Is(err, query.ErrNotFound) {
+			logger.Warn("s.iDao.UpdateByID not found", logger.Err(err), logger.Any("userExample", userExample), interceptor.ServerCtxRequestIDField(ctx))
+			return nil, ecode.StatusNotFound.Err()
+		}
 		logger.Error("s.iDao.UpdateByID error", logger.Err(err), logger.Any("userExample", userExample), interceptor.ServerCtxRequestIDField(ctx))
 		return nil, ecode.StatusInternalServerError.ToRPCErr()
 	}
@@ -108,6 +182,38 @@ func (s *userExampleService) UpdateByID(ctx context.Context, req *serverNameExam
 	return &serverNameExampleV1.UpdateUserExampleByIDReply{}, nil
 }
 
+// UpdateByIDs update multiple records with the same values based on ids
+func (s *userExampleService) UpdateByIDs(ctx context.Context, in *serverNameExampleV1.UpdateUserExampleByIDsRequest) (*serverNameExampleV1.UpdateUserExampleByIDsReply, error) {
+	err := in.Validate()
+	if err != nil {
+		logger.Warn("req.Validate error", logger.Err(err), logger.Any("req", in), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusInvalidParams.Err()
+	}
+	if len(in.Ids) == 0 || in.UserExample == nil {
+		logger.Warn("ids or userExample is empty", logger.Any("req", in), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusInvalidParams.Err()
+	}
+	ids := in.Ids
+
+	// the values to be updated, the conversion code uses the name req
+	req := in.UserExample
+	userExample := &model.UserExample{}
+	err = copier.Copy(userExample, req)
+	if err != nil {
+		return nil, ecode.StatusUpdateBatchUserExample.Err()
+	}
+	userExample.ID = 0
+	// todo generate the conversion code of the request to here
+
+	err = s.iDao.UpdateByIDs(ctx, ids, userExample)
+	if err != nil {
+		logger.Error("s.iDao.UpdateByIDs error", logger.Err(err), logger.Any("ids", ids), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusInternalServerError.ToRPCErr()
+	}
+
+	return &serverNameExampleV1.UpdateUserExampleByIDsReply{}, nil
+}
+
 // GetByID get a record by id
 func (s *userExampleService) GetByID(ctx context.Context, req *serverNameExampleV1.GetUserExampleByIDRequest) (*serverNameExampleV1.GetUserExampleByIDReply, error) {
 	err := req.Validate()
@@ -177,11 +283,12 @@ func (s *userExampleService) List(ctx context.Context, req *serverNameExampleV1.
 	}
 	params.Size = int(req.Params.Limit)
 
-	records, total, err := s.iDao.GetByColumns(ctx, params)
+	records, total, nextCursor, err := s.iDao.GetByColumns(ctx, params)
 	if err != nil {
-		if strings.Contains(err.Error(), "query params error:") {
+		var paramsErr *query.ParamsError
+		if errors.As(err, &paramsErr) {
 			logger.Warn("s.iDao.GetByColumns error", logger.Err(err), logger.Any("params", params), interceptor.ServerCtxRequestIDField(ctx))
-			return nil, ecode.StatusInvalidParams.Err()
+			return nil, ecode.StatusInvalidParams.Err(ecode.Any(paramsErr.Field, paramsErr.Reason))
 		}
 		logger.Error("s.iDao.GetByColumns error", logger.Err(err), logger.Any("params", params), interceptor.ServerCtxRequestIDField(ctx))
 		return nil, ecode.StatusInternalServerError.ToRPCErr()
@@ -200,9 +307,42 @@ func (s *userExampleService) List(ctx context.Context, req *serverNameExampleV1.
 	return &serverNameExampleV1.ListUserExampleReply{
 		Total:        total,
 		UserExamples: userExamples,
+		NextCursor:   nextCursor,
 	}, nil
 }
 
+// todo generate the service index methods code to here
+// delete the templates index code start
+
+// GetByEmail get a record by the unique index email
+func (s *userExampleService) GetByEmail(ctx context.Context, req *serverNameExampleV1.GetUserExampleByEmailRequest) (*serverNameExampleV1.GetUserExampleByEmailReply, error) {
+	err := req.Validate()
+	if err != nil {
+		logger.Warn("req.Validate error", logger.Err(err), logger.Any("req", req), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusInvalidParams.Err()
+	}
+
+	record, err := s.iDao.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			logger.Warn("s.iDao.GetByEmail error", logger.Err(err), logger.Any("req", req), interceptor.ServerCtxRequestIDField(ctx))
+			return nil, ecode.StatusNotFound.Err()
+		}
+		logger.Error("s.iDao.GetByEmail error", logger.Err(err), logger.Any("req", req), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusInternalServerError.ToRPCErr()
+	}
+
+	data, err := covertUserExample(record)
+	if err != nil {
+		logger.Warn("covertUserExample error", logger.Err(err), logger.Any("record", record), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusGetUserExample.Err()
+	}
+
+	return &serverNameExampleV1.GetUserExampleByEmailReply{UserExample: data}, nil
+}
+
+// delete the templates index code end
+
 func covertUserExample(record *model.UserExample) (*serverNameExampleV1.UserExample, error) {
 	value := &serverNameExampleV1.UserExample{}
 	err := copier.Copy(value, record)
@@ -210,6 +350,7 @@ func covertUserExample(record *model.UserExample) (*serverNameExampleV1.UserExam
 		return nil, err
 	}
 	value.Id = record.ID
+	// todo generate the conversion code of the reply to here
 	value.CreatedAt = record.CreatedAt.Unix()
 	value.UpdatedAt = record.UpdatedAt.Unix()
 	return value, nil
@@ -45,6 +45,55 @@ func Test_service_userExample_methods(t *testing.T) {
 			wantErr: false,
 		},
 
+		{
+			name: "CreateBatch",
+			fn: func() (interface{}, error) {
+				// todo enter parameters to test
+				req := &serverNameExampleV1.CreateBatchUserExampleRequest{
+					UserExamples: []*serverNameExampleV1.CreateUserExampleRequest{
+						{
+							Name:     "foo8",
+							Email:    "foo8@bar.com",
+							Password: "f447b20a7fcbf53a5d5be013ea0b15af",
+							Phone:    "16000000008",
+							Avatar:   "http://internal.com/8.jpg",
+							Age:      12,
+							Gender:   1,
+						},
+						{
+							Name:     "foo9",
+							Email:    "foo9@bar.com",
+							Password: "f447b20a7fcbf53a5d5be013ea0b15af",
+							Phone:    "16000000009",
+							Avatar:   "http://internal.com/9.jpg",
+							Age:      13,
+							Gender:   2,
+						},
+					},
+				}
+				return cli.CreateBatch(ctx, req)
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "Upsert",
+			fn: func() (interface{}, error) {
+				// todo enter parameters to test
+				req := &serverNameExampleV1.CreateUserExampleRequest{
+					Name:     "foo7",
+					Email:    "foo7@bar.com",
+					Password: "f447b20a7fcbf53a5d5be013ea0b15af",
+					Phone:    "16000000007",
+					Avatar:   "http://internal.com/7.jpg",
+					Age:      12,
+					Gender:   2,
+				}
+				return cli.Upsert(ctx, req)
+			},
+			wantErr: false,
+		},
+
 		{
 			name: "UpdateByID",
 			fn: func() (interface{}, error) {
@@ -58,6 +107,33 @@ func Test_service_userExample_methods(t *testing.T) {
 			},
 			wantErr: false,
 		},
+
+		{
+			name: "UpdateByIDs",
+			fn: func() (interface{}, error) {
+				// todo enter parameters to test
+				req := &serverNameExampleV1.UpdateUserExampleByIDsRequest{
+					Ids: []uint64{8, 9},
+					UserExample: &serverNameExampleV1.UserExample{
+						Age: 14,
+					},
+				}
+				return cli.UpdateByIDs(ctx, req)
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "GetByEmail",
+			fn: func() (interface{}, error) {
+				// todo enter parameters to test
+				req := &serverNameExampleV1.GetUserExampleByEmailRequest{
+					Email: "foo7@bar.com",
+				}
+				return cli.GetByEmail(ctx, req)
+			},
+			wantErr: false,
+		},
 		// delete the templates code end
 		{
 			name: "DeleteByID",
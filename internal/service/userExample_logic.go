@@ -28,6 +28,18 @@ func (c *userExampleServiceClient) Create(ctx context.Context, req *serverNameEx
 	return c.userExampleServiceCli.Create(ctx, req)
 }
 
+func (c *userExampleServiceClient) CreateBatch(ctx context.Context, req *serverNameExampleV1.CreateBatchUserExampleRequest) (*serverNameExampleV1.CreateBatchUserExampleReply, error) {
+	// implement me
+	// If required, fill in the code to fetch data from other rpc servers here.
+	return c.userExampleServiceCli.CreateBatch(ctx, req)
+}
+
+func (c *userExampleServiceClient) Upsert(ctx context.Context, req *serverNameExampleV1.CreateUserExampleRequest) (*serverNameExampleV1.UpsertUserExampleReply, error) {
+	// implement me
+	// If required, fill in the code to fetch data from other rpc servers here.
+	return c.userExampleServiceCli.Upsert(ctx, req)
+}
+
 func (c *userExampleServiceClient) DeleteByID(ctx context.Context, req *serverNameExampleV1.DeleteUserExampleByIDRequest) (*serverNameExampleV1.DeleteUserExampleByIDReply, error) {
 	// implement me
 	// If required, fill in the code to fetch data from other rpc servers here.
@@ -40,6 +52,12 @@ func (c *userExampleServiceClient) UpdateByID(ctx context.Context, req *serverNa
 	return c.userExampleServiceCli.UpdateByID(ctx, req)
 }
 
+func (c *userExampleServiceClient) UpdateByIDs(ctx context.Context, req *serverNameExampleV1.UpdateUserExampleByIDsRequest) (*serverNameExampleV1.UpdateUserExampleByIDsReply, error) {
+	// implement me
+	// If required, fill in the code to fetch data from other rpc servers here.
+	return c.userExampleServiceCli.UpdateByIDs(ctx, req)
+}
+
 func (c *userExampleServiceClient) GetByID(ctx context.Context, req *serverNameExampleV1.GetUserExampleByIDRequest) (*serverNameExampleV1.GetUserExampleByIDReply, error) {
 	// implement me
 	// If required, fill in the code to fetch data from other rpc servers here.
@@ -57,3 +75,9 @@ func (c *userExampleServiceClient) List(ctx context.Context, req *serverNameExam
 	// If required, fill in the code to fetch data from other rpc servers here.
 	return c.userExampleServiceCli.List(ctx, req)
 }
+
+func (c *userExampleServiceClient) GetByEmail(ctx context.Context, req *serverNameExampleV1.GetUserExampleByEmailRequest) (*serverNameExampleV1.GetUserExampleByEmailReply, error) {
+	// implement me
+	// If required, fill in the code to fetch data from other rpc servers here.
+	return c.userExampleServiceCli.GetByEmail(ctx, req)
+}
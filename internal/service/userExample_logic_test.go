@@ -32,6 +32,16 @@ func TestNewUserExampleServiceClient(t *testing.T) {
 		t.Log(reply, err)
 		cancel()
 	})
+	utils.SafeRunWithTimeout(time.Second, func(cancel context.CancelFunc) {
+		reply, err := cli.CreateBatch(ctx, nil)
+		t.Log(reply, err)
+		cancel()
+	})
+	utils.SafeRunWithTimeout(time.Second, func(cancel context.CancelFunc) {
+		reply, err := cli.Upsert(ctx, nil)
+		t.Log(reply, err)
+		cancel()
+	})
 	utils.SafeRunWithTimeout(time.Second, func(cancel context.CancelFunc) {
 		reply, err := cli.DeleteByID(ctx, nil)
 		t.Log(reply, err)
@@ -42,6 +52,11 @@ func TestNewUserExampleServiceClient(t *testing.T) {
 		t.Log(reply, err)
 		cancel()
 	})
+	utils.SafeRunWithTimeout(time.Second, func(cancel context.CancelFunc) {
+		reply, err := cli.UpdateByIDs(ctx, nil)
+		t.Log(reply, err)
+		cancel()
+	})
 	utils.SafeRunWithTimeout(time.Second, func(cancel context.CancelFunc) {
 		reply, err := cli.GetByID(ctx, nil)
 		t.Log(reply, err)
@@ -57,5 +72,10 @@ func TestNewUserExampleServiceClient(t *testing.T) {
 		t.Log(reply, err)
 		cancel()
 	})
+	utils.SafeRunWithTimeout(time.Second, func(cancel context.CancelFunc) {
+		reply, err := cli.GetByEmail(ctx, nil)
+		t.Log(reply, err)
+		cancel()
+	})
 
 }
@@ -89,6 +89,48 @@ func Test_userExampleService_Create(t *testing.T) {
 	// delete the templates code end
 }
 
+func Test_userExampleService_CreateBatch(t *testing.T) {
+	s := newUserExampleService()
+	defer s.Close()
+	req := &serverNameExampleV1.CreateUserExampleRequest{}
+	_ = copier.Copy(req, s.TestData.(*model.UserExample))
+	testData := &serverNameExampleV1.CreateBatchUserExampleRequest{
+		UserExamples: []*serverNameExampleV1.CreateUserExampleRequest{req},
+	}
+
+	s.MockDao.SQLMock.ExpectBegin()
+	s.MockDao.SQLMock.ExpectExec("INSERT INTO .*").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.MockDao.SQLMock.ExpectCommit()
+
+	reply, err := s.IServiceClient.(serverNameExampleV1.UserExampleServiceClient).CreateBatch(s.Ctx, testData)
+	t.Log(err, reply.String())
+
+	// empty list error test
+	testData.UserExamples = nil
+	reply, err = s.IServiceClient.(serverNameExampleV1.UserExampleServiceClient).CreateBatch(s.Ctx, testData)
+	assert.Error(t, err)
+}
+
+func Test_userExampleService_Upsert(t *testing.T) {
+	s := newUserExampleService()
+	defer s.Close()
+	testData := &serverNameExampleV1.CreateUserExampleRequest{}
+	_ = copier.Copy(testData, s.TestData.(*model.UserExample))
+
+	s.MockDao.SQLMock.ExpectBegin()
+	s.MockDao.SQLMock.ExpectExec("INSERT INTO .*").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.MockDao.SQLMock.ExpectCommit()
+
+	reply, err := s.IServiceClient.(serverNameExampleV1.UserExampleServiceClient).Upsert(s.Ctx, testData)
+	t.Log(err, reply.String())
+
+	// upsert error test
+	reply, err = s.IServiceClient.(serverNameExampleV1.UserExampleServiceClient).Upsert(s.Ctx, testData)
+	assert.Error(t, err)
+}
+
 func Test_userExampleService_DeleteByID(t *testing.T) {
 	s := newUserExampleService()
 	defer s.Close()
@@ -146,6 +188,42 @@ func Test_userExampleService_UpdateByID(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func Test_userExampleService_UpdateByIDs(t *testing.T) {
+	s := newUserExampleService()
+	defer s.Close()
+	data := s.TestData.(*model.UserExample)
+	testData := &serverNameExampleV1.UpdateUserExampleByIDsRequest{
+		UserExample: &serverNameExampleV1.UserExample{},
+	}
+
+	// empty ids error test
+	reply, err := s.IServiceClient.(serverNameExampleV1.UserExampleServiceClient).UpdateByIDs(s.Ctx, testData)
+	assert.Error(t, err)
+
+	// no fields error test
+	testData.Ids = []uint64{data.ID}
+	reply, err = s.IServiceClient.(serverNameExampleV1.UserExampleServiceClient).UpdateByIDs(s.Ctx, testData)
+	assert.Error(t, err)
+
+	// no values error test
+	testData.UserExample = nil
+	reply, err = s.IServiceClient.(serverNameExampleV1.UserExampleServiceClient).UpdateByIDs(s.Ctx, testData)
+	assert.Error(t, err)
+	t.Log(reply.String())
+	// delete the templates code start 2
+
+	s.MockDao.SQLMock.ExpectBegin()
+	s.MockDao.SQLMock.ExpectExec("UPDATE .*").
+		WithArgs(10, s.MockDao.AnyTime, data.ID). // Modified according to the actual number of parameters
+		WillReturnResult(sqlmock.NewResult(int64(data.ID), 1))
+	s.MockDao.SQLMock.ExpectCommit()
+
+	testData.UserExample = &serverNameExampleV1.UserExample{Age: 10}
+	reply, err = s.IServiceClient.(serverNameExampleV1.UserExampleServiceClient).UpdateByIDs(s.Ctx, testData)
+	t.Log(err, reply.String())
+	// delete the templates code end 2
+}
+
 func Test_userExampleService_GetByID(t *testing.T) {
 	s := newUserExampleService()
 	defer s.Close()
@@ -231,6 +309,40 @@ func Test_userExampleService_List(t *testing.T) {
 	assert.Error(t, err)
 }
 
+// delete the templates index code start
+func Test_userExampleService_GetByEmail(t *testing.T) {
+	s := newUserExampleService()
+	defer s.Close()
+	data := s.TestData.(*model.UserExample)
+	data.Email = "foo@bar.com"
+	testData := &serverNameExampleV1.GetUserExampleByEmailRequest{
+		Email: data.Email,
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "email", "created_at", "updated_at"}).
+		AddRow(data.ID, data.Email, data.CreatedAt, data.UpdatedAt)
+
+	s.MockDao.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs(testData.Email).
+		WillReturnRows(rows)
+
+	reply, err := s.IServiceClient.(serverNameExampleV1.UserExampleServiceClient).GetByEmail(s.Ctx, testData)
+	assert.NoError(t, err)
+	t.Log(reply.String())
+
+	// invalid email error test
+	testData.Email = "foo"
+	reply, err = s.IServiceClient.(serverNameExampleV1.UserExampleServiceClient).GetByEmail(s.Ctx, testData)
+	assert.Error(t, err)
+
+	// get error test
+	testData.Email = "unknown@bar.com"
+	reply, err = s.IServiceClient.(serverNameExampleV1.UserExampleServiceClient).GetByEmail(s.Ctx, testData)
+	assert.Error(t, err)
+}
+
+// delete the templates index code end
+
 func Test_covertUserExample(t *testing.T) {
 	testData := &model.UserExample{}
 	testData.ID = 1
@@ -12,9 +12,10 @@ type Result struct {
 
 // Params query parameters
 type Params struct {
-	Page int    `form:"page" binding:"gte=0" json:"page"`      // page number, starting from page 0
-	Size int    `form:"size" binding:"gt=0" json:"size"`       // lines per page
-	Sort string `form:"sort" binding:"" json:"sort,omitempty"` // sorted fields, multi-column sorting separated by commas
+	Page   int    `form:"page" binding:"gte=0" json:"page"`          // page number, starting from page 0
+	Size   int    `form:"size" binding:"gt=0" json:"size"`           // lines per page
+	Sort   string `form:"sort" binding:"" json:"sort,omitempty"`     // sorted fields, multi-column sorting separated by commas
+	Cursor string `form:"cursor" binding:"" json:"cursor,omitempty"` // the nextCursor returned by the previous page, if not empty, the records after the cursor are queried and page is ignored
 
 	Columns []Column `json:"columns,omitempty"` // query conditions
 }
@@ -22,7 +23,9 @@ type Params struct {
 // Column search information
 type Column struct {
 	Name  string      `json:"name"`  // column name
-	Exp   string      `json:"exp"`   // expressions, which default to = when the value is null, have =, ! =, >, >=, <, <=, like
-	Value interface{} `json:"value"` // column value
-	Logic string      `json:"logic"` // logical type, defaults to and when value is null, only &(and), ||(or)
+	Exp   string      `json:"exp"`   // expressions, which default to = when the value is null, have =, ! =, >, >=, <, <=, like, prefix, suffix, in, notin, between, isnull, notnull
+	Value interface{} `json:"value"` // column value, the value of in, notin and between is an array or a string separated by commas
+	Logic string      `json:"logic"` // logical type connecting with the next column, defaults to and when value is null, only &(and), ||(or)
+
+	Columns []Column `json:"columns,omitempty"` // nested conditions in parentheses, if not empty, the name, exp and value are ignored
 }
@@ -3,11 +3,20 @@ package types
 import (
 	"time"
 
+	"github.com/zhufuyi/sponge/internal/model"
 	"github.com/zhufuyi/sponge/pkg/mysql/query"
+
+	"gorm.io/datatypes"
 )
 
 var _ time.Time
 
+// used by the typed JSON columns
+var (
+	_ datatypes.JSON
+	_ *model.UserExample
+)
+
 // todo generate the request and response struct to here
 // delete the templates code start
 
@@ -50,8 +59,24 @@ type GetUserExampleByIDRespond struct {
 	UpdatedAt time.Time `json:"updated_at"` // update time
 }
 
+// GetUserExampleByEmailRequest request params of the unique index email
+type GetUserExampleByEmailRequest struct {
+	Email string `uri:"email" binding:""` // email
+}
+
 // delete the templates code end
 
+// CreateUserExamplesRequest request form of creating multiple records
+type CreateUserExamplesRequest struct {
+	UserExamples []CreateUserExampleRequest `json:"userExamples" binding:"min=1,dive"` // userExample list
+}
+
+// UpdateUserExamplesByIDsRequest request form of updating multiple records with the same non-zero fields
+type UpdateUserExamplesByIDsRequest struct {
+	IDs []uint64 `json:"ids" binding:"min=1"` // id list
+	UpdateUserExampleByIDRequest
+}
+
 // DeleteUserExamplesByIDsRequest request form ids
 type DeleteUserExamplesByIDsRequest struct {
 	IDs []uint64 `json:"ids" binding:"min=1"` // id list
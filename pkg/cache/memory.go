@@ -40,10 +40,22 @@ func NewMemoryCache(keyPrefix string, encoding encoding.Encoding, newObject func
 
 // Set data
 func (m *memoryCache) Set(ctx context.Context, key string, val interface{}, expiration time.Duration) error {
+	return m.set(key, val, expiration, nil)
+}
+
+// SetWithSoftTTL set data with the soft expiration
+func (m *memoryCache) SetWithSoftTTL(ctx context.Context, key string, val interface{}, softTTL time.Duration, hardTTL time.Duration, delta time.Duration) error {
+	return m.set(key, val, hardTTL, newSoftExpiration(softTTL, delta))
+}
+
+func (m *memoryCache) set(key string, val interface{}, expiration time.Duration, softExpiration *SoftExpiration) error {
 	buf, err := encoding.Marshal(m.encoding, val)
 	if err != nil {
 		return fmt.Errorf("encoding.Marshal error: %v, key=%s, val=%+v ", err, key, val)
 	}
+	if softExpiration != nil {
+		buf = encodeSoftExpiration(softExpiration, buf)
+	}
 	cacheKey, err := BuildCacheKey(m.KeyPrefix, key)
 	if err != nil {
 		return fmt.Errorf("BuildCacheKey error: %v, key=%s", err, key)
@@ -58,26 +70,33 @@ func (m *memoryCache) Set(ctx context.Context, key string, val interface{}, expi
 
 // Get data
 func (m *memoryCache) Get(ctx context.Context, key string, val interface{}) error {
+	_, err := m.GetWithSoftTTL(ctx, key, val)
+	return err
+}
+
+// GetWithSoftTTL get data and the soft expiration
+func (m *memoryCache) GetWithSoftTTL(ctx context.Context, key string, val interface{}) (*SoftExpiration, error) {
 	cacheKey, err := BuildCacheKey(m.KeyPrefix, key)
 	if err != nil {
-		return fmt.Errorf("BuildCacheKey error: %v, key=%s", err, key)
+		return nil, fmt.Errorf("BuildCacheKey error: %v, key=%s", err, key)
 	}
 
 	data, ok := m.client.Get(cacheKey)
 	if !ok {
-		return CacheNotFound
+		return nil, CacheNotFound
 	}
 
 	if string(data.([]byte)) == NotFoundPlaceholder {
-		return ErrPlaceholder
+		return nil, ErrPlaceholder
 	}
 
-	err = encoding.Unmarshal(m.encoding, data.([]byte), val)
+	buf, softExpiration := decodeSoftExpiration(data.([]byte))
+	err = encoding.Unmarshal(m.encoding, buf, val)
 	if err != nil {
-		return fmt.Errorf("encoding.Unmarshal error: %v, key=%s, cacheKey=%s, type=%v, json=%+v ",
-			err, key, cacheKey, reflect.TypeOf(val), string(data.([]byte)))
+		return nil, fmt.Errorf("encoding.Unmarshal error: %v, key=%s, cacheKey=%s, type=%v, json=%+v ",
+			err, key, cacheKey, reflect.TypeOf(val), string(buf))
 	}
-	return nil
+	return softExpiration, nil
 }
 
 // Del delete data
@@ -86,12 +105,13 @@ func (m *memoryCache) Del(ctx context.Context, keys ...string) error {
 		return nil
 	}
 
-	key := keys[0]
-	cacheKey, err := BuildCacheKey(m.KeyPrefix, key)
-	if err != nil {
-		return fmt.Errorf("build cache key error, err=%v, key=%s", err, key)
+	for _, key := range keys {
+		cacheKey, err := BuildCacheKey(m.KeyPrefix, key)
+		if err != nil {
+			return fmt.Errorf("build cache key error, err=%v, key=%s", err, key)
+		}
+		m.client.Del(cacheKey)
 	}
-	m.client.Del(cacheKey)
 	return nil
 }
 
@@ -107,6 +127,18 @@ func (m *memoryCache) MultiSet(ctx context.Context, valueMap map[string]interfac
 	return nil
 }
 
+// MultiSetWithSoftTTL multiple set data with the soft expiration
+func (m *memoryCache) MultiSetWithSoftTTL(ctx context.Context, valueMap map[string]interface{}, softTTL time.Duration, hardTTL time.Duration, delta time.Duration) error {
+	softExpiration := newSoftExpiration(softTTL, delta)
+	for key, value := range valueMap {
+		err := m.set(key, value, hardTTL, softExpiration)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // MultiGet multiple get data
 func (m *memoryCache) MultiGet(ctx context.Context, keys []string, value interface{}) error {
 	valueMap := reflect.ValueOf(value)
@@ -0,0 +1,374 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/zhufuyi/sponge/pkg/encoding"
+	"github.com/zhufuyi/sponge/pkg/krand"
+	"github.com/zhufuyi/sponge/pkg/logger"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// DefaultInvalidationChannel the redis channel that publishes the keys to be deleted from the local cache
+const DefaultInvalidationChannel = "sponge:cache:invalidation"
+
+// MultiLevelOption set the options of the multi-level cache
+type MultiLevelOption func(*multiLevelOptions)
+
+type multiLevelOptions struct {
+	localExpiration time.Duration
+	channel         string
+}
+
+func defaultMultiLevelOptions() *multiLevelOptions {
+	return &multiLevelOptions{
+		localExpiration: time.Minute,
+		channel:         DefaultInvalidationChannel,
+	}
+}
+
+func (o *multiLevelOptions) apply(opts ...MultiLevelOption) {
+	for _, opt := range opts {
+		opt(o)
+	}
+}
+
+// WithLocalExpiration set the expiration of the local cache, it is the upper limit of the stale data
+// if the invalidation message is lost, default is 1 minute
+func WithLocalExpiration(d time.Duration) MultiLevelOption {
+	return func(o *multiLevelOptions) {
+		if d > 0 {
+			o.localExpiration = d
+		}
+	}
+}
+
+// WithInvalidationChannel set the redis channel of the invalidation messages, default is DefaultInvalidationChannel
+func WithInvalidationChannel(channel string) MultiLevelOption {
+	return func(o *multiLevelOptions) {
+		if channel != "" {
+			o.channel = channel
+		}
+	}
+}
+
+// multiLevelCache reads through the local memory cache (L1) and then redis (L2), the keys written by Set
+// and deleted by Del are published to the other instances to delete their local cache, the messages
+// published by itself are ignored.
+type multiLevelCache struct {
+	id              string // the messages published by itself are ignored
+	local           *memoryCache
+	remote          *redisCache
+	client          redis.UniversalClient
+	channel         string
+	localExpiration time.Duration
+}
+
+// NewMultiLevelCache create a two-level cache of local memory and redis, all instances of the service must
+// use the same redis and channel, so that the local cache of every instance is deleted when the data changes.
+func NewMultiLevelCache(client redis.UniversalClient, keyPrefix string, encoding encoding.Encoding,
+	newObject func() interface{}, opts ...MultiLevelOption) Cache {
+	o := defaultMultiLevelOptions()
+	o.apply(opts...)
+
+	c := &multiLevelCache{
+		id:              krand.String(krand.R_All, 16),
+		local:           NewMemoryCache(keyPrefix, encoding, newObject).(*memoryCache),
+		remote:          NewRedisCache(client, keyPrefix, encoding, newObject).(*redisCache),
+		client:          client,
+		channel:         o.channel,
+		localExpiration: o.localExpiration,
+	}
+	subscribeInvalidation(client, o.channel, c)
+
+	return c
+}
+
+// Set data to redis and local cache
+func (c *multiLevelCache) Set(ctx context.Context, key string, val interface{}, expiration time.Duration) error {
+	err := c.remote.Set(ctx, key, val, expiration)
+	if err != nil {
+		return err
+	}
+	_ = c.local.Set(ctx, key, val, c.localTTL(expiration))
+
+	c.invalidate(ctx, key)
+	return nil
+}
+
+// SetWithSoftTTL set data with the soft expiration to redis and local cache
+func (c *multiLevelCache) SetWithSoftTTL(ctx context.Context, key string, val interface{}, softTTL time.Duration, hardTTL time.Duration, delta time.Duration) error {
+	softExpiration := newSoftExpiration(softTTL, delta)
+	err := c.remote.set(ctx, key, val, hardTTL, softExpiration)
+	if err != nil {
+		return err
+	}
+	_ = c.local.set(key, val, c.localTTL(hardTTL), softExpiration)
+
+	c.invalidate(ctx, key)
+	return nil
+}
+
+// Get data from local cache, if not found, get from redis and save to local cache
+func (c *multiLevelCache) Get(ctx context.Context, key string, val interface{}) error {
+	_, err := c.GetWithSoftTTL(ctx, key, val)
+	return err
+}
+
+// GetWithSoftTTL get data and the soft expiration from local cache, if not found, get from redis and save to local cache
+func (c *multiLevelCache) GetWithSoftTTL(ctx context.Context, key string, val interface{}) (*SoftExpiration, error) {
+	softExpiration, err := c.local.GetWithSoftTTL(ctx, key, val)
+	if err == nil || errors.Is(err, ErrPlaceholder) {
+		return softExpiration, err
+	}
+
+	softExpiration, err = c.remote.GetWithSoftTTL(ctx, key, val)
+	if err != nil {
+		if errors.Is(err, ErrPlaceholder) {
+			_ = c.setLocalWithNotFound(key)
+		}
+		return nil, err
+	}
+	_ = c.local.set(key, val, c.localExpiration, softExpiration)
+
+	return softExpiration, nil
+}
+
+// MultiSet multiple set data to redis and local cache
+func (c *multiLevelCache) MultiSet(ctx context.Context, valueMap map[string]interface{}, expiration time.Duration) error {
+	if len(valueMap) == 0 {
+		return nil
+	}
+
+	err := c.remote.MultiSet(ctx, valueMap, expiration)
+	if err != nil {
+		return err
+	}
+	_ = c.local.MultiSet(ctx, valueMap, c.localTTL(expiration))
+
+	c.invalidate(ctx, mapKeys(valueMap)...)
+	return nil
+}
+
+// MultiSetWithSoftTTL multiple set data with the soft expiration to redis and local cache
+func (c *multiLevelCache) MultiSetWithSoftTTL(ctx context.Context, valueMap map[string]interface{}, softTTL time.Duration, hardTTL time.Duration, delta time.Duration) error {
+	if len(valueMap) == 0 {
+		return nil
+	}
+
+	softExpiration := newSoftExpiration(softTTL, delta)
+	err := c.remote.multiSet(ctx, valueMap, hardTTL, softExpiration)
+	if err != nil {
+		return err
+	}
+	for key, value := range valueMap {
+		_ = c.local.set(key, value, c.localTTL(hardTTL), softExpiration)
+	}
+
+	c.invalidate(ctx, mapKeys(valueMap)...)
+	return nil
+}
+
+// MultiGet multiple get data from local cache, the keys not found are got from redis and saved to local cache,
+// the result is keyed by the cache key, the same as the redis cache
+func (c *multiLevelCache) MultiGet(ctx context.Context, keys []string, value interface{}) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	valueMap := reflect.ValueOf(value)
+	// the result of the local cache is keyed by the key, it is saved to value by the cache key
+	localMap := reflect.MakeMap(valueMap.Type())
+	_ = c.local.MultiGet(ctx, keys, localMap.Interface())
+	var missKeys []string
+	for _, key := range keys {
+		v := localMap.MapIndex(reflect.ValueOf(key))
+		if !v.IsValid() {
+			missKeys = append(missKeys, key)
+			continue
+		}
+		cacheKey, err := BuildCacheKey(c.local.KeyPrefix, key)
+		if err != nil {
+			return fmt.Errorf("BuildCacheKey error: %v, key=%s", err, key)
+		}
+		valueMap.SetMapIndex(reflect.ValueOf(cacheKey), v)
+	}
+	if len(missKeys) == 0 {
+		return nil
+	}
+
+	err := c.remote.MultiGet(ctx, missKeys, value)
+	if err != nil {
+		return err
+	}
+	for _, key := range missKeys {
+		cacheKey, _ := BuildCacheKey(c.remote.KeyPrefix, key)
+		if v := valueMap.MapIndex(reflect.ValueOf(cacheKey)); v.IsValid() {
+			_ = c.local.Set(ctx, key, v.Interface(), c.localExpiration)
+		}
+	}
+
+	return nil
+}
+
+// Del delete data from redis and local cache, and delete the local cache of the other instances
+func (c *multiLevelCache) Del(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	err := c.remote.Del(ctx, keys...)
+	if err != nil {
+		return err
+	}
+	_ = c.local.Del(ctx, keys...)
+
+	c.invalidate(ctx, keys...)
+	return nil
+}
+
+// SetCacheWithNotFound set not found to redis and local cache
+func (c *multiLevelCache) SetCacheWithNotFound(ctx context.Context, key string) error {
+	err := c.remote.SetCacheWithNotFound(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	_ = c.setLocalWithNotFound(key)
+
+	return nil
+}
+
+func (c *multiLevelCache) setLocalWithNotFound(key string) error {
+	cacheKey, err := BuildCacheKey(c.local.KeyPrefix, key)
+	if err != nil {
+		return err
+	}
+	if !c.local.client.SetWithTTL(cacheKey, []byte(NotFoundPlaceholder), 0, c.localTTL(DefaultNotFoundExpireTime)) {
+		return errors.New("SetWithTTL failed")
+	}
+	return nil
+}
+
+// the local data does not live longer than the data of redis
+func (c *multiLevelCache) localTTL(expiration time.Duration) time.Duration {
+	if expiration > 0 && expiration < c.localExpiration {
+		return expiration
+	}
+	return c.localExpiration
+}
+
+// the message of the keys to be deleted from the local cache
+type invalidationMessage struct {
+	From string   `json:"from"`
+	Keys []string `json:"keys"`
+}
+
+// the data has been written to redis if the publish fails, so the error of publish is only logged,
+// the local cache of the other instances is deleted after the local expiration
+func (c *multiLevelCache) invalidate(ctx context.Context, keys ...string) {
+	if err := c.publish(ctx, keys...); err != nil {
+		logger.Warn("publish cache invalidation error, the local cache of the other instances is deleted after the local expiration",
+			logger.Err(err), logger.Any("keys", keys))
+	}
+}
+
+func mapKeys(valueMap map[string]interface{}) []string {
+	keys := make([]string, 0, len(valueMap))
+	for key := range valueMap {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (c *multiLevelCache) publish(ctx context.Context, keys ...string) error {
+	msg := &invalidationMessage{From: c.id, Keys: make([]string, 0, len(keys))}
+	for _, key := range keys {
+		cacheKey, err := BuildCacheKey(c.local.KeyPrefix, key)
+		if err != nil {
+			continue
+		}
+		msg.Keys = append(msg.Keys, cacheKey)
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	err = c.client.Publish(ctx, c.channel, payload).Err()
+	if err != nil {
+		return fmt.Errorf("c.client.Publish error: %v, channel=%s", err, c.channel)
+	}
+	return nil
+}
+
+// the caches that share the redis client and channel are invalidated by one subscription
+type invalidationSubscriber struct {
+	mu     sync.RWMutex
+	caches []*multiLevelCache
+}
+
+type subscriberKey struct {
+	client  redis.UniversalClient
+	channel string
+}
+
+var (
+	subscribers   = make(map[subscriberKey]*invalidationSubscriber)
+	subscribersMu sync.Mutex
+)
+
+func subscribeInvalidation(client redis.UniversalClient, channel string, c *multiLevelCache) {
+	subscribersMu.Lock()
+	defer subscribersMu.Unlock()
+
+	key := subscriberKey{client: client, channel: channel}
+	s, ok := subscribers[key]
+	if !ok {
+		s = &invalidationSubscriber{}
+		subscribers[key] = s
+		// the messages are received until the redis client is closed, it reconnects automatically
+		go s.run(key, client.Subscribe(context.Background(), channel))
+	}
+
+	s.mu.Lock()
+	s.caches = append(s.caches, c)
+	s.mu.Unlock()
+}
+
+func (s *invalidationSubscriber) run(key subscriberKey, pubSub *redis.PubSub) {
+	defer func() {
+		_ = pubSub.Close()
+		// the channel is closed after the redis client is closed, remove the subscription of the client
+		subscribersMu.Lock()
+		if subscribers[key] == s {
+			delete(subscribers, key)
+		}
+		subscribersMu.Unlock()
+	}()
+
+	for msg := range pubSub.Channel() {
+		m := &invalidationMessage{}
+		if err := json.Unmarshal([]byte(msg.Payload), m); err != nil {
+			continue
+		}
+
+		s.mu.RLock()
+		for _, c := range s.caches {
+			if c.id == m.From {
+				continue
+			}
+			for _, cacheKey := range m.Keys {
+				c.local.client.Del(cacheKey)
+			}
+		}
+		s.mu.RUnlock()
+	}
+}
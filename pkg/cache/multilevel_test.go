@@ -0,0 +1,243 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zhufuyi/sponge/pkg/encoding"
+	"github.com/zhufuyi/sponge/pkg/gotest"
+	"github.com/zhufuyi/sponge/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newMultiLevelCache(opts ...MultiLevelOption) *gotest.Cache {
+	record1 := &redisUser{
+		ID:   1,
+		Name: "foo",
+	}
+	record2 := &redisUser{
+		ID:   2,
+		Name: "bar",
+	}
+
+	testData := map[string]interface{}{
+		utils.Uint64ToStr(record1.ID): record1,
+		utils.Uint64ToStr(record2.ID): record2,
+	}
+
+	c := gotest.NewCache(testData)
+	cachePrefix := ""
+	c.ICache = NewMultiLevelCache(c.RedisClient, cachePrefix, encoding.JSONEncoding{}, func() interface{} {
+		return &redisUser{}
+	}, opts...)
+
+	return c
+}
+
+func TestMultiLevelCache(t *testing.T) {
+	c := newMultiLevelCache(WithLocalExpiration(time.Minute), WithInvalidationChannel("test:invalidation"))
+	defer c.Close()
+	testData := c.TestDataSlice[0].(*redisUser)
+	iCache := c.ICache.(Cache)
+
+	key := utils.Uint64ToStr(testData.ID)
+	err := iCache.Set(c.Ctx, key, c.TestDataMap[key], time.Minute)
+	assert.NoError(t, err)
+	err = iCache.Set(c.Ctx, key, c.TestDataMap[key], 0)
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 10)
+
+	val := &redisUser{}
+	err = iCache.Get(c.Ctx, key, val)
+	assert.NoError(t, err)
+	assert.Equal(t, testData.Name, val.Name)
+
+	err = iCache.Del(c.Ctx, key)
+	assert.NoError(t, err)
+	err = iCache.Get(c.Ctx, key, val)
+	assert.ErrorIs(t, err, CacheNotFound)
+
+	err = iCache.MultiSet(c.Ctx, c.TestDataMap, time.Minute)
+	assert.NoError(t, err)
+	err = iCache.MultiSet(c.Ctx, c.TestDataMap, 0)
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 10)
+
+	var keys []string
+	for k := range c.TestDataMap {
+		keys = append(keys, k)
+	}
+	vals := make(map[string]*redisUser)
+	err = iCache.MultiGet(c.Ctx, keys, vals)
+	assert.NoError(t, err)
+	assert.Equal(t, len(c.TestDataSlice), len(vals))
+
+	err = iCache.SetCacheWithNotFound(c.Ctx, "not_found")
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 10)
+	err = iCache.Get(c.Ctx, "not_found", val)
+	assert.ErrorIs(t, err, ErrPlaceholder)
+}
+
+func TestMultiLevelCache_readThrough(t *testing.T) {
+	c := newMultiLevelCache()
+	defer c.Close()
+	iCache := c.ICache.(Cache)
+
+	// the data in redis is saved to the local cache
+	_, err := c.RedisClient.Set(c.Ctx, "1", `{"ID":1,"Name":"foo"}`, time.Minute).Result()
+	assert.NoError(t, err)
+	val := &redisUser{}
+	err = iCache.Get(c.Ctx, "1", val)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", val.Name)
+	time.Sleep(time.Millisecond * 10)
+
+	// read from the local cache
+	_, err = c.RedisClient.Set(c.Ctx, "1", `{"ID":1,"Name":"bar"}`, time.Minute).Result()
+	assert.NoError(t, err)
+	err = iCache.Get(c.Ctx, "1", val)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", val.Name)
+
+	// the keys not found in the local cache are got from redis
+	_, err = c.RedisClient.Set(c.Ctx, "2", `{"ID":2,"Name":"bar"}`, time.Minute).Result()
+	assert.NoError(t, err)
+	vals := make(map[string]*redisUser)
+	err = iCache.MultiGet(c.Ctx, []string{"1", "2", "3"}, vals)
+	assert.NoError(t, err)
+	assert.Len(t, vals, 2)
+	assert.Equal(t, "foo", vals["1"].Name)
+	assert.Equal(t, "bar", vals["2"].Name)
+}
+
+func TestMultiLevelCache_localExpiration(t *testing.T) {
+	c := newMultiLevelCache(WithLocalExpiration(time.Millisecond * 100))
+	defer c.Close()
+	iCache := c.ICache.(Cache)
+
+	err := iCache.Set(c.Ctx, "1", &redisUser{ID: 1, Name: "foo"}, time.Minute)
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 10)
+
+	// the local cache is expired, the data is got from redis
+	_, err = c.RedisClient.Set(c.Ctx, "1", `{"ID":1,"Name":"bar"}`, time.Minute).Result()
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 200)
+	val := &redisUser{}
+	err = iCache.Get(c.Ctx, "1", val)
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", val.Name)
+}
+
+func TestMultiLevelCache_invalidation(t *testing.T) {
+	c := newMultiLevelCache()
+	defer c.Close()
+	// another instance of the service
+	replica := NewMultiLevelCache(c.RedisClient, "", encoding.JSONEncoding{}, func() interface{} {
+		return &redisUser{}
+	})
+	iCache := c.ICache.(Cache)
+	time.Sleep(time.Millisecond * 100)
+
+	err := iCache.Set(c.Ctx, "1", &redisUser{ID: 1, Name: "foo"}, time.Minute)
+	assert.NoError(t, err)
+	val := &redisUser{}
+	err = replica.Get(c.Ctx, "1", val)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", val.Name)
+	time.Sleep(time.Millisecond * 10)
+
+	// the local cache of the replica is deleted by the message of set, the new data is got from redis
+	err = iCache.Set(c.Ctx, "1", &redisUser{ID: 1, Name: "bar"}, time.Minute)
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 100)
+	err = replica.Get(c.Ctx, "1", val)
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", val.Name)
+
+	// the message published by itself is ignored, the local cache is kept
+	val = &redisUser{}
+	err = iCache.Get(c.Ctx, "1", val)
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", val.Name)
+	_, err = c.RedisClient.Set(c.Ctx, "1", `{"ID":1,"Name":"baz"}`, time.Minute).Result()
+	assert.NoError(t, err)
+	err = iCache.Get(c.Ctx, "1", val)
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", val.Name)
+
+	// the local cache of the replica is deleted by the message
+	err = iCache.Del(c.Ctx, "1")
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 100)
+	err = replica.Get(c.Ctx, "1", val)
+	assert.ErrorIs(t, err, CacheNotFound)
+}
+
+func TestMultiLevelCache_publishError(t *testing.T) {
+	c := newMultiLevelCache()
+	iCache := c.ICache.(Cache)
+	c.Close()
+
+	err := iCache.Set(c.Ctx, "1", &redisUser{ID: 1, Name: "foo"}, time.Minute)
+	assert.Error(t, err)
+	err = iCache.Del(c.Ctx, "1")
+	assert.Error(t, err)
+
+	// only the publish failed, the data has been deleted, the error is not returned
+	c = newMultiLevelCache()
+	defer c.Close()
+	closed := gotest.NewCache(nil)
+	closed.Close()
+	iCache = c.ICache.(Cache)
+	iCache.(*multiLevelCache).client = closed.RedisClient
+	err = iCache.Set(c.Ctx, "1", &redisUser{ID: 1, Name: "foo"}, time.Minute)
+	assert.NoError(t, err)
+	err = iCache.Del(c.Ctx, "1")
+	assert.NoError(t, err)
+	err = iCache.Get(c.Ctx, "1", &redisUser{})
+	assert.ErrorIs(t, err, CacheNotFound)
+}
+
+func TestMultiLevelCache_multiGetWithPrefix(t *testing.T) {
+	c := gotest.NewCache(nil)
+	defer c.Close()
+	iCache := NewMultiLevelCache(c.RedisClient, "user", encoding.JSONEncoding{}, func() interface{} {
+		return &redisUser{}
+	})
+
+	err := iCache.Set(c.Ctx, "1", &redisUser{ID: 1, Name: "foo"}, time.Minute)
+	assert.NoError(t, err)
+	_, err = c.RedisClient.Set(c.Ctx, "user:2", `{"ID":2,"Name":"bar"}`, time.Minute).Result()
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 10)
+
+	// the data of the local cache and redis are keyed by the cache key
+	vals := make(map[string]*redisUser)
+	err = iCache.MultiGet(c.Ctx, []string{"1", "2"}, vals)
+	assert.NoError(t, err)
+	assert.Len(t, vals, 2)
+	assert.Equal(t, "foo", vals["user:1"].Name)
+	assert.Equal(t, "bar", vals["user:2"].Name)
+}
+
+func TestMultiLevelCache_unsubscribe(t *testing.T) {
+	c := newMultiLevelCache()
+	key := subscriberKey{client: c.RedisClient, channel: DefaultInvalidationChannel}
+	subscribersMu.Lock()
+	_, ok := subscribers[key]
+	subscribersMu.Unlock()
+	assert.True(t, ok)
+
+	// the subscription is removed after the redis client is closed
+	_ = c.RedisClient.Close()
+	c.Close()
+	assert.Eventually(t, func() bool {
+		subscribersMu.Lock()
+		defer subscribersMu.Unlock()
+		_, ok := subscribers[key]
+		return !ok
+	}, time.Second*5, time.Millisecond*10)
+}
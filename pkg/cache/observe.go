@@ -0,0 +1,305 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/zhufuyi/sponge/pkg/cache"
+
+var (
+	metricsNamespace = "cache"
+
+	metricsLabels = []string{"prefix", "operation"}
+
+	cacheHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "hits_total",
+			Help:      "Total number of cache hits.",
+		}, metricsLabels,
+	)
+
+	cacheMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "misses_total",
+			Help:      "Total number of cache misses.",
+		}, metricsLabels,
+	)
+
+	cachePlaceholderHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "placeholder_hits_total",
+			Help:      "Total number of cache hits of the placeholder, the data is not found in the database.",
+		}, metricsLabels,
+	)
+
+	cacheErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "errors_total",
+			Help:      "Total number of cache errors, miss and placeholder are not errors.",
+		}, metricsLabels,
+	)
+
+	cacheDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "operation_duration_seconds",
+			Help:      "Cache operation latencies in seconds.",
+			// from 100us to 1.6s, the memory cache is much faster than redis
+			Buckets: prometheus.ExponentialBuckets(0.0001, 4, 8),
+		}, metricsLabels,
+	)
+)
+
+// ObserveOption set the options of the observed cache
+type ObserveOption func(*observeOptions)
+
+type observeOptions struct {
+	enableMetrics bool
+	registerers   []prometheus.Registerer
+	enableTrace   bool
+}
+
+func (o *observeOptions) apply(opts ...ObserveOption) {
+	for _, opt := range opts {
+		opt(o)
+	}
+}
+
+// WithMetrics report the hits, misses, placeholder hits, errors and latencies of the cache operations,
+// the metrics are registered to the registerers, default is prometheus.DefaultRegisterer
+func WithMetrics(registerers ...prometheus.Registerer) ObserveOption {
+	return func(o *observeOptions) {
+		o.enableMetrics = true
+		o.registerers = registerers
+	}
+}
+
+// WithTrace create a span for each cache operation
+func WithTrace() ObserveOption {
+	return func(o *observeOptions) {
+		o.enableTrace = true
+	}
+}
+
+// the cache that reports the metrics and spans of the operations
+type observedCache struct {
+	cache         Cache
+	prefix        string
+	enableMetrics bool
+	enableTrace   bool
+}
+
+// NewObservedCache wrap the cache to report the metrics and spans of the operations, prefix is the label of the metrics,
+// e.g. the key prefix of the table, if neither metrics nor trace is enabled, the cache is returned as it is.
+func NewObservedCache(c Cache, prefix string, opts ...ObserveOption) Cache {
+	o := &observeOptions{}
+	o.apply(opts...)
+	if !o.enableMetrics && !o.enableTrace {
+		return c
+	}
+
+	if o.enableMetrics {
+		registerMetrics(o.registerers...)
+	}
+
+	return &observedCache{
+		cache:         c,
+		prefix:        prefix,
+		enableMetrics: o.enableMetrics,
+		enableTrace:   o.enableTrace,
+	}
+}
+
+func registerMetrics(registerers ...prometheus.Registerer) {
+	if len(registerers) == 0 {
+		registerers = []prometheus.Registerer{prometheus.DefaultRegisterer}
+	}
+	collectors := []prometheus.Collector{cacheHits, cacheMisses, cachePlaceholderHits, cacheErrors, cacheDuration}
+	for _, registerer := range registerers {
+		for _, collector := range collectors {
+			// the metrics are shared by all caches, they are registered only once
+			_ = registerer.Register(collector)
+		}
+	}
+}
+
+// the result of the operation
+type observeResult struct {
+	hits         int
+	misses       int
+	placeholders int
+	err          error
+}
+
+// the result of getting a value, not found and placeholder are not errors
+func getResult(err error) *observeResult {
+	switch {
+	case err == nil:
+		return &observeResult{hits: 1}
+	case errors.Is(err, CacheNotFound):
+		return &observeResult{misses: 1}
+	case errors.Is(err, ErrPlaceholder):
+		return &observeResult{placeholders: 1}
+	default:
+		return &observeResult{err: err}
+	}
+}
+
+// start observing the operation, call the returned function with the result when the operation is done
+func (c *observedCache) observe(ctx context.Context, operation string, keys int) (context.Context, func(*observeResult)) {
+	start := time.Now()
+	var span trace.Span
+	if c.enableTrace {
+		ctx, span = otel.Tracer(instrumentationName).Start(ctx, "cache."+operation,
+			trace.WithSpanKind(trace.SpanKindInternal),
+			trace.WithAttributes(
+				attribute.String("cache.prefix", c.prefix),
+				attribute.String("cache.operation", operation),
+				attribute.Int("cache.keys", keys),
+			),
+		)
+	}
+
+	return ctx, func(r *observeResult) {
+		if c.enableMetrics {
+			lvs := []string{c.prefix, operation}
+			cacheDuration.WithLabelValues(lvs...).Observe(time.Since(start).Seconds())
+			if r.hits > 0 {
+				cacheHits.WithLabelValues(lvs...).Add(float64(r.hits))
+			}
+			if r.misses > 0 {
+				cacheMisses.WithLabelValues(lvs...).Add(float64(r.misses))
+			}
+			if r.placeholders > 0 {
+				cachePlaceholderHits.WithLabelValues(lvs...).Add(float64(r.placeholders))
+			}
+			if r.err != nil {
+				cacheErrors.WithLabelValues(lvs...).Inc()
+			}
+		}
+
+		if span != nil {
+			span.SetAttributes(
+				attribute.Int("cache.hits", r.hits),
+				attribute.Int("cache.misses", r.misses),
+				attribute.Int("cache.placeholder_hits", r.placeholders),
+			)
+			if r.err != nil {
+				span.RecordError(r.err)
+				span.SetStatus(codes.Error, r.err.Error())
+			}
+			span.End()
+		}
+	}
+}
+
+// Set data
+func (c *observedCache) Set(ctx context.Context, key string, val interface{}, expiration time.Duration) error {
+	ctx, done := c.observe(ctx, "set", 1)
+	err := c.cache.Set(ctx, key, val, expiration)
+	done(&observeResult{err: err})
+	return err
+}
+
+// Get data
+func (c *observedCache) Get(ctx context.Context, key string, val interface{}) error {
+	ctx, done := c.observe(ctx, "get", 1)
+	err := c.cache.Get(ctx, key, val)
+	done(getResult(err))
+	return err
+}
+
+// MultiSet multiple set data
+func (c *observedCache) MultiSet(ctx context.Context, valMap map[string]interface{}, expiration time.Duration) error {
+	ctx, done := c.observe(ctx, "multi_set", len(valMap))
+	err := c.cache.MultiSet(ctx, valMap, expiration)
+	done(&observeResult{err: err})
+	return err
+}
+
+// MultiGet multiple get data, the keys not in the valueMap are misses
+func (c *observedCache) MultiGet(ctx context.Context, keys []string, valueMap interface{}) error {
+	ctx, done := c.observe(ctx, "multi_get", len(keys))
+	before := mapLen(valueMap)
+	err := c.cache.MultiGet(ctx, keys, valueMap)
+	if err != nil {
+		done(&observeResult{err: err})
+		return err
+	}
+	hits := mapLen(valueMap) - before
+	done(&observeResult{hits: hits, misses: len(keys) - hits})
+	return nil
+}
+
+// Del delete data
+func (c *observedCache) Del(ctx context.Context, keys ...string) error {
+	ctx, done := c.observe(ctx, "del", len(keys))
+	err := c.cache.Del(ctx, keys...)
+	done(&observeResult{err: err})
+	return err
+}
+
+// SetCacheWithNotFound set the placeholder of the not found data
+func (c *observedCache) SetCacheWithNotFound(ctx context.Context, key string) error {
+	ctx, done := c.observe(ctx, "set_not_found", 1)
+	err := c.cache.SetCacheWithNotFound(ctx, key)
+	done(&observeResult{err: err})
+	return err
+}
+
+// SetWithSoftTTL set data with the soft expiration, if the cache is not a SoftCache, the soft expiration is ignored
+func (c *observedCache) SetWithSoftTTL(ctx context.Context, key string, val interface{}, softTTL time.Duration, hardTTL time.Duration, delta time.Duration) error {
+	sc, ok := c.cache.(SoftCache)
+	if !ok {
+		return c.Set(ctx, key, val, hardTTL)
+	}
+	ctx, done := c.observe(ctx, "set", 1)
+	err := sc.SetWithSoftTTL(ctx, key, val, softTTL, hardTTL, delta)
+	done(&observeResult{err: err})
+	return err
+}
+
+// MultiSetWithSoftTTL multiple set data with the soft expiration, if the cache is not a SoftCache, the soft expiration is ignored
+func (c *observedCache) MultiSetWithSoftTTL(ctx context.Context, valueMap map[string]interface{}, softTTL time.Duration, hardTTL time.Duration, delta time.Duration) error {
+	sc, ok := c.cache.(SoftCache)
+	if !ok {
+		return c.MultiSet(ctx, valueMap, hardTTL)
+	}
+	ctx, done := c.observe(ctx, "multi_set", len(valueMap))
+	err := sc.MultiSetWithSoftTTL(ctx, valueMap, softTTL, hardTTL, delta)
+	done(&observeResult{err: err})
+	return err
+}
+
+// GetWithSoftTTL get data and the soft expiration, if the cache is not a SoftCache, the soft expiration is nil
+func (c *observedCache) GetWithSoftTTL(ctx context.Context, key string, val interface{}) (*SoftExpiration, error) {
+	sc, ok := c.cache.(SoftCache)
+	if !ok {
+		return nil, c.Get(ctx, key, val)
+	}
+	ctx, done := c.observe(ctx, "get", 1)
+	softExpiration, err := sc.GetWithSoftTTL(ctx, key, val)
+	done(getResult(err))
+	return softExpiration, err
+}
+
+func mapLen(m interface{}) int {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Map {
+		return 0
+	}
+	return v.Len()
+}
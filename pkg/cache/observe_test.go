@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zhufuyi/sponge/pkg/encoding"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestNewObservedCache(t *testing.T) {
+	newObject := func() interface{} { return &redisUser{} }
+	c := NewMemoryCache("", encoding.JSONEncoding{}, newObject)
+
+	// nothing to observe
+	assert.Equal(t, c, NewObservedCache(c, "user"))
+
+	oc := NewObservedCache(c, "user", WithMetrics(prometheus.NewRegistry()), WithTrace())
+	_, ok := oc.(*observedCache)
+	assert.True(t, ok)
+	_, ok = oc.(SoftCache)
+	assert.True(t, ok)
+}
+
+func TestObservedCache_Metrics(t *testing.T) {
+	c := newRedisCache()
+	defer c.Close()
+	newObject := func() interface{} { return &redisUser{} }
+	registry := prometheus.NewRegistry()
+	iCache := NewObservedCache(NewRedisCache(c.RedisClient, "", encoding.JSONEncoding{}, newObject),
+		"metrics_user", WithMetrics(registry))
+
+	err := iCache.Set(c.Ctx, "1", &redisUser{ID: 1, Name: "foo"}, time.Minute)
+	assert.NoError(t, err)
+	err = iCache.SetCacheWithNotFound(c.Ctx, "2")
+	assert.NoError(t, err)
+
+	val := &redisUser{}
+	_ = iCache.Get(c.Ctx, "1", val) // hit
+	_ = iCache.Get(c.Ctx, "2", val) // placeholder hit
+	_ = iCache.Get(c.Ctx, "3", val) // miss
+	vals := make(map[string]*redisUser)
+	_ = iCache.MultiGet(c.Ctx, []string{"1", "3", "4"}, vals) // 1 hit, 2 misses
+	_, _ = iCache.(SoftCache).GetWithSoftTTL(c.Ctx, "1", val) // hit
+
+	// the value can't be unmarshalled into nil, it is an error
+	_ = iCache.Get(c.Ctx, "1", nil)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(cacheHits.WithLabelValues("metrics_user", "get")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(cacheMisses.WithLabelValues("metrics_user", "get")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(cachePlaceholderHits.WithLabelValues("metrics_user", "get")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(cacheHits.WithLabelValues("metrics_user", "multi_get")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(cacheMisses.WithLabelValues("metrics_user", "multi_get")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(cacheErrors.WithLabelValues("metrics_user", "get")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(cacheErrors.WithLabelValues("metrics_user", "set")))
+
+	count, err := testutil.GatherAndCount(registry, "cache_operation_duration_seconds")
+	assert.NoError(t, err)
+	assert.Greater(t, count, 0)
+
+	// the metrics are registered only once
+	_ = NewObservedCache(NewMemoryCache("", encoding.JSONEncoding{}, newObject), "metrics_user2", WithMetrics(registry))
+}
+
+func TestObservedCache_Trace(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+	otel.SetTracerProvider(tp)
+
+	c := newRedisCache()
+	defer c.Close()
+	newObject := func() interface{} { return &redisUser{} }
+	iCache := NewObservedCache(NewRedisCache(c.RedisClient, "", encoding.JSONEncoding{}, newObject), "trace_user", WithTrace())
+
+	err := iCache.Set(c.Ctx, "1", &redisUser{ID: 1, Name: "foo"}, time.Minute)
+	assert.NoError(t, err)
+	_ = iCache.Get(c.Ctx, "1", &redisUser{})
+	_ = iCache.Get(c.Ctx, "1", nil)
+	err = iCache.Del(c.Ctx, "1")
+	assert.NoError(t, err)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 4)
+	names := make([]string, 0, len(spans))
+	for _, span := range spans {
+		names = append(names, span.Name())
+	}
+	assert.Equal(t, []string{"cache.set", "cache.get", "cache.get", "cache.del"}, names)
+	assert.Equal(t, "Error", spans[2].Status().Code.String())
+	assert.Len(t, spans[2].Events(), 1) // the error
+}
@@ -18,17 +18,21 @@ var CacheNotFound = redis.Nil
 
 // redisCache redis cache object
 type redisCache struct {
-	client            *redis.Client
+	client            redis.UniversalClient
+	isCluster         bool // the keys of MSet, MGet and Del are not in the same slot in cluster
 	KeyPrefix         string
 	encoding          encoding.Encoding
 	DefaultExpireTime time.Duration
 	newObject         func() interface{}
 }
 
-// NewRedisCache new a cache, client parameter can be passed in for unit testing
-func NewRedisCache(client *redis.Client, keyPrefix string, encoding encoding.Encoding, newObject func() interface{}) Cache {
+// NewRedisCache new a cache, the client is a single node, cluster or sentinel client,
+// client parameter can be passed in for unit testing
+func NewRedisCache(client redis.UniversalClient, keyPrefix string, encoding encoding.Encoding, newObject func() interface{}) Cache {
+	_, isCluster := client.(*redis.ClusterClient)
 	return &redisCache{
 		client:    client,
+		isCluster: isCluster,
 		KeyPrefix: keyPrefix,
 		encoding:  encoding,
 		newObject: newObject,
@@ -37,10 +41,22 @@ func NewRedisCache(client *redis.Client, keyPrefix string, encoding encoding.Enc
 
 // Set one value
 func (c *redisCache) Set(ctx context.Context, key string, val interface{}, expiration time.Duration) error {
+	return c.set(ctx, key, val, expiration, nil)
+}
+
+// SetWithSoftTTL set one value with the soft expiration
+func (c *redisCache) SetWithSoftTTL(ctx context.Context, key string, val interface{}, softTTL time.Duration, hardTTL time.Duration, delta time.Duration) error {
+	return c.set(ctx, key, val, hardTTL, newSoftExpiration(softTTL, delta))
+}
+
+func (c *redisCache) set(ctx context.Context, key string, val interface{}, expiration time.Duration, softExpiration *SoftExpiration) error {
 	buf, err := encoding.Marshal(c.encoding, val)
 	if err != nil {
 		return fmt.Errorf("encoding.Marshal error: %v, key=%s, val=%+v ", err, key, val)
 	}
+	if softExpiration != nil {
+		buf = encodeSoftExpiration(softExpiration, buf)
+	}
 
 	cacheKey, err := BuildCacheKey(c.KeyPrefix, key)
 	if err != nil {
@@ -58,35 +74,51 @@ func (c *redisCache) Set(ctx context.Context, key string, val interface{}, expir
 
 // Get one value
 func (c *redisCache) Get(ctx context.Context, key string, val interface{}) error {
+	_, err := c.GetWithSoftTTL(ctx, key, val)
+	return err
+}
+
+// GetWithSoftTTL get one value and the soft expiration
+func (c *redisCache) GetWithSoftTTL(ctx context.Context, key string, val interface{}) (*SoftExpiration, error) {
 	cacheKey, err := BuildCacheKey(c.KeyPrefix, key)
 	if err != nil {
-		return fmt.Errorf("BuildCacheKey error: %v, key=%s", err, key)
+		return nil, fmt.Errorf("BuildCacheKey error: %v, key=%s", err, key)
 	}
 
 	bytes, err := c.client.Get(ctx, cacheKey).Bytes()
 	// NOTE: don't handle the case where redis value is nil
 	// but leave it to the upstream for processing
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// prevent Unmarshal from reporting an error if data is empty
 	if string(bytes) == "" {
-		return nil
+		return nil, nil
 	}
 	if string(bytes) == NotFoundPlaceholder {
-		return ErrPlaceholder
+		return nil, ErrPlaceholder
 	}
+	bytes, softExpiration := decodeSoftExpiration(bytes)
 	err = encoding.Unmarshal(c.encoding, bytes, val)
 	if err != nil {
-		return fmt.Errorf("encoding.Unmarshal error: %v, key=%s, cacheKey=%s, type=%v, json=%+v ",
+		return nil, fmt.Errorf("encoding.Unmarshal error: %v, key=%s, cacheKey=%s, type=%v, json=%+v ",
 			err, key, cacheKey, reflect.TypeOf(val), string(bytes))
 	}
-	return nil
+	return softExpiration, nil
 }
 
 // MultiSet set multiple values
 func (c *redisCache) MultiSet(ctx context.Context, valueMap map[string]interface{}, expiration time.Duration) error {
+	return c.multiSet(ctx, valueMap, expiration, nil)
+}
+
+// MultiSetWithSoftTTL set multiple values with the soft expiration
+func (c *redisCache) MultiSetWithSoftTTL(ctx context.Context, valueMap map[string]interface{}, softTTL time.Duration, hardTTL time.Duration, delta time.Duration) error {
+	return c.multiSet(ctx, valueMap, hardTTL, newSoftExpiration(softTTL, delta))
+}
+
+func (c *redisCache) multiSet(ctx context.Context, valueMap map[string]interface{}, expiration time.Duration, softExpiration *SoftExpiration) error {
 	if len(valueMap) == 0 {
 		return nil
 	}
@@ -101,6 +133,9 @@ func (c *redisCache) MultiSet(ctx context.Context, valueMap map[string]interface
 			fmt.Printf("encoding.Marshal error, %v, value:%v\n", err, value)
 			continue
 		}
+		if softExpiration != nil {
+			buf = encodeSoftExpiration(softExpiration, buf)
+		}
 		cacheKey, err := BuildCacheKey(c.KeyPrefix, key)
 		if err != nil {
 			fmt.Printf("BuildCacheKey error, %v, key:%v\n", err, key)
@@ -110,6 +145,17 @@ func (c *redisCache) MultiSet(ctx context.Context, valueMap map[string]interface
 		paris = append(paris, buf)
 	}
 	pipeline := c.client.Pipeline()
+	if c.isCluster {
+		// the commands of the pipeline are sent to the nodes of the slots of the keys
+		for i := 0; i < len(paris); i = i + 2 {
+			pipeline.Set(ctx, string(paris[i].([]byte)), paris[i+1], expiration)
+		}
+		_, err := pipeline.Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("pipeline.Exec error: %v", err)
+		}
+		return nil
+	}
 	err := pipeline.MSet(ctx, paris...).Err()
 	if err != nil {
 		return fmt.Errorf("pipeline.MSet error: %v", err)
@@ -142,7 +188,7 @@ func (c *redisCache) MultiGet(ctx context.Context, keys []string, value interfac
 		}
 		cacheKeys[index] = cacheKey
 	}
-	values, err := c.client.MGet(ctx, cacheKeys...).Result()
+	values, err := c.mGet(ctx, cacheKeys)
 	if err != nil {
 		return fmt.Errorf("c.client.MGet error: %v, keys=%+v", err, cacheKeys)
 	}
@@ -154,7 +200,8 @@ func (c *redisCache) MultiGet(ctx context.Context, keys []string, value interfac
 			continue
 		}
 		object := c.newObject()
-		err = encoding.Unmarshal(c.encoding, []byte(v.(string)), object)
+		buf, _ := decodeSoftExpiration([]byte(v.(string)))
+		err = encoding.Unmarshal(c.encoding, buf, object)
 		if err != nil {
 			fmt.Printf("unmarshal data error: %+v, key=%s, cacheKey=%s type=%v\n", err, keys[i], cacheKeys[i], reflect.TypeOf(value))
 			continue
@@ -178,13 +225,51 @@ func (c *redisCache) Del(ctx context.Context, keys ...string) error {
 		}
 		cacheKeys[index] = cacheKey
 	}
-	err := c.client.Del(ctx, cacheKeys...).Err()
+	err := c.del(ctx, cacheKeys)
 	if err != nil {
 		return fmt.Errorf("c.client.Del error: %v, keys=%+v", err, cacheKeys)
 	}
 	return nil
 }
 
+// the values of the keys, the value is nil if the key does not exist
+func (c *redisCache) mGet(ctx context.Context, cacheKeys []string) ([]interface{}, error) {
+	if !c.isCluster {
+		return c.client.MGet(ctx, cacheKeys...).Result()
+	}
+
+	pipeline := c.client.Pipeline()
+	cmds := make([]*redis.StringCmd, len(cacheKeys))
+	for i, cacheKey := range cacheKeys {
+		cmds[i] = pipeline.Get(ctx, cacheKey)
+	}
+	_, err := pipeline.Exec(ctx)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	values := make([]interface{}, len(cmds))
+	for i, cmd := range cmds {
+		if val, err := cmd.Result(); err == nil {
+			values[i] = val
+		}
+	}
+	return values, nil
+}
+
+func (c *redisCache) del(ctx context.Context, cacheKeys []string) error {
+	if !c.isCluster {
+		return c.client.Del(ctx, cacheKeys...).Err()
+	}
+
+	pipeline := c.client.Pipeline()
+	for _, cacheKey := range cacheKeys {
+		pipeline.Del(ctx, cacheKey)
+	}
+	_, err := pipeline.Exec(ctx)
+	return err
+}
+
 // SetCacheWithNotFound set value for notfound
 func (c *redisCache) SetCacheWithNotFound(ctx context.Context, key string) error {
 	cacheKey, err := BuildCacheKey(c.KeyPrefix, key)
@@ -8,6 +8,8 @@ import (
 	"github.com/zhufuyi/sponge/pkg/gotest"
 	"github.com/zhufuyi/sponge/pkg/utils"
 
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -78,6 +80,41 @@ func TestRedisCache(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestRedisCache_cluster(t *testing.T) {
+	s, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	client := redis.NewClusterClient(&redis.ClusterOptions{Addrs: []string{s.Addr()}})
+	defer client.Close()
+
+	c := newRedisCache()
+	defer c.Close()
+	iCache := NewRedisCache(client, "", encoding.JSONEncoding{}, func() interface{} {
+		return &redisUser{}
+	})
+
+	err = iCache.MultiSet(c.Ctx, c.TestDataMap, time.Minute)
+	assert.NoError(t, err)
+	ttl := s.TTL("1")
+	assert.Equal(t, time.Minute, ttl)
+
+	keys := []string{"1", "2", "3"}
+	vals := make(map[string]*redisUser)
+	err = iCache.MultiGet(c.Ctx, keys, vals)
+	assert.NoError(t, err)
+	assert.Equal(t, len(c.TestDataSlice), len(vals))
+	assert.Equal(t, "foo", vals["1"].Name)
+
+	err = iCache.Del(c.Ctx, keys...)
+	assert.NoError(t, err)
+	vals = make(map[string]*redisUser)
+	err = iCache.MultiGet(c.Ctx, keys, vals)
+	assert.NoError(t, err)
+	assert.Len(t, vals, 0)
+}
+
 func TestRedisCacheError(t *testing.T) {
 	c := newRedisCache()
 	defer c.Close()
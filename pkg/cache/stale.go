@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/zhufuyi/sponge/pkg/logger"
+)
+
+// SoftCache the cache that stores the soft expiration alongside the value, the value is stale after the soft expiration,
+// but it is still returned until the hard expiration of the cache, so that it can be refreshed in the background
+// instead of all the requests loading it from the database at the moment it expires.
+type SoftCache interface {
+	// SetWithSoftTTL set the value, it is stale after softTTL and deleted after hardTTL,
+	// delta is the time spent loading the value, it is used by the early expiration
+	SetWithSoftTTL(ctx context.Context, key string, val interface{}, softTTL time.Duration, hardTTL time.Duration, delta time.Duration) error
+	// MultiSetWithSoftTTL multiple set the values, see SetWithSoftTTL
+	MultiSetWithSoftTTL(ctx context.Context, valueMap map[string]interface{}, softTTL time.Duration, hardTTL time.Duration, delta time.Duration) error
+	// GetWithSoftTTL get the value and the soft expiration, the soft expiration is nil if the value is set without soft ttl
+	GetWithSoftTTL(ctx context.Context, key string, val interface{}) (*SoftExpiration, error)
+}
+
+// SoftExpiration the logical expiration of the value
+type SoftExpiration struct {
+	ExpireAt time.Time
+	Delta    time.Duration // the time spent loading the value
+}
+
+func newSoftExpiration(softTTL time.Duration, delta time.Duration) *SoftExpiration {
+	return &SoftExpiration{ExpireAt: time.Now().Add(softTTL), Delta: delta}
+}
+
+// IsStale whether the value needs to be refreshed, if beta > 0, the value is refreshed before the expiration by
+// the probabilistic early expiration (XFetch), the larger the beta and the delta, the earlier the refresh,
+// 1.0 is the recommended beta, see https://cseweb.ucsd.edu/~avattani/papers/cache_stampede.pdf
+func (e *SoftExpiration) IsStale(beta float64) bool {
+	if e == nil {
+		return false
+	}
+
+	now := time.Now()
+	if beta > 0 && e.Delta > 0 {
+		// now - delta * beta * log(rand()) >= expiry, rand() is in (0, 1]
+		early := -float64(e.Delta) * beta * math.Log(1-rand.Float64()) //nolint
+		now = now.Add(time.Duration(early))
+	}
+	return !now.Before(e.ExpireAt)
+}
+
+// the soft expiration is stored before the encoded value, the marker can't be the beginning of the encoded value
+var softMarker = []byte{0, 's', 'w', 'r'}
+
+const softHeaderLen = 4 + 8 + 8
+
+func encodeSoftExpiration(e *SoftExpiration, data []byte) []byte {
+	buf := make([]byte, softHeaderLen, softHeaderLen+len(data))
+	copy(buf, softMarker)
+	binary.BigEndian.PutUint64(buf[4:12], uint64(e.ExpireAt.UnixNano()))
+	binary.BigEndian.PutUint64(buf[12:20], uint64(e.Delta))
+	return append(buf, data...)
+}
+
+// the encoded value and the soft expiration, the soft expiration is nil if the value is set without soft ttl
+func decodeSoftExpiration(data []byte) ([]byte, *SoftExpiration) {
+	if len(data) < softHeaderLen || !bytes.Equal(data[:4], softMarker) {
+		return data, nil
+	}
+	return data[softHeaderLen:], &SoftExpiration{
+		ExpireAt: time.Unix(0, int64(binary.BigEndian.Uint64(data[4:12]))),
+		Delta:    time.Duration(binary.BigEndian.Uint64(data[12:20])),
+	}
+}
+
+// RefreshTimeout the timeout of the ctx of the refresh run by RefreshAsync, the queries of the refresh are canceled
+// after the timeout, so that a hung query does not block the refresh of the key
+var RefreshTimeout = time.Second * 10
+
+var refreshingKeys sync.Map
+
+// RefreshAsync run the refresh of the stale value of the key in the background, the refresh of the same key
+// is run only once at the same time, return false if the key is being refreshed. The ctx of the refresh
+// has the values of the ctx, e.g. tenant id and trace span, but it is not canceled when the request ends,
+// it is canceled after RefreshTimeout. The panic of the refresh is recovered and logged.
+func RefreshAsync(ctx context.Context, key string, refresh func(ctx context.Context)) bool {
+	if _, loaded := refreshingKeys.LoadOrStore(key, struct{}{}); loaded {
+		return false
+	}
+
+	go func() {
+		refreshCtx, cancel := context.WithTimeout(detachedContext{parent: ctx}, RefreshTimeout)
+		defer func() {
+			cancel()
+			refreshingKeys.Delete(key)
+			if e := recover(); e != nil {
+				logger.Error("refresh cache panic", logger.Any("panic", e), logger.String("key", key))
+			}
+		}()
+		refresh(refreshCtx)
+	}()
+	return true
+}
+
+// the context that has the values of the parent, but is never canceled
+type detachedContext struct {
+	parent context.Context
+}
+
+func (c detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+
+func (c detachedContext) Done() <-chan struct{} { return nil }
+
+func (c detachedContext) Err() error { return nil }
+
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
@@ -0,0 +1,162 @@
+package cache
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zhufuyi/sponge/pkg/encoding"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSoftExpiration_IsStale(t *testing.T) {
+	var e *SoftExpiration
+	assert.False(t, e.IsStale(0))
+
+	e = newSoftExpiration(time.Minute, 0)
+	assert.False(t, e.IsStale(0))
+	assert.False(t, e.IsStale(1))
+
+	e = newSoftExpiration(-time.Second, 0)
+	assert.True(t, e.IsStale(0))
+
+	// the larger the delta and beta, the earlier the refresh
+	e = newSoftExpiration(time.Second, time.Hour)
+	assert.False(t, e.IsStale(0))
+	count := 0
+	for i := 0; i < 100; i++ {
+		if e.IsStale(1) {
+			count++
+		}
+	}
+	assert.Greater(t, count, 90)
+}
+
+func TestEncodeSoftExpiration(t *testing.T) {
+	e := newSoftExpiration(time.Minute, time.Millisecond*10)
+	data := encodeSoftExpiration(e, []byte(`{"name":"foo"}`))
+	buf, got := decodeSoftExpiration(data)
+	assert.Equal(t, `{"name":"foo"}`, string(buf))
+	assert.Equal(t, e.ExpireAt.UnixNano(), got.ExpireAt.UnixNano())
+	assert.Equal(t, e.Delta, got.Delta)
+
+	buf, got = decodeSoftExpiration([]byte(`{"name":"foo"}`))
+	assert.Equal(t, `{"name":"foo"}`, string(buf))
+	assert.Nil(t, got)
+}
+
+func TestSoftCache(t *testing.T) {
+	c := newRedisCache()
+	defer c.Close()
+	newObject := func() interface{} { return &redisUser{} }
+	caches := map[string]Cache{
+		"memory": NewMemoryCache("", encoding.JSONEncoding{}, newObject),
+		"redis":  NewRedisCache(c.RedisClient, "", encoding.JSONEncoding{}, newObject),
+		"multi":  NewMultiLevelCache(c.RedisClient, "multi", encoding.JSONEncoding{}, newObject),
+	}
+
+	for name, iCache := range caches {
+		t.Run(name, func(t *testing.T) {
+			sc, ok := iCache.(SoftCache)
+			assert.True(t, ok)
+
+			err := sc.SetWithSoftTTL(c.Ctx, "1", &redisUser{ID: 1, Name: "foo"}, time.Minute, time.Hour, time.Millisecond)
+			assert.NoError(t, err)
+			time.Sleep(time.Millisecond * 10)
+			val := &redisUser{}
+			e, err := sc.GetWithSoftTTL(c.Ctx, "1", val)
+			assert.NoError(t, err)
+			assert.Equal(t, "foo", val.Name)
+			assert.False(t, e.IsStale(0))
+			assert.Equal(t, time.Millisecond, e.Delta)
+
+			// the soft expiration is ignored by Get and MultiGet
+			val = &redisUser{}
+			err = iCache.Get(c.Ctx, "1", val)
+			assert.NoError(t, err)
+			assert.Equal(t, "foo", val.Name)
+
+			err = sc.MultiSetWithSoftTTL(c.Ctx, map[string]interface{}{
+				"2": &redisUser{ID: 2, Name: "bar"},
+				"3": &redisUser{ID: 3, Name: "baz"},
+			}, -time.Second, time.Hour, 0)
+			assert.NoError(t, err)
+			time.Sleep(time.Millisecond * 10)
+			e, err = sc.GetWithSoftTTL(c.Ctx, "2", val)
+			assert.NoError(t, err)
+			assert.Equal(t, "bar", val.Name)
+			assert.True(t, e.IsStale(0))
+			vals := make(map[string]*redisUser)
+			err = iCache.MultiGet(c.Ctx, []string{"2", "3"}, vals)
+			assert.NoError(t, err)
+			assert.Len(t, vals, 2)
+
+			// the value set without soft ttl is never stale
+			err = iCache.Set(c.Ctx, "4", &redisUser{ID: 4, Name: "qux"}, time.Hour)
+			assert.NoError(t, err)
+			time.Sleep(time.Millisecond * 10)
+			e, err = sc.GetWithSoftTTL(c.Ctx, "4", val)
+			assert.NoError(t, err)
+			assert.Nil(t, e)
+
+			err = iCache.SetCacheWithNotFound(c.Ctx, "5")
+			assert.NoError(t, err)
+			time.Sleep(time.Millisecond * 10)
+			_, err = sc.GetWithSoftTTL(c.Ctx, "5", val)
+			assert.ErrorIs(t, err, ErrPlaceholder)
+		})
+	}
+}
+
+type ctxKey struct{}
+
+func TestRefreshAsync(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "foo"))
+	var count int32
+	done := make(chan struct{})
+	refresh := func(ctx context.Context) {
+		<-done
+		// the refresh is not canceled with the ctx of the request
+		assert.NoError(t, ctx.Err())
+		assert.Equal(t, "foo", ctx.Value(ctxKey{}))
+		atomic.AddInt32(&count, 1)
+	}
+
+	assert.True(t, RefreshAsync(ctx, "key", refresh))
+	assert.False(t, RefreshAsync(ctx, "key", refresh))
+	cancel()
+	close(done)
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+
+	// the key can be refreshed again after the refresh is finished
+	assert.True(t, RefreshAsync(ctx, "key", refresh))
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&count))
+}
+
+func TestRefreshAsync_panicAndTimeout(t *testing.T) {
+	timeout := RefreshTimeout
+	RefreshTimeout = time.Millisecond * 20
+	defer func() { RefreshTimeout = timeout }()
+
+	// the panic of the refresh is recovered, the key can be refreshed again
+	assert.True(t, RefreshAsync(context.Background(), "panic", func(ctx context.Context) {
+		panic("mock panic")
+	}))
+	time.Sleep(time.Millisecond * 50)
+
+	// the ctx of the refresh has a deadline
+	var err atomic.Value
+	assert.True(t, RefreshAsync(context.Background(), "panic", func(ctx context.Context) {
+		_, ok := ctx.Deadline()
+		assert.True(t, ok)
+		<-ctx.Done()
+		err.Store(ctx.Err())
+	}))
+	time.Sleep(time.Millisecond * 100)
+	assert.ErrorIs(t, err.Load().(error), context.DeadlineExceeded)
+	assert.True(t, RefreshAsync(context.Background(), "panic", func(ctx context.Context) {}))
+}
@@ -0,0 +1,30 @@
+// Package ctxvalue defines the keys of the values that are shared by the request context and the libraries,
+// e.g. the uid and request id stored by the gin middleware are read by the plugins of gorm, without importing gin.
+package ctxvalue
+
+import "context"
+
+const (
+	// UIDKey the key of the uid of the authenticated user in the context
+	UIDKey = "uid"
+
+	// RequestIDKey the key of the request id in the context
+	RequestIDKey = "request_id"
+)
+
+// UID get the uid of the authenticated user from context, return empty string if not exist
+func UID(ctx context.Context) string {
+	return getString(ctx, UIDKey)
+}
+
+// RequestID get the request id from context, return empty string if not exist
+func RequestID(ctx context.Context) string {
+	return getString(ctx, RequestIDKey)
+}
+
+func getString(ctx context.Context, key string) string {
+	if str, ok := ctx.Value(key).(string); ok {
+		return str
+	}
+	return ""
+}
@@ -0,0 +1,23 @@
+package ctxvalue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUID(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "", UID(ctx))
+	assert.Equal(t, "", RequestID(ctx))
+
+	ctx = context.WithValue(ctx, UIDKey, "100")                  //nolint
+	ctx = context.WithValue(ctx, RequestIDKey, "foo-request-id") //nolint
+	assert.Equal(t, "100", UID(ctx))
+	assert.Equal(t, "foo-request-id", RequestID(ctx))
+
+	// not a string
+	ctx = context.WithValue(ctx, UIDKey, 100) //nolint
+	assert.Equal(t, "", UID(ctx))
+}
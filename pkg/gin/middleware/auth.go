@@ -1,6 +1,9 @@
 package middleware
 
 import (
+	"context"
+
+	"github.com/zhufuyi/sponge/pkg/ctxvalue"
 	"github.com/zhufuyi/sponge/pkg/errcode"
 	"github.com/zhufuyi/sponge/pkg/gin/response"
 	"github.com/zhufuyi/sponge/pkg/jwt"
@@ -9,6 +12,9 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// ContextUIDKey the key of the uid of the authenticated user in the context
+const ContextUIDKey = ctxvalue.UIDKey
+
 // Auth authorization
 func Auth() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -27,7 +33,7 @@ func Auth() gin.HandlerFunc {
 			c.Abort()
 			return
 		}
-		c.Set("uid", claims.UID)
+		setUID(c, claims.UID)
 
 		c.Next()
 	}
@@ -59,8 +65,29 @@ func AuthAdmin() gin.HandlerFunc {
 			c.Abort()
 			return
 		}
-		c.Set("uid", claims.UID)
+		setUID(c, claims.UID)
 
 		c.Next()
 	}
 }
+
+// the uid is exposed to the functions that use c.Request.Context(), e.g. the audit columns of gorm
+func setUID(c *gin.Context, uid string) {
+	c.Set(ContextUIDKey, uid)
+	c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ContextUIDKey, uid)) //nolint
+}
+
+// GCtxUID get the uid of the authenticated user from gin.Context
+func GCtxUID(c *gin.Context) string {
+	if v, isExist := c.Get(ContextUIDKey); isExist {
+		if uid, ok := v.(string); ok {
+			return uid
+		}
+	}
+	return ""
+}
+
+// CtxUID get the uid of the authenticated user from context.Context
+func CtxUID(ctx context.Context) string {
+	return ctxvalue.UID(ctx)
+}
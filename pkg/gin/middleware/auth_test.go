@@ -1,9 +1,11 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/zhufuyi/sponge/pkg/utils"
 
 	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
 )
 
 var (
@@ -36,7 +39,7 @@ func runAuthHTTPServer() string {
 	}
 
 	userFun := func(c *gin.Context) {
-		response.Success(c, "hello "+uid)
+		response.Success(c, "hello "+CtxUID(c.Request.Context()))
 	}
 
 	r.GET("/token", tokenFun)
@@ -130,6 +133,16 @@ func TestAdminAuth(t *testing.T) {
 	t.Log(val)
 }
 
+func TestGetUIDFromContext(t *testing.T) {
+	c := &gin.Context{Request: httptest.NewRequest(http.MethodGet, "/", nil)}
+	assert.Equal(t, "", GCtxUID(c))
+	assert.Equal(t, "", CtxUID(context.Background()))
+
+	setUID(c, uid)
+	assert.Equal(t, uid, GCtxUID(c))
+	assert.Equal(t, uid, CtxUID(c.Request.Context()))
+}
+
 func getUser(requestAddr string, authorization string) (string, error) {
 	client := &http.Client{}
 	url := requestAddr + "/user/" + uid
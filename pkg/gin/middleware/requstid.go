@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 
+	"github.com/zhufuyi/sponge/pkg/ctxvalue"
 	"github.com/zhufuyi/sponge/pkg/krand"
 
 	"github.com/gin-gonic/gin"
@@ -11,7 +12,7 @@ import (
 
 const (
 	// ContextRequestIDKey context request id for context
-	ContextRequestIDKey = "request_id"
+	ContextRequestIDKey = ctxvalue.RequestIDKey
 
 	// HeaderXRequestIDKey http header request id key
 	HeaderXRequestIDKey = "X-Request-ID"
@@ -27,9 +28,11 @@ func RequestID() gin.HandlerFunc {
 		if requestID == "" {
 			requestID = krand.String(krand.R_All, 10)
 			c.Request.Header.Set(HeaderXRequestIDKey, requestID)
-			// Expose it for use in the application
-			c.Set(ContextRequestIDKey, requestID)
 		}
+		// Expose it for use in the application
+		c.Set(ContextRequestIDKey, requestID)
+		// Expose it to the functions that use c.Request.Context(), e.g. dao and the logs of gorm
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ContextRequestIDKey, requestID)) //nolint
 
 		// Set X-Request-ID header
 		c.Writer.Header().Set(HeaderXRequestIDKey, requestID)
@@ -55,11 +58,7 @@ func GCtxRequestIDField(c *gin.Context) zap.Field {
 
 // CtxRequestID get request id from context.Context
 func CtxRequestID(ctx context.Context) string {
-	v := ctx.Value(ContextRequestIDKey)
-	if str, ok := v.(string); ok {
-		return str
-	}
-	return ""
+	return ctxvalue.RequestID(ctx)
 }
 
 // CtxRequestIDField get request id field from context.Context
@@ -50,6 +50,9 @@ func TestFieldRequestIDFromContext(t *testing.T) {
 		t.Log(str)
 		field = CtxRequestIDField(c)
 		t.Log(field)
+
+		assert.NotEmpty(t, CtxRequestID(c.Request.Context()))
+		assert.Equal(t, GCtxRequestID(c), CtxRequestID(c.Request.Context()))
 	})
 
 	_, err := http.Get(requestAddr + "/ping")
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/zhufuyi/sponge/pkg/errcode"
+	"github.com/zhufuyi/sponge/pkg/gin/response"
+	"github.com/zhufuyi/sponge/pkg/jwt"
+	"github.com/zhufuyi/sponge/pkg/logger"
+	"github.com/zhufuyi/sponge/pkg/tenant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HeaderXTenantIDKey http header tenant id key
+const HeaderXTenantIDKey = "X-Tenant-ID"
+
+// TenantOption set the tenant options.
+type TenantOption func(*tenantOptions)
+
+type tenantOptions struct {
+	header string
+}
+
+func defaultTenantOptions() *tenantOptions {
+	return &tenantOptions{
+		header: "", // the header is set by the client, it is not trusted by default
+	}
+}
+
+func (o *tenantOptions) apply(opts ...TenantOption) {
+	for _, opt := range opts {
+		opt(o)
+	}
+}
+
+// WithTenantHeader read the tenant id from the http header if the request has no jwt token, e.g. HeaderXTenantIDKey,
+// it is only for the service behind a trusted gateway that authenticates the request and sets the header,
+// otherwise any client can access the data of any tenant. the header is not read by default.
+func WithTenantHeader(header string) TenantOption {
+	return func(o *tenantOptions) {
+		o.header = header
+	}
+}
+
+// Tenant extract the tenant id from the claim of jwt token and store it in the context of request, the request with
+// jwt token is rejected if there is no tenant id in the claim, the request without token is rejected unless the
+// trusted header is set by WithTenantHeader.
+func Tenant(opts ...TenantOption) gin.HandlerFunc {
+	o := defaultTenantOptions()
+	o.apply(opts...)
+
+	return func(c *gin.Context) {
+		tenantID := ""
+		authorization := c.GetHeader("Authorization")
+		if strings.HasPrefix(authorization, "Bearer ") {
+			claims, err := jwt.VerifyToken(authorization[7:])
+			if err != nil {
+				logger.Warn("VerifyToken error", logger.Err(err))
+				response.Error(c, errcode.Unauthorized)
+				c.Abort()
+				return
+			}
+			tenantID = claims.TenantID
+		} else if o.header != "" {
+			tenantID = c.GetHeader(o.header)
+		}
+		if tenantID == "" {
+			logger.Warn("missing tenant id", GCtxRequestIDField(c))
+			response.Error(c, errcode.Forbidden)
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(tenant.NewContext(c.Request.Context(), tenantID))
+
+		c.Next()
+	}
+}
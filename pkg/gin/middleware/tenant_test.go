@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zhufuyi/sponge/pkg/errcode"
+	"github.com/zhufuyi/sponge/pkg/gin/response"
+	"github.com/zhufuyi/sponge/pkg/jwt"
+	"github.com/zhufuyi/sponge/pkg/tenant"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTenant(t *testing.T) {
+	jwt.Init()
+	gin.SetMode(gin.ReleaseMode)
+	r := gin.New()
+	r.GET("/tenant", Tenant(), func(c *gin.Context) {
+		response.Success(c, tenant.FromContext(c.Request.Context()))
+	})
+	r.GET("/header", Tenant(WithTenantHeader(HeaderXTenantIDKey)), func(c *gin.Context) {
+		response.Success(c, tenant.FromContext(c.Request.Context()))
+	})
+
+	request := func(path string, header map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	// from token
+	token, err := jwt.GenerateTokenWithTenant("100", "t2")
+	assert.NoError(t, err)
+	w := request("/tenant", map[string]string{"Authorization": "Bearer " + token})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "t2")
+
+	// the header is not trusted by default
+	w = request("/tenant", map[string]string{HeaderXTenantIDKey: "t1"})
+	assert.Contains(t, w.Body.String(), fmt.Sprintf(`"code":%d`, errcode.Forbidden.Code()))
+
+	// from the trusted header
+	w = request("/header", map[string]string{HeaderXTenantIDKey: "t1"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "t1")
+
+	// the claim of token takes precedence over the header
+	w = request("/header", map[string]string{"Authorization": "Bearer " + token, HeaderXTenantIDKey: "t1"})
+	assert.Contains(t, w.Body.String(), "t2")
+
+	// the token without tenant claim is rejected, the header is not read
+	token, err = jwt.GenerateToken("100")
+	assert.NoError(t, err)
+	w = request("/header", map[string]string{"Authorization": "Bearer " + token, HeaderXTenantIDKey: "t1"})
+	assert.Contains(t, w.Body.String(), fmt.Sprintf(`"code":%d`, errcode.Forbidden.Code()))
+
+	// invalid token
+	w = request("/header", map[string]string{"Authorization": "Bearer xxxxxx", HeaderXTenantIDKey: "t1"})
+	assert.Contains(t, w.Body.String(), fmt.Sprintf(`"code":%d`, errcode.Unauthorized.Code()))
+
+	// missing tenant
+	w = request("/tenant", nil)
+	assert.Contains(t, w.Body.String(), fmt.Sprintf(`"code":%d`, errcode.Forbidden.Code()))
+}
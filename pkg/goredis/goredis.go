@@ -1,6 +1,9 @@
 package goredis
 
 import (
+	"errors"
+	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/go-redis/redis/extra/redisotel"
@@ -12,22 +15,44 @@ const (
 	ErrRedisNotFound = redis.Nil
 	// DefaultRedisName default redis name
 	DefaultRedisName = "default"
+
+	clusterScheme  = "cluster://"
+	sentinelScheme = "sentinel://"
 )
 
-// Init connecting to redis
+// Init connecting to redis, the client is a single node, cluster or sentinel client depending on the dsn.
 // dsn supported formats.
 // no password, no db: localhost:6379
 // with password and db: <user>:<pass>@localhost:6379/2
-func Init(dsn string, opts ...Option) (*redis.Client, error) {
+// cluster: cluster://<user>:<pass>@localhost:7000,localhost:7001,localhost:7002
+// sentinel: sentinel://<user>:<pass>@localhost:26379,localhost:26380/<masterName>/2?sentinelPassword=<pass>
+func Init(dsn string, opts ...Option) (redis.UniversalClient, error) {
 	o := defaultOptions()
 	o.apply(opts...)
 
-	opt, err := getRedisOpt(dsn, o)
-	if err != nil {
-		return nil, err
-	}
+	var rdb redis.UniversalClient
+	switch {
+	case strings.HasPrefix(dsn, clusterScheme):
+		opt, err := getClusterOpt(dsn, o)
+		if err != nil {
+			return nil, err
+		}
+		rdb = redis.NewClusterClient(opt)
 
-	rdb := redis.NewClient(opt)
+	case strings.HasPrefix(dsn, sentinelScheme):
+		opt, err := getSentinelOpt(dsn, o)
+		if err != nil {
+			return nil, err
+		}
+		rdb = redis.NewFailoverClient(opt)
+
+	default:
+		opt, err := getRedisOpt(dsn, o)
+		if err != nil {
+			return nil, err
+		}
+		rdb = redis.NewClient(opt)
+	}
 
 	if o.enableTrace { // tracing is enabled or not depending on the setting
 		rdb.AddHook(redisotel.TracingHook{})
@@ -65,13 +90,111 @@ func getRedisOpt(dsn string, opts *options) (*redis.Options, error) {
 	return redisOpts, nil
 }
 
-// Init2 connecting to redis
-func Init2(addr string, password string, db int, opts ...Option) *redis.Client {
+func getClusterOpt(dsn string, opts *options) (*redis.ClusterOptions, error) {
+	d, err := parseDsn(strings.TrimPrefix(dsn, clusterScheme))
+	if err != nil {
+		return nil, err
+	}
+	if d.path != "" && d.path != "0" {
+		return nil, errors.New("redis cluster only supports db 0")
+	}
+
+	return &redis.ClusterOptions{
+		Addrs:        d.addrs,
+		Username:     d.username,
+		Password:     d.password,
+		DialTimeout:  opts.dialTimeout,
+		ReadTimeout:  opts.readTimeout,
+		WriteTimeout: opts.writeTimeout,
+	}, nil
+}
+
+func getSentinelOpt(dsn string, opts *options) (*redis.FailoverOptions, error) {
+	d, err := parseDsn(strings.TrimPrefix(dsn, sentinelScheme))
+	if err != nil {
+		return nil, err
+	}
+
+	// the path is <masterName>/<db>
+	ss := strings.SplitN(d.path, "/", 2)
+	if ss[0] == "" {
+		return nil, errors.New("the master name of redis sentinel is empty")
+	}
+	db := 0
+	if len(ss) == 2 && ss[1] != "" {
+		db, err = strconv.Atoi(ss[1])
+		if err != nil {
+			return nil, errors.New("invalid db of redis sentinel: " + ss[1])
+		}
+	}
+
+	return &redis.FailoverOptions{
+		MasterName:       ss[0],
+		SentinelAddrs:    d.addrs,
+		SentinelUsername: d.query.Get("sentinelUsername"),
+		SentinelPassword: d.query.Get("sentinelPassword"),
+		Username:         d.username,
+		Password:         d.password,
+		DB:               db,
+		DialTimeout:      opts.dialTimeout,
+		ReadTimeout:      opts.readTimeout,
+		WriteTimeout:     opts.writeTimeout,
+	}, nil
+}
+
+type dsnInfo struct {
+	username string
+	password string
+	addrs    []string
+	path     string
+	query    url.Values
+}
+
+// parse [<user>:<pass>@]<addr1>,<addr2>[/<path>][?k=v], the url package does not support multiple hosts
+func parseDsn(dsn string) (*dsnInfo, error) {
+	d := &dsnInfo{query: url.Values{}}
+
+	var err error
+	if i := strings.Index(dsn, "?"); i >= 0 {
+		d.query, err = url.ParseQuery(dsn[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		dsn = dsn[:i]
+	}
+	if i := strings.LastIndex(dsn, "@"); i >= 0 {
+		userInfo := dsn[:i]
+		dsn = dsn[i+1:]
+		if j := strings.Index(userInfo, ":"); j >= 0 {
+			d.username, d.password = userInfo[:j], userInfo[j+1:]
+		} else {
+			d.username = userInfo
+		}
+	}
+	if i := strings.Index(dsn, "/"); i >= 0 {
+		d.path = strings.Trim(dsn[i+1:], "/")
+		dsn = dsn[:i]
+	}
+
+	for _, addr := range strings.Split(dsn, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			d.addrs = append(d.addrs, addr)
+		}
+	}
+	if len(d.addrs) == 0 {
+		return nil, errors.New("the address of redis is empty")
+	}
+
+	return d, nil
+}
+
+// Init2 connecting to redis, if addr is multiple addresses separated by commas, it connects to redis cluster
+func Init2(addr string, password string, db int, opts ...Option) redis.UniversalClient {
 	o := defaultOptions()
 	o.apply(opts...)
 
-	rdb := redis.NewClient(&redis.Options{
-		Addr:         addr,
+	rdb := redis.NewUniversalClient(&redis.UniversalOptions{
+		Addrs:        strings.Split(addr, ","),
 		Password:     password,
 		DB:           db,
 		DialTimeout:  o.dialTimeout,
@@ -0,0 +1,333 @@
+// Package lock is a distributed lock on redis, the lock is released by the token of the holder,
+// the lease is extended by the watchdog, and each acquisition returns a fencing token.
+package lock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/zhufuyi/sponge/pkg/krand"
+
+	"github.com/go-redis/redis/v8"
+)
+
+var (
+	// ErrNotObtained the lock is held by others or the redis nodes are unavailable
+	ErrNotObtained = errors.New("lock not obtained")
+	// ErrNotHeld the lock has expired or is held by others
+	ErrNotHeld = errors.New("lock not held")
+)
+
+const (
+	// the clock drift between the redis nodes is ttl*driftFactor+minClockDrift,
+	// see https://redis.io/docs/manual/patterns/distributed-locks/
+	driftFactor   = 0.01
+	minClockDrift = 2 * time.Millisecond
+
+	// the timeout of releasing the lock that is not obtained on the quorum
+	releaseTimeout = time.Second
+)
+
+var (
+	// set the lock if it does not exist, and increase the fencing counter
+	obtainScript = redis.NewScript(`
+if redis.call("SET", KEYS[1], ARGV[1], "NX", "PX", ARGV[2]) then
+	return redis.call("INCR", KEYS[2])
+end
+return 0
+`)
+
+	// delete the lock only if it is held by the token
+	releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+	// extend the lease only if the lock is held by the token
+	extendScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+	// raise the fencing counter of the node to the fencing token of the quorum
+	fencingScript = redis.NewScript(`
+local n = tonumber(redis.call("GET", KEYS[1]) or "0")
+if n < tonumber(ARGV[1]) then
+	redis.call("SET", KEYS[1], ARGV[1])
+end
+return 1
+`)
+)
+
+// Locker obtain the locks on one redis or the quorum of multiple independent redis nodes
+type Locker struct {
+	clients []redis.UniversalClient
+	quorum  int
+	opts    *options
+}
+
+// NewLocker create a locker on a redis, the client can be a single node, cluster or sentinel client
+func NewLocker(client redis.UniversalClient, opts ...Option) *Locker {
+	return NewQuorumLocker([]redis.UniversalClient{client}, opts...)
+}
+
+// NewQuorumLocker create a locker on multiple independent redis nodes (Redlock), the lock is obtained
+// only if it is obtained on the majority of the nodes within the ttl, so it survives the failure of the minority.
+func NewQuorumLocker(clients []redis.UniversalClient, opts ...Option) *Locker {
+	o := defaultOptions()
+	o.apply(opts...)
+
+	return &Locker{
+		clients: clients,
+		quorum:  len(clients)/2 + 1,
+		opts:    o,
+	}
+}
+
+// TryLock try to obtain the lock once, return ErrNotObtained if the lock is held by others.
+// If the watchdog is enabled, the lease is extended until the lock is released or ctx is done.
+func (l *Locker) TryLock(ctx context.Context, name string) (*Lock, error) {
+	return l.obtain(ctx, name)
+}
+
+// Lock obtain the lock, if it is held by others, retry with the exponential backoff until the timeout,
+// if timeout <= 0, retry until ctx is done. If the watchdog is enabled, the lease is extended until
+// the lock is released or ctx is done.
+func (l *Locker) Lock(ctx context.Context, name string, timeout time.Duration) (*Lock, error) {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	backoff := l.opts.minBackoff
+	for {
+		lock, err := l.obtain(ctx, name)
+		if err == nil || !errors.Is(err, ErrNotObtained) {
+			return lock, err
+		}
+
+		// the jitter avoids all the waiters retrying at the same time
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1)) //nolint
+		if !deadline.IsZero() {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return nil, err
+			}
+			if wait > remaining {
+				wait = remaining
+			}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if backoff > l.opts.maxBackoff {
+			backoff = l.opts.maxBackoff
+		}
+	}
+}
+
+func (l *Locker) obtain(ctx context.Context, name string) (*Lock, error) {
+	// the hash tag puts the lock key and the fencing key in the same slot of the redis cluster
+	key := l.opts.keyPrefix + "{" + name + "}"
+	fencingKey := key + ":fencing"
+	token := krand.String(krand.R_All, 22)
+	ttl := l.opts.ttl
+	start := time.Now()
+
+	var (
+		fencingToken int64
+		obtained     []redis.UniversalClient
+		lastErr      error
+	)
+	for _, client := range l.clients {
+		n, err := obtainScript.Run(ctx, client, []string{key, fencingKey}, token, ttl.Milliseconds()).Int64()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if n > 0 {
+			obtained = append(obtained, client)
+			if n > fencingToken {
+				fencingToken = n
+			}
+		}
+	}
+
+	// the fencing counters of the quorum are raised to the fencing token, any later quorum has at least
+	// one of these nodes, so the fencing token of the later lock is greater.
+	if len(l.clients) > 1 && len(obtained) >= l.quorum {
+		synced := obtained[:0]
+		for _, client := range obtained {
+			err := fencingScript.Run(ctx, client, []string{fencingKey}, fencingToken).Err()
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			synced = append(synced, client)
+		}
+		obtained = synced
+	}
+
+	drift := time.Duration(float64(ttl)*driftFactor) + minClockDrift
+	validity := ttl - time.Since(start) - drift
+	if len(obtained) < l.quorum || validity <= 0 {
+		// the lock may be set on the nodes that returned errors, release it on all nodes
+		releaseCtx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+		defer cancel()
+		for _, client := range l.clients {
+			_ = releaseScript.Run(releaseCtx, client, []string{key}, token).Err()
+		}
+		if lastErr != nil {
+			return nil, fmt.Errorf("%w, name=%s, error: %v", ErrNotObtained, name, lastErr)
+		}
+		return nil, ErrNotObtained
+	}
+
+	lock := &Lock{
+		locker:       l,
+		name:         name,
+		key:          key,
+		token:        token,
+		fencingToken: fencingToken,
+		stop:         make(chan struct{}),
+		lost:         make(chan struct{}),
+	}
+	if l.opts.enableWatch {
+		go lock.watch(ctx)
+	}
+
+	return lock, nil
+}
+
+// Lock the obtained lock
+type Lock struct {
+	locker       *Locker
+	name         string
+	key          string
+	token        string
+	fencingToken int64
+
+	stopOnce sync.Once
+	stop     chan struct{} // stop the watchdog
+	lostOnce sync.Once
+	lost     chan struct{}
+}
+
+// Name the name of the lock
+func (l *Lock) Name() string {
+	return l.name
+}
+
+// Key the redis key of the lock
+func (l *Lock) Key() string {
+	return l.key
+}
+
+// Token the random token of the holder
+func (l *Lock) Token() string {
+	return l.token
+}
+
+// FencingToken the monotonically increasing number of the acquisitions of the lock, pass it to the storage
+// with the writes, and the storage rejects the writes with a token smaller than the one it has seen,
+// so the holder whose lease has expired (e.g. paused by GC) can't overwrite the data of the new holder.
+func (l *Lock) FencingToken() int64 {
+	return l.fencingToken
+}
+
+// Lost the channel is closed if the watchdog finds that the lock has expired or is held by others
+func (l *Lock) Lost() <-chan struct{} {
+	return l.lost
+}
+
+// Extend reset the lease of the lock to ttl, return ErrNotHeld if the lock is not held
+func (l *Lock) Extend(ctx context.Context, ttl time.Duration) error {
+	var (
+		held    int
+		lastErr error
+	)
+	for _, client := range l.locker.clients {
+		n, err := extendScript.Run(ctx, client, []string{l.key}, l.token, ttl.Milliseconds()).Int64()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if n > 0 {
+			held++
+		}
+	}
+
+	if held < l.locker.quorum {
+		if lastErr != nil {
+			return fmt.Errorf("extend lock error: %v, name=%s", lastErr, l.name)
+		}
+		return ErrNotHeld
+	}
+	return nil
+}
+
+// Release release the lock and stop the watchdog, return ErrNotHeld if the lock is not held
+func (l *Lock) Release(ctx context.Context) error {
+	l.stopOnce.Do(func() { close(l.stop) })
+
+	var (
+		released int
+		lastErr  error
+	)
+	for _, client := range l.locker.clients {
+		n, err := releaseScript.Run(ctx, client, []string{l.key}, l.token).Int64()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if n > 0 {
+			released++
+		}
+	}
+
+	if released < l.locker.quorum {
+		if lastErr != nil {
+			return fmt.Errorf("release lock error: %v, name=%s", lastErr, l.name)
+		}
+		return ErrNotHeld
+	}
+	return nil
+}
+
+// extend the lease every 1/3 of the ttl until the lock is released or ctx is done
+func (l *Lock) watch(ctx context.Context) {
+	ttl := l.locker.opts.ttl
+	ticker := time.NewTicker(ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			err := l.Extend(ctx, ttl)
+			if errors.Is(err, ErrNotHeld) {
+				l.lostOnce.Do(func() { close(l.lost) })
+				return
+			}
+			// the other errors are retried at the next tick, before the lease expires
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package lock
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+func newClient(t *testing.T) (*miniredis.Miniredis, redis.UniversalClient) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mr, redis.NewClient(&redis.Options{Addr: mr.Addr()})
+}
+
+func TestLocker_TryLock(t *testing.T) {
+	mr, client := newClient(t)
+	defer mr.Close()
+	ctx := context.Background()
+	locker := NewLocker(client, WithTTL(time.Second))
+
+	lock, err := locker.TryLock(ctx, "job")
+	assert.NoError(t, err)
+	assert.Equal(t, "job", lock.Name())
+	assert.Equal(t, "lock:{job}", lock.Key())
+	assert.Equal(t, int64(1), lock.FencingToken())
+	assert.NotEmpty(t, lock.Token())
+	assert.True(t, mr.Exists("lock:{job}"))
+	assert.Equal(t, time.Second, mr.TTL("lock:{job}"))
+
+	// held by others
+	_, err = locker.TryLock(ctx, "job")
+	assert.ErrorIs(t, err, ErrNotObtained)
+
+	err = lock.Extend(ctx, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, mr.TTL("lock:{job}"))
+
+	err = lock.Release(ctx)
+	assert.NoError(t, err)
+	assert.False(t, mr.Exists("lock:{job}"))
+	err = lock.Release(ctx)
+	assert.ErrorIs(t, err, ErrNotHeld)
+	err = lock.Extend(ctx, time.Minute)
+	assert.ErrorIs(t, err, ErrNotHeld)
+
+	// the fencing token increases with every acquisition
+	lock2, err := locker.TryLock(ctx, "job")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), lock2.FencingToken())
+
+	// the expired lock can't be released by the previous holder after it is obtained by others
+	mr.FastForward(time.Second * 2)
+	lock3, err := locker.TryLock(ctx, "job")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), lock3.FencingToken())
+	err = lock2.Release(ctx)
+	assert.ErrorIs(t, err, ErrNotHeld)
+	assert.True(t, mr.Exists("lock:{job}"))
+	assert.NoError(t, lock3.Release(ctx))
+
+	// redis is unavailable
+	mr.Close()
+	_, err = locker.TryLock(ctx, "job")
+	assert.ErrorIs(t, err, ErrNotObtained)
+	assert.Contains(t, err.Error(), "error")
+}
+
+func TestLocker_Lock(t *testing.T) {
+	mr, client := newClient(t)
+	defer mr.Close()
+	ctx := context.Background()
+	locker := NewLocker(client, WithKeyPrefix("test:"), WithRetryBackoff(time.Millisecond, time.Millisecond*20))
+
+	lock, err := locker.Lock(ctx, "job", time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, "test:{job}", lock.Key())
+
+	// timeout
+	start := time.Now()
+	_, err = locker.Lock(ctx, "job", time.Millisecond*100)
+	assert.ErrorIs(t, err, ErrNotObtained)
+	assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*100)
+
+	// ctx is done
+	ctx2, cancel := context.WithTimeout(ctx, time.Millisecond*50)
+	defer cancel()
+	_, err = locker.Lock(ctx2, "job", 0)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// obtained after it is released by the holder
+	go func() {
+		time.Sleep(time.Millisecond * 50)
+		_ = lock.Release(ctx)
+	}()
+	lock2, err := locker.Lock(ctx, "job", time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), lock2.FencingToken())
+}
+
+func TestLocker_Mutex(t *testing.T) {
+	mr, client := newClient(t)
+	defer mr.Close()
+	ctx := context.Background()
+	locker := NewLocker(client, WithRetryBackoff(time.Millisecond, time.Millisecond*5))
+
+	var (
+		holders int32
+		count   int32
+		wg      sync.WaitGroup
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lock, err := locker.Lock(ctx, "counter", time.Second*5)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, int32(1), atomic.AddInt32(&holders, 1))
+			atomic.AddInt32(&count, 1)
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&holders, -1)
+			assert.NoError(t, lock.Release(ctx))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(10), count)
+}
+
+func TestLock_Watchdog(t *testing.T) {
+	mr, client := newClient(t)
+	defer mr.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	locker := NewLocker(client, WithTTL(time.Millisecond*300), WithWatchdog())
+
+	lock, err := locker.TryLock(ctx, "job")
+	assert.NoError(t, err)
+
+	// the lease is extended by the watchdog
+	mr.FastForward(time.Millisecond * 200)
+	time.Sleep(time.Millisecond * 150)
+	assert.Equal(t, time.Millisecond*300, mr.TTL("lock:{job}"))
+	mr.FastForward(time.Millisecond * 200)
+	assert.True(t, mr.Exists("lock:{job}"))
+
+	// the watchdog stops when ctx is done
+	cancel()
+	time.Sleep(time.Millisecond * 150)
+	mr.FastForward(time.Millisecond * 400)
+	assert.False(t, mr.Exists("lock:{job}"))
+
+	// the lock is lost
+	lock, err = locker.TryLock(context.Background(), "job")
+	assert.NoError(t, err)
+	mr.Del("lock:{job}")
+	select {
+	case <-lock.Lost():
+	case <-time.After(time.Second):
+		t.Error("the lost lock is not found by the watchdog")
+	}
+
+	// the watchdog stops when the lock is released
+	lock, err = locker.TryLock(context.Background(), "job")
+	assert.NoError(t, err)
+	assert.NoError(t, lock.Release(context.Background()))
+	time.Sleep(time.Millisecond * 150)
+	select {
+	case <-lock.Lost():
+		t.Error("the released lock is not lost")
+	default:
+	}
+}
+
+func TestQuorumLocker(t *testing.T) {
+	var (
+		mrs     []*miniredis.Miniredis
+		clients []redis.UniversalClient
+	)
+	for i := 0; i < 3; i++ {
+		mr, client := newClient(t)
+		defer mr.Close()
+		mrs = append(mrs, mr)
+		clients = append(clients, client)
+	}
+	ctx := context.Background()
+	locker := NewQuorumLocker(clients)
+
+	lock, err := locker.TryLock(ctx, "job")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), lock.FencingToken())
+	for _, mr := range mrs {
+		assert.True(t, mr.Exists("lock:{job}"))
+	}
+	assert.NoError(t, lock.Extend(ctx, time.Minute))
+	assert.NoError(t, lock.Release(ctx))
+
+	// the lock is obtained on the majority, the fencing token is greater than the counters of all the nodes
+	_ = mrs[0].Set("lock:{job}:fencing", "10")
+	_ = mrs[1].Set("lock:{job}", "other")
+	lock, err = locker.TryLock(ctx, "job")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), lock.FencingToken())
+	fencing, _ := mrs[2].Get("lock:{job}:fencing")
+	assert.Equal(t, "11", fencing)
+	assert.NoError(t, lock.Release(ctx))
+	mrs[1].Del("lock:{job}")
+
+	// not obtained on the majority, the lock is released on the minority
+	_ = mrs[0].Set("lock:{job}", "other")
+	_ = mrs[1].Set("lock:{job}", "other")
+	_, err = locker.TryLock(ctx, "job")
+	assert.ErrorIs(t, err, ErrNotObtained)
+	assert.False(t, mrs[2].Exists("lock:{job}"))
+	mrs[0].Del("lock:{job}")
+	mrs[1].Del("lock:{job}")
+
+	// the lock survives the failure of the minority
+	mrs[2].Close()
+	lock, err = locker.TryLock(ctx, "job")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), lock.FencingToken())
+	assert.NoError(t, lock.Extend(ctx, time.Minute))
+	assert.NoError(t, lock.Release(ctx))
+
+	mrs[1].Close()
+	_, err = locker.TryLock(ctx, "job")
+	assert.ErrorIs(t, err, ErrNotObtained)
+}
@@ -0,0 +1,68 @@
+package lock
+
+import "time"
+
+// Option set the options of the locker
+type Option func(*options)
+
+type options struct {
+	keyPrefix   string
+	ttl         time.Duration
+	enableWatch bool
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+}
+
+func (o *options) apply(opts ...Option) {
+	for _, opt := range opts {
+		opt(o)
+	}
+}
+
+// default settings
+func defaultOptions() *options {
+	return &options{
+		keyPrefix:   "lock:",
+		ttl:         10 * time.Second,
+		enableWatch: false,
+		minBackoff:  10 * time.Millisecond,
+		maxBackoff:  500 * time.Millisecond,
+	}
+}
+
+// WithKeyPrefix set the prefix of the lock keys, default is "lock:"
+func WithKeyPrefix(prefix string) Option {
+	return func(o *options) {
+		o.keyPrefix = prefix
+	}
+}
+
+// WithTTL set the lease of the lock, the lock is released automatically after the lease
+// if it is not released or extended, default is 10s
+func WithTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		if ttl > 0 {
+			o.ttl = ttl
+		}
+	}
+}
+
+// WithWatchdog extend the lease of the lock automatically every 1/3 of the ttl,
+// until the lock is released or the context of obtaining the lock is done
+func WithWatchdog() Option {
+	return func(o *options) {
+		o.enableWatch = true
+	}
+}
+
+// WithRetryBackoff set the exponential backoff between the retries of Lock, default is 10ms to 500ms
+func WithRetryBackoff(min time.Duration, max time.Duration) Option {
+	return func(o *options) {
+		if min > 0 {
+			o.minBackoff = min
+		}
+		if max >= o.minBackoff {
+			o.maxBackoff = max
+		}
+	}
+}
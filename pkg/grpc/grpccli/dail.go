@@ -55,6 +55,12 @@ func dial(ctx context.Context, endpoint string, isSecure bool, opts ...Option) (
 		unaryClientInterceptors = append(unaryClientInterceptors, interceptor.UnaryClientRequestID())
 	}
 
+	// tenant
+	if o.enableTenant {
+		unaryClientInterceptors = append(unaryClientInterceptors, interceptor.UnaryClientTenant())
+		streamClientInterceptors = append(streamClientInterceptors, interceptor.StreamClientTenant())
+	}
+
 	// logging
 	if o.enableLog {
 		unaryClientInterceptors = append(unaryClientInterceptors, interceptor.UnaryClientLog(logger.Get()))
@@ -24,6 +24,7 @@ type options struct {
 
 	enableLog       bool // whether to turn on the log
 	enableRequestID bool // whether to turn on the request id
+	enableTenant    bool // whether to pass the tenant id to the server
 	log             *zap.Logger
 
 	enableTrace          bool // whether to turn on tracing
@@ -68,6 +69,13 @@ func WithEnableRequestID() Option {
 	}
 }
 
+// WithEnableTenant pass the tenant id in the context to the server
+func WithEnableTenant() Option {
+	return func(o *options) {
+		o.enableTenant = true
+	}
+}
+
 // WithEnableLog enable log
 func WithEnableLog(log *zap.Logger) Option {
 	return func(o *options) {
@@ -58,6 +58,13 @@ func TestWithEnableRequestID(t *testing.T) {
 	assert.Equal(t, true, o.enableRequestID)
 }
 
+func TestWithEnableTenant(t *testing.T) {
+	opt := WithEnableTenant()
+	o := new(options)
+	o.apply(opt)
+	assert.Equal(t, true, o.enableTenant)
+}
+
 func TestWithEnableLog(t *testing.T) {
 	testData := zap.NewNop()
 	opt := WithEnableLog(testData)
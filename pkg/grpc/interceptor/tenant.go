@@ -0,0 +1,151 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/zhufuyi/sponge/pkg/jwt"
+	"github.com/zhufuyi/sponge/pkg/tenant"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ContextTenantIDKey the key of tenant id in metadata
+const ContextTenantIDKey = "x-tenant-id"
+
+// ---------------------------------- client interceptor ----------------------------------
+
+func addClientTenantIDToCtx(ctx context.Context) context.Context {
+	tenantID := tenant.FromContext(ctx)
+	if tenantID == "" || metautils.ExtractOutgoing(ctx).Get(ContextTenantIDKey) != "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, ContextTenantIDKey, tenantID)
+}
+
+// UnaryClientTenant client-side tenant unary interceptor, pass the tenant id in the context to the server
+func UnaryClientTenant() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(addClientTenantIDToCtx(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientTenant client-side tenant stream interceptor, pass the tenant id in the context to the server
+func StreamClientTenant() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(addClientTenantIDToCtx(ctx), desc, cc, method, opts...)
+	}
+}
+
+// ---------------------------------- server interceptor ----------------------------------
+
+// TenantOption set the tenant options.
+type TenantOption func(*tenantOptions)
+
+type tenantOptions struct {
+	mdKey         string
+	ignoreMethods map[string]struct{}
+}
+
+func defaultTenantOptions() *tenantOptions {
+	return &tenantOptions{
+		mdKey:         "", // the metadata is set by the client, it is not trusted by default
+		ignoreMethods: make(map[string]struct{}),
+	}
+}
+
+func (o *tenantOptions) apply(opts ...TenantOption) {
+	for _, opt := range opts {
+		opt(o)
+	}
+}
+
+// WithTenantMetadataKey read the tenant id from the metadata if the request has no jwt token, e.g. ContextTenantIDKey,
+// it is only for the service called by the trusted services or gateway that set the metadata, e.g. UnaryClientTenant,
+// otherwise any client can access the data of any tenant. the metadata is not read by default.
+func WithTenantMetadataKey(key string) TenantOption {
+	return func(o *tenantOptions) {
+		o.mdKey = key
+	}
+}
+
+// WithTenantIgnoreMethods ways to ignore tenant
+// fullMethodName format: /packageName.serviceName/methodName,
+// example /api.userExample.v1.userExampleService/GetByID
+func WithTenantIgnoreMethods(fullMethodNames ...string) TenantOption {
+	return func(o *tenantOptions) {
+		for _, method := range fullMethodNames {
+			o.ignoreMethods[method] = struct{}{}
+		}
+	}
+}
+
+// the tenant id is read from the claim of jwt token, the claims verified by UnaryServerJwtAuth are used,
+// otherwise the token in metadata is verified if it exists. the trusted metadata is read only if there is no token.
+func tenantFromCtx(ctx context.Context, mdKey string) (context.Context, error) {
+	tenantID := ""
+	if claims, ok := ctx.Value(authCtxClaimsName).(*jwt.CustomClaims); ok {
+		tenantID = claims.TenantID
+	} else if token, err := grpc_auth.AuthFromMD(ctx, authScheme); err == nil {
+		claims, err := jwt.VerifyToken(token)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+		}
+		tenantID = claims.TenantID
+	} else if mdKey != "" {
+		tenantID = metautils.ExtractIncoming(ctx).Get(mdKey)
+	}
+	if tenantID == "" {
+		return nil, status.Error(codes.PermissionDenied, tenant.ErrMissingTenant.Error())
+	}
+
+	return tenant.NewContext(ctx, tenantID), nil
+}
+
+// UnaryServerTenant server-side tenant unary interceptor, extract the tenant id from
+// the claim of jwt token or the trusted metadata and store it in the context
+func UnaryServerTenant(opts ...TenantOption) grpc.UnaryServerInterceptor {
+	o := defaultTenantOptions()
+	o.apply(opts...)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := o.ignoreMethods[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+
+		newCtx, err := tenantFromCtx(ctx, o.mdKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(newCtx, req)
+	}
+}
+
+// StreamServerTenant server-side tenant stream interceptor, extract the tenant id from
+// the claim of jwt token or the trusted metadata and store it in the context
+func StreamServerTenant(opts ...TenantOption) grpc.StreamServerInterceptor {
+	o := defaultTenantOptions()
+	o.apply(opts...)
+
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, ok := o.ignoreMethods[info.FullMethod]; ok {
+			return handler(srv, stream)
+		}
+
+		newCtx, err := tenantFromCtx(stream.Context(), o.mdKey)
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = newCtx
+		return handler(srv, wrapped)
+	}
+}
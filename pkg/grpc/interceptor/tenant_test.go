@@ -0,0 +1,107 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zhufuyi/sponge/pkg/jwt"
+	"github.com/zhufuyi/sponge/pkg/tenant"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryClientTenant(t *testing.T) {
+	interceptor := UnaryClientTenant()
+	assert.NotNil(t, interceptor)
+
+	ctx := tenant.NewContext(context.Background(), "t1")
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		assert.Equal(t, []string{"t1"}, md.Get(ContextTenantIDKey))
+		return nil
+	}
+	err := interceptor(ctx, "/test", nil, nil, nil, invoker)
+	assert.NoError(t, err)
+}
+
+func TestStreamClientTenant(t *testing.T) {
+	interceptor := StreamClientTenant()
+	assert.NotNil(t, interceptor)
+
+	ctx := tenant.NewContext(context.Background(), "t1")
+	_, err := interceptor(ctx, nil, nil, "/test", streamClientFunc)
+	assert.NoError(t, err)
+}
+
+func TestUnaryServerTenant(t *testing.T) {
+	interceptor := UnaryServerTenant()
+	assert.NotNil(t, interceptor)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return tenant.FromContext(ctx), nil
+	}
+
+	// the metadata is not trusted by default
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ContextTenantIDKey, "t1"))
+	_, err := interceptor(ctx, nil, unaryServerInfo, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// from the trusted metadata
+	trusted := UnaryServerTenant(WithTenantMetadataKey(ContextTenantIDKey))
+	reply, err := trusted(ctx, nil, unaryServerInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "t1", reply)
+
+	// the claim of token takes precedence over the metadata
+	jwt.Init()
+	token, _ := jwt.GenerateTokenWithTenant("100", "t2")
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(ContextTenantIDKey, "t1", "authorization", authScheme+" "+token))
+	reply, err = trusted(ctx, nil, unaryServerInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "t2", reply)
+
+	// the token without tenant claim is rejected, the metadata is not read
+	token, _ = jwt.GenerateToken("100")
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(ContextTenantIDKey, "t1", "authorization", authScheme+" "+token))
+	_, err = trusted(ctx, nil, unaryServerInfo, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// the claims verified by jwt interceptor
+	ctx = context.WithValue(context.Background(), authCtxClaimsName, &jwt.CustomClaims{TenantID: "t3"}) //nolint
+	reply, err = interceptor(ctx, nil, unaryServerInfo, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "t3", reply)
+
+	// invalid token
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", authScheme+" token......"))
+	_, err = interceptor(ctx, nil, unaryServerInfo, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// missing tenant
+	_, err = interceptor(context.Background(), nil, unaryServerInfo, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// ignore method
+	interceptor = UnaryServerTenant(WithTenantIgnoreMethods(unaryServerInfo.FullMethod))
+	_, err = interceptor(context.Background(), nil, unaryServerInfo, handler)
+	assert.NoError(t, err)
+}
+
+func TestStreamServerTenant(t *testing.T) {
+	interceptor := StreamServerTenant(WithTenantMetadataKey("tenant"))
+	assert.NotNil(t, interceptor)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("tenant", "t1"))
+	err := interceptor(nil, newStreamServer(ctx), streamServerInfo, func(srv interface{}, stream grpc.ServerStream) error {
+		assert.Equal(t, "t1", tenant.FromContext(stream.Context()))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	err = interceptor(nil, newStreamServer(context.Background()), streamServerInfo, streamServerHandler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -112,6 +112,12 @@ func SetServerPattern(pattern string) {
 	}
 }
 
+// ServerRegisterer get the registry of the server side metrics, the collectors registered to it
+// are exported by /metrics of the server, e.g. the metrics of database
+func ServerRegisterer() prometheus.Registerer {
+	return srvReg
+}
+
 // Register for http routing and grpc methods
 func Register(mux *http.ServeMux, grpcServer *grpc.Server) {
 	// register for http routing
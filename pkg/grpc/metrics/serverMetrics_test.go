@@ -75,6 +75,11 @@ func TestRegister(t *testing.T) {
 	Register(http.NewServeMux(), grpc.NewServer())
 }
 
+func TestServerRegisterer(t *testing.T) {
+	err := ServerRegisterer().Register(prometheus.NewCounter(prometheus.CounterOpts{Name: "demo5"}))
+	assert.NoError(t, err)
+}
+
 func TestServerHTTPService(t *testing.T) {
 	serverAddr, _ := utils.GetLocalHTTPAddrPairs()
 	s := ServerHTTPService(serverAddr, grpc.NewServer())
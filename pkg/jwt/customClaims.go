@@ -8,13 +8,19 @@ import (
 
 // CustomClaims add custom fields to StandardClaims' payload
 type CustomClaims struct {
-	UID  string `json:"uid"`
-	Role string `json:"role"`
+	UID      string `json:"uid"`
+	Role     string `json:"role"`
+	TenantID string `json:"tenant_id,omitempty"`
 	jwt.StandardClaims
 }
 
 // GenerateToken generate token
 func GenerateToken(uid string, role ...string) (string, error) {
+	return GenerateTokenWithTenant(uid, "", role...)
+}
+
+// GenerateTokenWithTenant generate token with the tenant id of the user
+func GenerateTokenWithTenant(uid string, tenantID string, role ...string) (string, error) {
 	if opt == nil {
 		return "", errInit
 	}
@@ -24,9 +30,10 @@ func GenerateToken(uid string, role ...string) (string, error) {
 		roleVal = role[0]
 	}
 	claims := CustomClaims{
-		uid,
-		roleVal,
-		jwt.StandardClaims{
+		UID:      uid,
+		Role:     roleVal,
+		TenantID: tenantID,
+		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: time.Now().Add(opt.expire).Unix(),
 			Issuer:    opt.issuer,
 		},
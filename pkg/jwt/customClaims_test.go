@@ -19,6 +19,16 @@ func TestGenerateToken(t *testing.T) {
 	t.Log(token)
 }
 
+func TestGenerateTokenWithTenant(t *testing.T) {
+	Init()
+	token, err := GenerateTokenWithTenant("123", "t1", "admin")
+	assert.NoError(t, err)
+	v, err := VerifyToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "t1", v.TenantID)
+	assert.Equal(t, "admin", v.Role)
+}
+
 func TestVerifyToken(t *testing.T) {
 	opt = nil
 	v, err := VerifyToken("token")
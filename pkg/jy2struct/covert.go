@@ -3,6 +3,7 @@ package jy2struct
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 )
@@ -15,8 +16,10 @@ type Args struct {
 	Name      string // name of structure
 	SubStruct bool   // are sub-structures separated
 	Tags      string // add additional tags, multiple tags separated by commas
+	MapTypes  string // the fields whose values are the map of named sub-structures, format key:Type, multiple separated by commas, e.g. namedMysql:Mysql
 
 	tags          []string
+	mapTypes      map[string]string
 	convertFloats bool
 	parser        Parser
 }
@@ -41,6 +44,18 @@ func (j *Args) checkValid() error {
 		j.tags = append(j.tags, tag)
 	}
 
+	j.mapTypes = make(map[string]string)
+	for _, kv := range strings.Split(j.MapTypes, ",") {
+		if kv == "" {
+			continue
+		}
+		ss := strings.Split(kv, ":")
+		if len(ss) != 2 || ss[0] == "" || ss[1] == "" {
+			return fmt.Errorf("invalid map type '%s', the format is key:Type", kv)
+		}
+		j.mapTypes[ss[0]] = ss[1]
+	}
+
 	if j.Name == "" {
 		j.Name = "GenerateName"
 	}
@@ -67,7 +82,7 @@ func Covert(args *Args) (string, error) {
 
 	input := bytes.NewReader(data)
 
-	output, err := jyParse(input, args.parser, args.Name, "main", args.tags, args.SubStruct, args.convertFloats)
+	output, err := jyParse(input, args.parser, args.Name, "main", args.tags, args.SubStruct, args.convertFloats, args.mapTypes)
 	if err != nil {
 		return "", err
 	}
@@ -77,4 +77,30 @@ age: 10`,
 	arg = &Args{Format: "yaml", InputFile: "notfound.yaml"}
 	_, err = Covert(arg)
 	assert.Error(t, err)
+	arg = &Args{Format: "yaml", Data: "name: foo", MapTypes: "namedMysql"}
+	_, err = Covert(arg)
+	assert.Error(t, err)
+}
+
+func TestCovertWithMapTypes(t *testing.T) {
+	data := `mysql:
+  dsn: "root:123456@(127.0.0.1:3306)/account"
+  maxIdleConns: 3
+namedMysql:
+  order:
+    dsn: "root:123456@(127.0.0.1:3306)/order"
+    maxIdleConns: 3
+namedRedis: {}
+`
+	got, err := Covert(&Args{
+		Data:      data,
+		Format:    "yaml",
+		SubStruct: true,
+		MapTypes:  "namedMysql:Mysql,namedRedis:Redis",
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, got, "NamedMysql map[string]Mysql")
+	assert.Contains(t, got, "NamedRedis map[string]Redis")
+	assert.NotContains(t, got, "type Order struct")
+	t.Log(got)
 }
@@ -106,7 +106,7 @@ func readFile(input io.Reader) ([]byte, error) {
 }
 
 // json or yaml parse
-func jyParse(input io.Reader, parser Parser, structName, pkgName string, tags []string, subStruct bool, convertFloats bool) ([]byte, error) {
+func jyParse(input io.Reader, parser Parser, structName, pkgName string, tags []string, subStruct bool, convertFloats bool, mapTypes map[string]string) ([]byte, error) {
 	var subStructMap map[string]string = nil
 	if subStruct {
 		subStructMap = make(map[string]string)
@@ -125,8 +125,8 @@ func jyParse(input io.Reader, parser Parser, structName, pkgName string, tags []
 	case map[string]interface{}:
 		result = iresult
 	case []interface{}:
-		//src := fmt.Sprintf("package %s\n\ntype %s %s\n", pkgName, structName, typeForValue(iresult, structName, tags, subStructMap, convertFloats))
-		src := fmt.Sprintf("\ntype %s %s\n", structName, typeForValue(iresult, structName, tags, subStructMap, convertFloats))
+		//src := fmt.Sprintf("package %s\n\ntype %s %s\n", pkgName, structName, typeForValue(iresult, structName, tags, subStructMap, convertFloats, mapTypes))
+		src := fmt.Sprintf("\ntype %s %s\n", structName, typeForValue(iresult, structName, tags, subStructMap, convertFloats, mapTypes))
 		// supplementary sub-structures
 		for k, v := range subStructMap {
 			src += fmt.Sprintf("\n\ntype %s %s\n\n", v, k)
@@ -141,8 +141,8 @@ func jyParse(input io.Reader, parser Parser, structName, pkgName string, tags []
 		return nil, fmt.Errorf("unexpected type: %T", iresult)
 	}
 
-	//src := fmt.Sprintf("package %s\ntype %s %s}", pkgName, structName, generateTypes(result, structName, tags, 0, subStructMap, convertFloats))
-	src := fmt.Sprintf("\ntype %s %s}", structName, generateTypes(result, structName, tags, 0, subStructMap, convertFloats))
+	//src := fmt.Sprintf("package %s\ntype %s %s}", pkgName, structName, generateTypes(result, structName, tags, 0, subStructMap, convertFloats, mapTypes))
+	src := fmt.Sprintf("\ntype %s %s}", structName, generateTypes(result, structName, tags, 0, subStructMap, convertFloats, mapTypes))
 
 	keys := make([]string, 0, len(subStructMap))
 	for key := range subStructMap {
@@ -173,7 +173,7 @@ func convertKeysToStrings(obj map[interface{}]interface{}) map[string]interface{
 }
 
 // jyParse go struct entries for a map[string]interface{} structure
-func generateTypes(obj map[string]interface{}, structName string, tags []string, depth int, subStructMap map[string]string, convertFloats bool) string {
+func generateTypes(obj map[string]interface{}, structName string, tags []string, depth int, subStructMap map[string]string, convertFloats bool, mapTypes map[string]string) string {
 	structure := "struct {"
 
 	keys := make([]string, 0, len(obj))
@@ -184,65 +184,71 @@ func generateTypes(obj map[string]interface{}, structName string, tags []string,
 
 	for _, key := range keys {
 		value := obj[key]
-		valueType := typeForValue(value, structName, tags, subStructMap, convertFloats)
-
-		//value = mergeElements(value)
-		//If a nested value, recurse
-		switch value := value.(type) {
-		case []interface{}:
-			if len(value) > 0 {
-				sub := ""
-				if v, ok := value[0].(map[interface{}]interface{}); ok {
-					sub = generateTypes(convertKeysToStrings(v), structName, tags, depth+1, subStructMap, convertFloats) + "}"
-				} else if v, ok := value[0].(map[string]interface{}); ok {
-					sub = generateTypes(v, structName, tags, depth+1, subStructMap, convertFloats) + "}"
-				}
-
-				if sub != "" {
-					subName := sub
+		var valueType string
+		if typ, ok := mapTypes[key]; ok {
+			// the values are the named sub-structures of the same type, e.g. the named connections of databases
+			valueType = "map[string]" + typ
+		} else {
+			valueType = typeForValue(value, structName, tags, subStructMap, convertFloats, mapTypes)
+
+			//value = mergeElements(value)
+			//If a nested value, recurse
+			switch value := value.(type) {
+			case []interface{}:
+				if len(value) > 0 {
+					sub := ""
+					if v, ok := value[0].(map[interface{}]interface{}); ok {
+						sub = generateTypes(convertKeysToStrings(v), structName, tags, depth+1, subStructMap, convertFloats, mapTypes) + "}"
+					} else if v, ok := value[0].(map[string]interface{}); ok {
+						sub = generateTypes(v, structName, tags, depth+1, subStructMap, convertFloats, mapTypes) + "}"
+					}
 
-					if subStructMap != nil {
-						if val, ok := subStructMap[sub]; ok {
-							subName = val
-						} else {
-							//subName = fmt.Sprintf("%v_sub%v", structName, len(subStructMap)+1)
-							subName = FmtFieldName(key) // use the field name word
-							subStructMap[sub] = subName
+					if sub != "" {
+						subName := sub
+
+						if subStructMap != nil {
+							if val, ok := subStructMap[sub]; ok {
+								subName = val
+							} else {
+								//subName = fmt.Sprintf("%v_sub%v", structName, len(subStructMap)+1)
+								subName = FmtFieldName(key) // use the field name word
+								subStructMap[sub] = subName
+							}
 						}
-					}
 
-					valueType = "[]" + subName
+						valueType = "[]" + subName
+					}
 				}
-			}
-		case map[interface{}]interface{}:
-			sub := generateTypes(convertKeysToStrings(value), structName, tags, depth+1, subStructMap, convertFloats) + "}"
-			subName := sub
-
-			if subStructMap != nil {
-				if val, ok := subStructMap[sub]; ok {
-					subName = val
-				} else {
-					//subName = fmt.Sprintf("%v_sub%v", structName, len(subStructMap)+1)
-					subName = FmtFieldName(key) // use the field name word
-					subStructMap[sub] = subName
+			case map[interface{}]interface{}:
+				sub := generateTypes(convertKeysToStrings(value), structName, tags, depth+1, subStructMap, convertFloats, mapTypes) + "}"
+				subName := sub
+
+				if subStructMap != nil {
+					if val, ok := subStructMap[sub]; ok {
+						subName = val
+					} else {
+						//subName = fmt.Sprintf("%v_sub%v", structName, len(subStructMap)+1)
+						subName = FmtFieldName(key) // use the field name word
+						subStructMap[sub] = subName
+					}
 				}
-			}
-			valueType = subName
-		case map[string]interface{}:
-			sub := generateTypes(value, structName, tags, depth+1, subStructMap, convertFloats) + "}"
-			subName := sub
-
-			if subStructMap != nil {
-				if val, ok := subStructMap[sub]; ok {
-					subName = val
-				} else {
-					//subName = fmt.Sprintf("%v_sub%v", structName, len(subStructMap)+1)
-					subName = FmtFieldName(key) // use the field name word
-					subStructMap[sub] = subName
+				valueType = subName
+			case map[string]interface{}:
+				sub := generateTypes(value, structName, tags, depth+1, subStructMap, convertFloats, mapTypes) + "}"
+				subName := sub
+
+				if subStructMap != nil {
+					if val, ok := subStructMap[sub]; ok {
+						subName = val
+					} else {
+						//subName = fmt.Sprintf("%v_sub%v", structName, len(subStructMap)+1)
+						subName = FmtFieldName(key) // use the field name word
+						subStructMap[sub] = subName
+					}
 				}
-			}
 
-			valueType = subName
+				valueType = subName
+			}
 		}
 
 		fieldName := FmtFieldName(key)
@@ -380,7 +386,7 @@ func lintFieldName(name string) string {
 }
 
 // generate an appropriate struct type entry
-func typeForValue(value interface{}, structName string, tags []string, subStructMap map[string]string, convertFloats bool) string {
+func typeForValue(value interface{}, structName string, tags []string, subStructMap map[string]string, convertFloats bool, mapTypes map[string]string) string {
 	//Check if this is an array
 	if objects, ok := value.([]interface{}); ok {
 		types := make(map[reflect.Type]bool, 0)
@@ -388,13 +394,13 @@ func typeForValue(value interface{}, structName string, tags []string, subStruct
 			types[reflect.TypeOf(o)] = true
 		}
 		if len(types) == 1 {
-			return "[]" + typeForValue(mergeElements(objects).([]interface{})[0], structName, tags, subStructMap, convertFloats)
+			return "[]" + typeForValue(mergeElements(objects).([]interface{})[0], structName, tags, subStructMap, convertFloats, mapTypes)
 		}
 		return "[]interface{}"
 	} else if object, ok := value.(map[interface{}]interface{}); ok {
-		return generateTypes(convertKeysToStrings(object), structName, tags, 0, subStructMap, convertFloats) + "}"
+		return generateTypes(convertKeysToStrings(object), structName, tags, 0, subStructMap, convertFloats, mapTypes) + "}"
 	} else if object, ok := value.(map[string]interface{}); ok {
-		return generateTypes(object, structName, tags, 0, subStructMap, convertFloats) + "}"
+		return generateTypes(object, structName, tags, 0, subStructMap, convertFloats, mapTypes) + "}"
 	} else if reflect.TypeOf(value) == nil {
 		return "interface{}"
 	}
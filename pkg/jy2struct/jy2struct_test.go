@@ -18,7 +18,7 @@ func TestParseError(t *testing.T) {
 	_, err = ParseYaml(r)
 	assert.Error(t, err)
 
-	_, err = jyParse(r, ParseYaml, "", "", nil, false, false)
+	_, err = jyParse(r, ParseYaml, "", "", nil, false, false, nil)
 	assert.Error(t, err)
 
 	v := FmtFieldName("")
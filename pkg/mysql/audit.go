@@ -0,0 +1,51 @@
+package mysql
+
+import (
+	"github.com/zhufuyi/sponge/pkg/ctxvalue"
+
+	"gorm.io/gorm"
+)
+
+const (
+	auditPluginName = "sponge:audit"
+
+	// the columns of the users who created and last updated the record
+	columnCreatedBy = "created_by"
+	columnUpdatedBy = "updated_by"
+)
+
+// the plugin of gorm that fills the audit columns created_by and updated_by with the uid of the authenticated
+// user in the context, e.g. the uid stored by middleware.Auth, the columns are unchanged if there is no uid,
+// it is enabled by WithAudit
+type auditPlugin struct{}
+
+// Name of plugin
+func (p *auditPlugin) Name() string {
+	return auditPluginName
+}
+
+// Initialize register the callbacks to fill the audit columns before creating and updating
+func (p *auditPlugin) Initialize(db *gorm.DB) error {
+	err := db.Callback().Create().Before("gorm:create").Register(auditPluginName+":create", p.setColumns(columnCreatedBy, columnUpdatedBy))
+	if err != nil {
+		return err
+	}
+	return db.Callback().Update().Before("gorm:update").Register(auditPluginName+":update", p.setColumns(columnUpdatedBy))
+}
+
+func (p *auditPlugin) setColumns(columns ...string) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		if db.Error != nil || db.Statement.Schema == nil {
+			return
+		}
+		uid := ctxvalue.UID(db.Statement.Context)
+		if uid == "" {
+			return
+		}
+		for _, column := range columns {
+			if field := db.Statement.Schema.LookUpField(column); field != nil {
+				db.Statement.SetColumn(field.DBName, uid, true)
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zhufuyi/sponge/pkg/ctxvalue"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+type auditExample struct {
+	ID        uint64 `gorm:"column:id;primary_key"`
+	Name      string `gorm:"column:name"`
+	CreatedBy string `gorm:"column:created_by"`
+	UpdatedBy int64  `gorm:"column:updated_by"`
+}
+
+func TestAuditPlugin(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	err := d.DB.Use(&auditPlugin{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), ctxvalue.UIDKey, "100") //nolint
+
+	// create
+	record := &auditExample{Name: "foo"}
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").
+		WithArgs("foo", "100", 100).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectCommit()
+	err = d.DB.WithContext(ctx).Create(record).Error
+	assert.NoError(t, err)
+	assert.Equal(t, "100", record.CreatedBy)
+	assert.Equal(t, int64(100), record.UpdatedBy)
+
+	// create in batch
+	records := []*auditExample{{Name: "foo"}, {Name: "bar"}}
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").
+		WithArgs("foo", "100", 100, "bar", "100", 100).
+		WillReturnResult(sqlmock.NewResult(2, 2))
+	d.SQLMock.ExpectCommit()
+	err = d.DB.WithContext(ctx).Create(records).Error
+	assert.NoError(t, err)
+
+	// update, the created_by is unchanged
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .* SET `name`=\\?,`updated_by`=\\? WHERE .*").
+		WithArgs("bar", "100", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectCommit()
+	err = d.DB.WithContext(ctx).Model(&auditExample{ID: 1}).Updates(KV{"name": "bar"}).Error
+	assert.NoError(t, err)
+
+	// no uid in the context
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").
+		WithArgs("foo", "", 0).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectCommit()
+	err = d.DB.WithContext(context.Background()).Create(&auditExample{Name: "foo"}).Error
+	assert.NoError(t, err)
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+}
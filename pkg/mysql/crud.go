@@ -2,12 +2,32 @@ package mysql
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"reflect"
 
 	"github.com/zhufuyi/sponge/pkg/mysql/query"
+	"github.com/zhufuyi/sponge/pkg/tenant"
 
+	"github.com/spf13/cast"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
 )
 
+// DefaultBatchSize the number of records inserted by one statement in CreateBatch
+const DefaultBatchSize = 100
+
+const (
+	// the column of optimistic locking, it is increased by 1 each time the record is updated
+	columnVersion = "version"
+	// the column of soft delete, the type of the field must be gorm.DeletedAt
+	columnDeletedAt = "deleted_at"
+)
+
+// ErrVersionConflict the record has been modified by others since it was read, the version is not matched
+var ErrVersionConflict = errors.New("version conflict, the record has been modified by others")
+
 // TableName get table name
 func TableName(table interface{}) string {
 	return GetTableName(table)
@@ -19,6 +39,115 @@ func Create(ctx context.Context, db *gorm.DB, table interface{}) error {
 	return db.WithContext(ctx).Create(table).Error
 }
 
+// CreateBatch create multiple records, inserted in chunks of batchSize records, the default is DefaultBatchSize,
+// the ids are written back to the records
+// the param of 'tables' must be slice of pointer, eg: []*StructName
+func CreateBatch(ctx context.Context, db *gorm.DB, tables interface{}, batchSize int) error {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	return db.WithContext(ctx).CreateInBatches(tables, batchSize).Error
+}
+
+// Upsert create a record, or update it if a record with the same values of conflictColumns already exists,
+// conflictColumns are the columns of a unique key, if empty, the primary key is used. all the columns are updated
+// except the primary key and created time, and the id of the created or updated record is written back to the table.
+// if the table has the tenant_id column, conflictColumns must include tenant_id, every unique key of the table must
+// include tenant_id on mysql, and the primary key of table is ignored, unless the context is set by tenant.WithBypass
+// the param of 'table' must be pointer, eg: &StructName
+func Upsert(ctx context.Context, db *gorm.DB, table interface{}, conflictColumns ...string) error {
+	db = db.WithContext(ctx)
+	if err := db.Statement.Parse(table); err != nil {
+		return err
+	}
+	sch := db.Statement.Schema
+	if sch.PrioritizedPrimaryField == nil {
+		return fmt.Errorf("the primary key of %s is not found", sch.Name)
+	}
+
+	columns := make([]clause.Column, 0, len(conflictColumns))
+	fields := make([]*schema.Field, 0, len(conflictColumns))
+	for _, name := range conflictColumns {
+		field := sch.LookUpField(name)
+		if field == nil {
+			return fmt.Errorf("conflict column '%s' not found in %s", name, sch.Name)
+		}
+		columns = append(columns, clause.Column{Name: field.DBName})
+		fields = append(fields, field)
+	}
+	if len(columns) == 0 {
+		columns = append(columns, clause.Column{Name: sch.PrioritizedPrimaryField.DBName})
+	}
+	if err := checkTenantConflict(ctx, db, table, sch, fields); err != nil {
+		return err
+	}
+
+	result := db.Clauses(clause.OnConflict{Columns: columns, UpdateAll: true}).Create(table)
+	if result.Error != nil {
+		return result.Error
+	}
+	// the affected rows of mysql are 1 if the record is created, 2 if updated and 0 if unchanged,
+	// the id of the existing record is not returned, it is read by the values of the conflict columns
+	if result.RowsAffected == 1 || len(fields) == 0 {
+		return nil
+	}
+	rv := reflect.ValueOf(table)
+	where := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		where[field.DBName], _ = field.ValueOf(ctx, rv)
+	}
+	// the non-zero primary key would be used as condition of the query
+	if err := sch.PrioritizedPrimaryField.Set(ctx, rv, reflect.Zero(sch.PrioritizedPrimaryField.FieldType).Interface()); err != nil {
+		return err
+	}
+	return db.Session(&gorm.Session{NewDB: true, Context: WithPrimary(ctx)}).Unscoped().Model(table).
+		Select(sch.PrioritizedPrimaryField.DBName).Where(where).Take(table).Error
+}
+
+// the update of upsert is not limited by the tenant scope, the record of other tenants would be overwritten if the
+// conflict columns do not include tenant_id, and the primary key of the table is ignored for the same reason
+func checkTenantConflict(ctx context.Context, db *gorm.DB, table interface{}, sch *schema.Schema, fields []*schema.Field) error {
+	if sch.LookUpField(columnTenantID) == nil || tenant.IsBypass(ctx) {
+		return nil
+	}
+	hasTenantID := false
+	for _, field := range fields {
+		if field.DBName == columnTenantID {
+			hasTenantID = true
+			break
+		}
+	}
+	if !hasTenantID {
+		return fmt.Errorf("the conflict columns of the tenant table %s must include %s", sch.Name, columnTenantID)
+	}
+	if err := checkTenantUniqueKeys(ctx, db); err != nil {
+		return err
+	}
+	pk := sch.PrioritizedPrimaryField
+	return pk.Set(ctx, reflect.ValueOf(table), reflect.Zero(pk.FieldType).Interface())
+}
+
+// the conflict columns are ignored by mysql, ON DUPLICATE KEY UPDATE is triggered by any unique key,
+// a unique key without tenant_id would overwrite the record of other tenants, the upsert is refused
+func checkTenantUniqueKeys(ctx context.Context, db *gorm.DB) error {
+	if db.Dialector.Name() != "mysql" {
+		return nil
+	}
+	var names []string
+	err := db.Session(&gorm.Session{NewDB: true, Context: WithPrimary(ctx)}).Raw(
+		"SELECT INDEX_NAME FROM information_schema.STATISTICS "+
+			"WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND NON_UNIQUE = 0 AND INDEX_NAME <> 'PRIMARY' "+
+			"GROUP BY INDEX_NAME HAVING SUM(COLUMN_NAME = ?) = 0",
+		db.Statement.Table, columnTenantID).Scan(&names).Error
+	if err != nil {
+		return err
+	}
+	if len(names) > 0 {
+		return fmt.Errorf("the unique keys %v of the tenant table %s must include %s", names, db.Statement.Table, columnTenantID)
+	}
+	return nil
+}
+
 // Delete record
 // the param of 'table' must be pointer, eg: &StructName
 func Delete(ctx context.Context, db *gorm.DB, table interface{}, query interface{}, args ...interface{}) error {
@@ -43,6 +172,118 @@ func Updates(ctx context.Context, db *gorm.DB, table interface{}, update KV, que
 	return db.WithContext(ctx).Model(table).Where(query, args...).Updates(update).Error
 }
 
+// UpdateByID update the columns in update of the record by the primary key of table, gorm.ErrRecordNotFound is
+// returned if the record does not exist. if the table has a version column, the record is updated only if its
+// version is equal to the version of table, otherwise ErrVersionConflict is returned, the version is increased
+// by 1 and written back to the table after updating
+// the param of 'table' must be pointer, eg: &StructName
+func UpdateByID(ctx context.Context, db *gorm.DB, table interface{}, update KV) error {
+	db = db.WithContext(ctx)
+	if err := db.Statement.Parse(table); err != nil {
+		return err
+	}
+	field := db.Statement.Schema.LookUpField(columnVersion)
+	if field == nil {
+		result := db.Model(table).Updates(update)
+		if result.Error != nil {
+			return result.Error
+		}
+		// no affected rows means the record does not exist or the values are not changed
+		if result.RowsAffected == 0 {
+			return notFound(db, table)
+		}
+		return nil
+	}
+
+	rv := reflect.ValueOf(table)
+	version, _ := field.ValueOf(ctx, rv)
+	kv := make(KV, len(update)+1)
+	for k, v := range update {
+		kv[k] = v
+	}
+	kv[field.DBName] = gorm.Expr(field.DBName + " + 1")
+	result := db.Model(table).Where(field.DBName+" = ?", version).Updates(kv)
+	if result.Error != nil {
+		return result.Error
+	}
+	// the version is changed by each update, no affected rows means the version is not matched or the record does not exist
+	if result.RowsAffected == 0 {
+		return versionConflictOrNotFound(db, table)
+	}
+	return field.Set(ctx, rv, cast.ToInt64(version)+1)
+}
+
+// distinguish the version conflict from the record that does not exist or is not visible in the scopes of db,
+// e.g. soft deleted or belongs to other tenants
+func versionConflictOrNotFound(db *gorm.DB, table interface{}) error {
+	if db.Statement.Schema.PrioritizedPrimaryField == nil {
+		return ErrVersionConflict
+	}
+	if err := notFound(db, table); err != nil {
+		return err
+	}
+	return ErrVersionConflict
+}
+
+// return gorm.ErrRecordNotFound if the record of the primary key of table does not exist or is not visible
+// in the scopes of db, return nil if it exists
+func notFound(db *gorm.DB, table interface{}) error {
+	pk := db.Statement.Schema.PrioritizedPrimaryField
+	if pk == nil {
+		return nil
+	}
+	id, _ := pk.ValueOf(db.Statement.Context, reflect.ValueOf(table))
+	var count int64
+	err := db.WithContext(WithPrimary(db.Statement.Context)).Model(table).Where(pk.DBName+" = ?", id).Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// UpdateByIDs update the columns in update of the records by multiple id, if the table has a version column,
+// the versions of the records are increased by 1 without checking
+// the param of 'table' must be pointer, eg: &StructName
+func UpdateByIDs(ctx context.Context, db *gorm.DB, table interface{}, ids interface{}, update KV) error {
+	db = db.WithContext(ctx)
+	if err := db.Statement.Parse(table); err != nil {
+		return err
+	}
+	if field := db.Statement.Schema.LookUpField(columnVersion); field != nil {
+		kv := make(KV, len(update)+1)
+		for k, v := range update {
+			kv[k] = v
+		}
+		kv[field.DBName] = gorm.Expr(field.DBName + " + 1")
+		update = kv
+	}
+	return db.Model(table).Where("id IN (?)", ids).Updates(update).Error
+}
+
+// Restore restore the soft deleted records by multiple id, the table must have the deleted_at column of gorm.DeletedAt
+// the param of 'table' must be pointer, eg: &StructName
+func Restore(ctx context.Context, db *gorm.DB, table interface{}, ids interface{}) error {
+	db = db.WithContext(ctx)
+	if err := db.Statement.Parse(table); err != nil {
+		return err
+	}
+	field := db.Statement.Schema.LookUpField(columnDeletedAt)
+	if field == nil || field.FieldType != reflect.TypeOf(gorm.DeletedAt{}) {
+		return fmt.Errorf("%s does not support soft delete, the %s column of gorm.DeletedAt is not found",
+			db.Statement.Schema.Name, columnDeletedAt)
+	}
+	return db.Unscoped().Model(table).Where("id IN (?)", ids).Update(field.DBName, nil).Error
+}
+
+// HardDelete delete the records by multiple id permanently, including the soft deleted records
+// the param of 'table' must be pointer, eg: &StructName
+func HardDelete(ctx context.Context, db *gorm.DB, table interface{}, ids interface{}) error {
+	return db.WithContext(ctx).Unscoped().Where("id IN (?)", ids).Delete(table).Error
+}
+
 // Get one record
 // the param of 'table' must be pointer, eg: &StructName
 func Get(ctx context.Context, db *gorm.DB, table interface{}, query interface{}, args ...interface{}) error {
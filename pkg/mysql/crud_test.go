@@ -54,6 +54,59 @@ func TestCreate(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateBatch(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+
+	tables := []*userExample{
+		{Name: "ZhangSan", Age: 20, Gender: "male"},
+		{Name: "LiSi", Age: 21, Gender: "female"},
+		{Name: "WangWu", Age: 22, Gender: "male"},
+	}
+
+	// chunks of 2 records
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").WillReturnResult(sqlmock.NewResult(1, 2))
+	d.SQLMock.ExpectExec("INSERT INTO .*").WillReturnResult(sqlmock.NewResult(3, 1))
+	d.SQLMock.ExpectCommit()
+
+	err := CreateBatch(d.Ctx, d.DB, tables, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2, 3}, []uint64{tables[0].ID, tables[1].ID, tables[2].ID})
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+}
+
+func TestUpsert(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+
+	// insert a new record
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .* ON DUPLICATE KEY UPDATE .*").WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectCommit()
+	record := &userExample{Name: "ZhangSan", Age: 20, Gender: "male"}
+	err := Upsert(d.Ctx, d.DB, record, "name")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), record.ID)
+
+	// update the existing record, the id is read by the conflict column
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .* ON DUPLICATE KEY UPDATE .*").WillReturnResult(sqlmock.NewResult(5, 2))
+	d.SQLMock.ExpectCommit()
+	d.SQLMock.ExpectQuery("SELECT `id` FROM `user_example` WHERE `name` = .*").
+		WithArgs("LiSi").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	record = &userExample{Name: "LiSi", Age: 21, Gender: "female"}
+	err = Upsert(d.Ctx, d.DB, record, "name")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), record.ID)
+
+	// unknown conflict column
+	err = Upsert(d.Ctx, d.DB, &userExample{Name: "WangWu"}, "unknown")
+	assert.Error(t, err)
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+}
+
 func TestDelete(t *testing.T) {
 	d := newUserExampleDao()
 	defer d.Close()
@@ -115,6 +168,144 @@ func TestUpdates(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+type versionExample struct {
+	ID      uint64 `gorm:"column:id;primary_key"`
+	Name    string `gorm:"column:name"`
+	Version int    `gorm:"column:version"`
+}
+
+func TestUpdateByID(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+
+	// without version column
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .*").
+		WithArgs(21, d.AnyTime, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectCommit()
+	err := UpdateByID(d.Ctx, d.DB, &userExample{Model: Model{ID: 1}}, KV{"age": 21})
+	assert.NoError(t, err)
+
+	// without version column, the record does not exist
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .*").
+		WithArgs(21, d.AnyTime, 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	d.SQLMock.ExpectCommit()
+	d.SQLMock.ExpectQuery("SELECT count\\(\\*\\) FROM `user_example` WHERE id = \\?").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	err = UpdateByID(d.Ctx, d.DB, &userExample{Model: Model{ID: 2}}, KV{"age": 21})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	// without version column, the values are not changed
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .*").
+		WithArgs(21, d.AnyTime, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	d.SQLMock.ExpectCommit()
+	d.SQLMock.ExpectQuery("SELECT count\\(\\*\\) FROM `user_example` WHERE id = \\?").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	err = UpdateByID(d.Ctx, d.DB, &userExample{Model: Model{ID: 1}}, KV{"age": 21})
+	assert.NoError(t, err)
+
+	// the version is matched
+	record := &versionExample{ID: 1, Version: 3}
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .* SET .*version`=version \\+ 1 WHERE .*version = \\?").
+		WithArgs("foo", 3, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectCommit()
+	err = UpdateByID(d.Ctx, d.DB, record, KV{"name": "foo"})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, record.Version)
+
+	// the version is not matched
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .*").
+		WithArgs("bar", 4, 1).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+	d.SQLMock.ExpectCommit()
+	d.SQLMock.ExpectQuery("SELECT count\\(\\*\\) FROM .* WHERE id = \\?").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	err = UpdateByID(d.Ctx, d.DB, record, KV{"name": "bar"})
+	assert.ErrorIs(t, err, ErrVersionConflict)
+	assert.Equal(t, 4, record.Version)
+
+	// the record does not exist
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .*").
+		WithArgs("bar", 4, 1).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+	d.SQLMock.ExpectCommit()
+	d.SQLMock.ExpectQuery("SELECT count\\(\\*\\) FROM .*").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	err = UpdateByID(d.Ctx, d.DB, record, KV{"name": "bar"})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.Equal(t, 4, record.Version)
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+}
+
+func TestUpdateByIDs(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .* WHERE id IN .*").
+		WithArgs(21, d.AnyTime, 1, 2).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	d.SQLMock.ExpectCommit()
+
+	err := UpdateByIDs(d.Ctx, d.DB, table, []uint64{1, 2}, KV{"age": 21})
+	assert.NoError(t, err)
+
+	// the versions are increased
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .* SET .*version`=version \\+ 1 WHERE id IN .*").
+		WithArgs("foo", 1, 2).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	d.SQLMock.ExpectCommit()
+	err = UpdateByIDs(d.Ctx, d.DB, &versionExample{}, []uint64{1, 2}, KV{"name": "foo"})
+	assert.NoError(t, err)
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+}
+
+func TestRestore(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .* SET `deleted_at`=.* WHERE id IN .*").
+		WithArgs(nil, d.AnyTime, 1, 2).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	d.SQLMock.ExpectCommit()
+	err := Restore(d.Ctx, d.DB, table, []uint64{1, 2})
+	assert.NoError(t, err)
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+
+	// no deleted_at column
+	err = Restore(d.Ctx, d.DB, &versionExample{}, []uint64{1, 2})
+	assert.Error(t, err)
+}
+
+func TestHardDelete(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("DELETE FROM .* WHERE id IN .*").
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	d.SQLMock.ExpectCommit()
+	err := HardDelete(d.Ctx, d.DB, table, []uint64{1, 2})
+	assert.NoError(t, err)
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+}
+
 func TestGetByID(t *testing.T) {
 	d := newUserExampleDao()
 	defer d.Close()
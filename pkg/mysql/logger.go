@@ -0,0 +1,116 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zhufuyi/sponge/pkg/ctxvalue"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm/logger"
+)
+
+// the directory of this package, the callers in it are skipped like the callers in gorm
+var sourceDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file) + "/"
+}()
+
+// the logger of gorm that outputs to zap, the request id in the context is added to each log
+type zapLogger struct {
+	zapLog        *zap.Logger
+	level         logger.LogLevel
+	slowThreshold time.Duration
+}
+
+func newZapLogger(zapLog *zap.Logger, level logger.LogLevel, slowThreshold time.Duration) logger.Interface {
+	return &zapLogger{
+		zapLog:        zapLog,
+		level:         level,
+		slowThreshold: slowThreshold,
+	}
+}
+
+// LogMode set the log level
+func (l *zapLogger) LogMode(level logger.LogLevel) logger.Interface {
+	newLogger := *l
+	newLogger.level = level
+	return &newLogger
+}
+
+// Info print info
+func (l *zapLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Info {
+		l.zapLog.Info(fmt.Sprintf(msg, data...), l.fields(ctx)...)
+	}
+}
+
+// Warn print warn messages
+func (l *zapLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Warn {
+		l.zapLog.Warn(fmt.Sprintf(msg, data...), l.fields(ctx)...)
+	}
+}
+
+// Error print error messages
+func (l *zapLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Error {
+		l.zapLog.Error(fmt.Sprintf(msg, data...), l.fields(ctx)...)
+	}
+}
+
+// Trace print sql, the errors are printed at error level, the slow sql at warn level and the others at info level
+func (l *zapLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	if l.level <= logger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	switch {
+	case err != nil && l.level >= logger.Error && !errors.Is(err, logger.ErrRecordNotFound):
+		l.zapLog.Error("sql error", append(l.traceFields(ctx, elapsed, fc), zap.Error(err))...)
+	case l.slowThreshold > 0 && elapsed > l.slowThreshold && l.level >= logger.Warn:
+		l.zapLog.Warn("slow sql", append(l.traceFields(ctx, elapsed, fc), zap.Duration("threshold", l.slowThreshold))...)
+	case l.level == logger.Info:
+		l.zapLog.Info("sql", l.traceFields(ctx, elapsed, fc)...)
+	}
+}
+
+func (l *zapLogger) fields(ctx context.Context) []zap.Field {
+	fields := []zap.Field{zap.String("file", callerFile())}
+	if ctx != nil {
+		if requestID := ctxvalue.RequestID(ctx); requestID != "" {
+			fields = append(fields, zap.String(ctxvalue.RequestIDKey, requestID))
+		}
+	}
+	return fields
+}
+
+func (l *zapLogger) traceFields(ctx context.Context, elapsed time.Duration, fc func() (string, int64)) []zap.Field {
+	sql, rows := fc()
+	return append(l.fields(ctx),
+		zap.String("sql", sql),
+		zap.Int64("rows", rows),
+		zap.Duration("elapsed", elapsed),
+	)
+}
+
+// the first caller outside of gorm and this package, e.g. the dao that executes the sql
+func callerFile() string {
+	for i := 3; i < 20; i++ {
+		_, file, line, ok := runtime.Caller(i)
+		if !ok {
+			break
+		}
+		if strings.HasSuffix(file, "_test.go") || (!strings.Contains(file, "gorm.io/") && !strings.HasPrefix(file, sourceDir)) {
+			return file + ":" + strconv.Itoa(line)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,80 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zhufuyi/sponge/pkg/ctxvalue"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestZapLogger(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	core, logs := observer.New(zapcore.DebugLevel)
+	ctx := context.WithValue(d.Ctx, ctxvalue.RequestIDKey, "foo-request-id") //nolint
+	newDB := func(level logger.LogLevel, slowThreshold time.Duration) *gorm.DB {
+		return d.DB.Session(&gorm.Session{Logger: newZapLogger(zap.New(core), level, slowThreshold)}).WithContext(ctx)
+	}
+
+	// print all sql
+	d.SQLMock.ExpectQuery("SELECT .*").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	err := newDB(logger.Info, 0).Where("id = ?", 1).First(&userExample{}).Error
+	assert.NoError(t, err)
+	entries := logs.TakeAll()
+	if assert.Len(t, entries, 1) {
+		fields := entries[0].ContextMap()
+		assert.Equal(t, zapcore.InfoLevel, entries[0].Level)
+		assert.Equal(t, "foo-request-id", fields[ctxvalue.RequestIDKey])
+		assert.Contains(t, fields["sql"], "SELECT")
+		assert.Contains(t, fields["file"], "logger_test.go")
+	}
+
+	// print the errors, record not found is not an error
+	d.SQLMock.ExpectQuery("SELECT .*").WillReturnError(errors.New("mock error"))
+	d.SQLMock.ExpectQuery("SELECT .*").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	db := newDB(logger.Error, 0)
+	err = db.Where("id = ?", 1).First(&userExample{}).Error
+	assert.Error(t, err)
+	err = db.Where("id = ?", 2).First(&userExample{}).Error
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	entries = logs.TakeAll()
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, zapcore.ErrorLevel, entries[0].Level)
+		assert.Equal(t, "mock error", entries[0].ContextMap()["error"])
+	}
+
+	// print the slow sql
+	d.SQLMock.ExpectQuery("SELECT .*").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	err = newDB(logger.Warn, time.Nanosecond).Where("id = ?", 1).First(&userExample{}).Error
+	assert.NoError(t, err)
+	entries = logs.TakeAll()
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, zapcore.WarnLevel, entries[0].Level)
+		assert.Equal(t, "slow sql", entries[0].Message)
+	}
+
+	// silent
+	d.SQLMock.ExpectQuery("SELECT .*").WillReturnError(errors.New("mock error"))
+	l := newZapLogger(zap.New(core), logger.Info, 0).LogMode(logger.Silent)
+	err = d.DB.Session(&gorm.Session{Logger: l}).Where("id = ?", 1).First(&userExample{}).Error
+	assert.Error(t, err)
+	l.Info(ctx, "info %d", 1)
+	assert.Zero(t, logs.Len())
+
+	l = l.LogMode(logger.Info)
+	l.Info(ctx, "info %d", 1)
+	l.Warn(ctx, "warn %d", 2)
+	l.Error(nil, "error %d", 3) //nolint
+	assert.Equal(t, 3, logs.Len())
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+}
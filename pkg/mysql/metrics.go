@@ -0,0 +1,143 @@
+package mysql
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"gorm.io/gorm"
+)
+
+const (
+	metricsPluginName = "sponge:metrics"
+	metricsStartKey   = metricsPluginName + ":start"
+)
+
+var (
+	metricsNamespace = "gorm"
+
+	metricsLabels = []string{"db", "table", "operation"}
+
+	queryDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "query_duration_seconds",
+			Help:      "SQL execution latencies in seconds.",
+		}, metricsLabels,
+	)
+
+	queryErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "query_errors_total",
+			Help:      "Total number of SQL execution errors, record not found is not an error.",
+		}, metricsLabels,
+	)
+)
+
+// the plugin of gorm that exports the duration and errors of sql, and the stats of the connection pools
+type metricsPlugin struct {
+	dbName      string
+	registerers []prometheus.Registerer
+}
+
+func newMetricsPlugin(dbName string, registerers ...prometheus.Registerer) *metricsPlugin {
+	if len(registerers) == 0 {
+		registerers = []prometheus.Registerer{prometheus.DefaultRegisterer}
+	}
+	return &metricsPlugin{dbName: dbName, registerers: registerers}
+}
+
+// Name of plugin
+func (p *metricsPlugin) Name() string {
+	return metricsPluginName
+}
+
+// Initialize register the metrics and the callbacks to observe the sql
+func (p *metricsPlugin) Initialize(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	stats := []prometheus.Collector{collectors.NewDBStatsCollector(sqlDB, p.dbName)}
+	// the replicas plugin must be used before the metrics plugin
+	if plugin, ok := db.Config.Plugins[replicaPluginName].(*replicaPlugin); ok {
+		for i, replica := range plugin.replicas {
+			stats = append(stats, collectors.NewDBStatsCollector(replica, fmt.Sprintf("%s_replica%d", p.dbName, i)))
+		}
+	}
+	for _, registerer := range p.registerers {
+		for _, metric := range []prometheus.Collector{queryDuration, queryErrors} {
+			if err = registerer.Register(metric); err != nil {
+				// the metrics of sql are shared by all databases
+				are := prometheus.AlreadyRegisteredError{}
+				if !errors.As(err, &are) || are.ExistingCollector != metric {
+					return err
+				}
+			}
+		}
+		for _, metric := range stats {
+			if err = registerStats(registerer, metric); err != nil {
+				return fmt.Errorf("register the stats of connection pool '%s' error: %v", p.dbName, err)
+			}
+		}
+	}
+
+	callbacks := []error{
+		db.Callback().Create().Before("gorm:create").Register(metricsPluginName+":before_create", p.before),
+		db.Callback().Create().After("gorm:create").Register(metricsPluginName+":after_create", p.after("create")),
+		db.Callback().Query().Before("gorm:query").Register(metricsPluginName+":before_query", p.before),
+		db.Callback().Query().After("gorm:query").Register(metricsPluginName+":after_query", p.after("query")),
+		db.Callback().Update().Before("gorm:update").Register(metricsPluginName+":before_update", p.before),
+		db.Callback().Update().After("gorm:update").Register(metricsPluginName+":after_update", p.after("update")),
+		db.Callback().Delete().Before("gorm:delete").Register(metricsPluginName+":before_delete", p.before),
+		db.Callback().Delete().After("gorm:delete").Register(metricsPluginName+":after_delete", p.after("delete")),
+		db.Callback().Row().Before("gorm:row").Register(metricsPluginName+":before_row", p.before),
+		db.Callback().Row().After("gorm:row").Register(metricsPluginName+":after_row", p.after("row")),
+		db.Callback().Raw().Before("gorm:raw").Register(metricsPluginName+":before_raw", p.before),
+		db.Callback().Raw().After("gorm:raw").Register(metricsPluginName+":after_raw", p.after("raw")),
+	}
+	for _, err = range callbacks {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// the stats of a connection pool with the same name are replaced, the connection is initialized again after closing,
+// the names of the connections that are used at the same time must be different, see WithName
+func registerStats(registerer prometheus.Registerer, c prometheus.Collector) error {
+	err := registerer.Register(c)
+	are := prometheus.AlreadyRegisteredError{}
+	if errors.As(err, &are) {
+		registerer.Unregister(are.ExistingCollector)
+		err = registerer.Register(c)
+	}
+	return err
+}
+
+func (p *metricsPlugin) before(db *gorm.DB) {
+	db.InstanceSet(metricsStartKey, time.Now())
+}
+
+func (p *metricsPlugin) after(operation string) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(metricsStartKey)
+		if !ok {
+			return
+		}
+		start, ok := v.(time.Time)
+		if !ok {
+			return
+		}
+
+		lvs := []string{p.dbName, db.Statement.Table, operation}
+		queryDuration.WithLabelValues(lvs...).Observe(time.Since(start).Seconds())
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			queryErrors.WithLabelValues(lvs...).Inc()
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package mysql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsPlugin(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	registry := prometheus.NewRegistry()
+	err := d.DB.Use(newMetricsPlugin("test", registry))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d.SQLMock.ExpectQuery("SELECT .*").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	d.SQLMock.ExpectQuery("SELECT .*").WillReturnError(errors.New("mock error"))
+	d.SQLMock.ExpectQuery("SELECT .*").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	for i := 0; i < 3; i++ {
+		_ = d.DB.Where("id = ?", i).First(&userExample{}).Error
+	}
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .*").WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectCommit()
+	err = d.DB.Model(&userExample{}).Where("id = ?", 1).Update("age", 21).Error
+	assert.NoError(t, err)
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+
+	// record not found is not an error
+	table := GetTableName(&userExample{})
+	assert.Equal(t, float64(1), testutil.ToFloat64(queryErrors.WithLabelValues("test", table, "query")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(queryErrors.WithLabelValues("test", table, "update")))
+
+	mfs, err := registry.Gather()
+	assert.NoError(t, err)
+	names := map[string]bool{}
+	for _, mf := range mfs {
+		names[mf.GetName()] = true
+		if mf.GetName() == "gorm_query_duration_seconds" {
+			var count uint64
+			for _, m := range mf.GetMetric() {
+				count += m.GetHistogram().GetSampleCount()
+			}
+			assert.Equal(t, uint64(4), count)
+		}
+	}
+	assert.True(t, names["gorm_query_duration_seconds"])
+	assert.True(t, names["gorm_query_errors_total"])
+	assert.True(t, names["go_sql_open_connections"])
+
+	// the metrics of sql are shared by the databases
+	d2 := newUserExampleDao()
+	defer d2.Close()
+	err = d2.DB.Use(newMetricsPlugin("test2", registry))
+	assert.NoError(t, err)
+
+	// the stats of the connection pool initialized again with the same name are replaced
+	d3 := newUserExampleDao()
+	defer d3.Close()
+	err = d3.DB.Use(newMetricsPlugin("test2", registry))
+	assert.NoError(t, err)
+	_, err = registry.Gather()
+	assert.NoError(t, err)
+
+	// the metrics with the same name registered by the others are not ignored
+	registry = prometheus.NewRegistry()
+	registry.MustRegister(prometheus.NewCounterVec(prometheus.CounterOpts{Namespace: metricsNamespace, Name: "query_duration_seconds"}, []string{"db"}))
+	d4 := newUserExampleDao()
+	defer d4.Close()
+	err = d4.DB.Use(newMetricsPlugin("test4", registry))
+	assert.Error(t, err)
+}
+
+func Test_getDBName(t *testing.T) {
+	assert.Equal(t, "test", getDBName(dsn))
+	assert.Equal(t, "mysql", getDBName("unknown"))
+}
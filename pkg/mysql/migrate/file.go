@@ -0,0 +1,188 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// the migration file name is <version>_<name>.up.sql or <version>_<name>.down.sql
+var fileNameRegexp = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+var invalidNameRegexp = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+// the version is the time when the migration is created
+const versionLayout = "20060102150405"
+
+// Migration a versioned migration
+type Migration struct {
+	Version int64
+	Name    string
+	UpSQL   string // statements to migrate up
+	DownSQL string // statements to roll back
+}
+
+// Load the migrations in the directory, sorted by version
+func Load(dir string) ([]*Migration, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		matches := fileNameRegexp.FindStringSubmatch(entry.Name())
+		if len(matches) == 0 {
+			continue
+		}
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version of migration file %s, %v", entry.Name(), err)
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := versions[version]
+		if !ok {
+			m = &Migration{Version: version, Name: matches[2]}
+			versions[version] = m
+		} else if m.Name != matches[2] {
+			return nil, fmt.Errorf("duplicate migration version %d, %s and %s", version, m.Name, matches[2])
+		}
+		if matches[3] == "up" {
+			m.UpSQL = string(data)
+		} else {
+			m.DownSQL = string(data)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(versions))
+	for _, m := range versions {
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Save write the up and down files of a new migration to the directory, the version is the current time,
+// return the paths of the up and down files
+func Save(dir string, name string, upSQL string, downSQL string) (string, string, error) {
+	name = strings.Trim(invalidNameRegexp.ReplaceAllString(name, "_"), "_")
+	if name == "" {
+		return "", "", fmt.Errorf("migration name is empty")
+	}
+	err := os.MkdirAll(dir, 0766)
+	if err != nil {
+		return "", "", err
+	}
+
+	migrations, err := Load(dir)
+	if err != nil {
+		return "", "", err
+	}
+	version, _ := strconv.ParseInt(time.Now().Format(versionLayout), 10, 64)
+	// the versions must be increasing, even if the migrations are created in the same second
+	if n := len(migrations); n > 0 && migrations[n-1].Version >= version {
+		version = migrations[n-1].Version + 1
+	}
+
+	upFile := filepath.Join(dir, fmt.Sprintf("%d_%s.up.sql", version, name))
+	downFile := filepath.Join(dir, fmt.Sprintf("%d_%s.down.sql", version, name))
+	if err = os.WriteFile(upFile, []byte(upSQL), 0666); err != nil {
+		return "", "", err
+	}
+	if err = os.WriteFile(downFile, []byte(downSQL), 0666); err != nil {
+		return "", "", err
+	}
+
+	return upFile, downFile, nil
+}
+
+// SplitStatements split the sql into statements by semicolons, the semicolons in the quoted strings,
+// identifiers and comments are ignored, the comments are removed
+func SplitStatements(sql string) []string {
+	var (
+		statements []string
+		current    strings.Builder
+	)
+	appendStatement := func() {
+		if s := strings.TrimSpace(current.String()); s != "" {
+			statements = append(statements, s)
+		}
+		current.Reset()
+	}
+
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			end := i + 1
+			for ; end < len(sql); end++ {
+				if sql[end] == '\\' && c != '`' {
+					end++
+					continue
+				}
+				if sql[end] == c {
+					// two quotes in a row is an escaped quote
+					if end+1 < len(sql) && sql[end+1] == c {
+						end++
+						continue
+					}
+					break
+				}
+			}
+			if end >= len(sql) {
+				end = len(sql) - 1
+			}
+			current.WriteString(sql[i : end+1])
+			i = end
+
+		case c == '#' || isDashComment(sql[i:]):
+			end := strings.IndexByte(sql[i:], '\n')
+			if end < 0 {
+				i = len(sql)
+			} else {
+				i += end - 1 // keep the line break
+			}
+
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				i = len(sql)
+			} else {
+				i += end + 3
+			}
+			current.WriteByte(' ')
+
+		case c == ';':
+			appendStatement()
+
+		default:
+			current.WriteByte(c)
+		}
+	}
+	appendStatement()
+
+	return statements
+}
+
+// the double dash comment must be followed by a whitespace in mysql
+func isDashComment(s string) bool {
+	if !strings.HasPrefix(s, "--") {
+		return false
+	}
+	return len(s) == 2 || s[2] == ' ' || s[2] == '\t' || s[2] == '\n' || s[2] == '\r'
+}
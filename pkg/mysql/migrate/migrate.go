@@ -0,0 +1,253 @@
+// Package migrate applies the versioned sql migrations in a directory to the database,
+// the applied versions are recorded in a schema version table.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/zhufuyi/sponge/pkg/mysql"
+
+	"gorm.io/gorm"
+)
+
+type schemaMigration struct {
+	Version   int64     `gorm:"column:version;primary_key;autoIncrement:false"`
+	Name      string    `gorm:"column:name;type:varchar(255);NOT NULL"`
+	AppliedAt time.Time `gorm:"column:applied_at;NOT NULL"`
+}
+
+// Status the status of the migration
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time // nil means pending
+	IsMissing bool       // the migration is applied, but the file is not found in the directory
+}
+
+// Migrator apply the migrations in the directory
+type Migrator struct {
+	db          *gorm.DB
+	dir         string
+	tableName   string
+	lockTimeout time.Duration
+}
+
+// New create a migrator, dir is the directory of the migration files
+func New(db *gorm.DB, dir string, opts ...Option) *Migrator {
+	o := defaultOptions()
+	o.apply(opts...)
+
+	return &Migrator{
+		db:          db,
+		dir:         dir,
+		tableName:   o.tableName,
+		lockTimeout: o.lockTimeout,
+	}
+}
+
+// Up apply the pending migrations in order, steps is the maximum number of the migrations to be applied,
+// if steps <= 0, all pending migrations are applied, return the applied migrations.
+// the migrations are applied by one instance at the same time, the others wait for the lock and skip the applied ones
+func (m *Migrator) Up(ctx context.Context, steps int) ([]*Migration, error) {
+	migrations, err := Load(m.dir)
+	if err != nil {
+		return nil, err
+	}
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	applied, err := m.getApplied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []*Migration
+	for _, migration := range migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+		if steps > 0 && len(done) >= steps {
+			break
+		}
+
+		err = m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := execStatements(tx, migration.UpSQL); err != nil {
+				return err
+			}
+			return tx.Table(m.tableName).Create(&schemaMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now(),
+			}).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("migrate up %d_%s error, %v", migration.Version, migration.Name, err)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Down roll back the applied migrations in the reverse order, steps is the maximum number of the migrations
+// to be rolled back, if steps <= 0, all applied migrations are rolled back, return the rolled back migrations
+func (m *Migrator) Down(ctx context.Context, steps int) ([]*Migration, error) {
+	migrations, err := Load(m.dir)
+	if err != nil {
+		return nil, err
+	}
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	applied, err := m.getApplied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]int64, 0, len(applied))
+	for version := range applied {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+
+	var done []*Migration
+	for _, version := range versions {
+		if steps > 0 && len(done) >= steps {
+			break
+		}
+
+		var migration *Migration
+		for _, mg := range migrations {
+			if mg.Version == version {
+				migration = mg
+				break
+			}
+		}
+		if migration == nil {
+			return done, fmt.Errorf("the file of migration %d_%s is not found in %s", version, applied[version].Name, m.dir)
+		}
+
+		err = m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := execStatements(tx, migration.DownSQL); err != nil {
+				return err
+			}
+			return tx.Table(m.tableName).Where("version = ?", version).Delete(&schemaMigration{}).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("migrate down %d_%s error, %v", migration.Version, migration.Name, err)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Status get the status of the migrations, sorted by version
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	migrations, err := Load(m.dir)
+	if err != nil {
+		return nil, err
+	}
+	applied, err := m.getApplied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*Status, 0, len(migrations))
+	for _, migration := range migrations {
+		status := &Status{Version: migration.Version, Name: migration.Name}
+		if record, ok := applied[migration.Version]; ok {
+			appliedAt := record.AppliedAt
+			status.AppliedAt = &appliedAt
+			delete(applied, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+	for _, record := range applied {
+		appliedAt := record.AppliedAt
+		statuses = append(statuses, &Status{
+			Version:   record.Version,
+			Name:      record.Name,
+			AppliedAt: &appliedAt,
+			IsMissing: true,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+
+	return statuses, nil
+}
+
+// get the applied migrations, the schema version table is created if not exists,
+// it is read from the primary, the replicas may not have the versions just applied
+func (m *Migrator) getApplied(ctx context.Context) (map[int64]*schemaMigration, error) {
+	db := m.db.WithContext(mysql.WithPrimary(ctx))
+	err := db.Table(m.tableName).AutoMigrate(&schemaMigration{})
+	if err != nil {
+		return nil, fmt.Errorf("create table %s error, %v", m.tableName, err)
+	}
+
+	var records []*schemaMigration
+	err = db.Table(m.tableName).Find(&records).Error
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int64]*schemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
+
+// take the mysql advisory lock of the migrations, the mysql DDL statements are committed implicitly, they can't be
+// run by several instances at the same time. the lock is held by a dedicated connection of the primary until unlock
+// is called, it is released by mysql if the connection is closed. the other databases are not locked.
+func (m *Migrator) lock(ctx context.Context) (func(), error) {
+	if m.db.Dialector.Name() != "mysql" {
+		return func() {}, nil
+	}
+	sqlDB, err := m.db.DB()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the lock is server-wide, it is named by the database and the version table
+	var locked sql.NullInt64
+	err = conn.QueryRowContext(ctx, "SELECT GET_LOCK(CONCAT(DATABASE(), ':', ?), ?)",
+		m.tableName, int(m.lockTimeout.Seconds())).Scan(&locked)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("get the lock of migrations error, %v", err)
+	}
+	if locked.Int64 != 1 {
+		_ = conn.Close()
+		return nil, fmt.Errorf("get the lock of migrations timeout after %s, the migrations are being applied by others", m.lockTimeout)
+	}
+
+	return func() {
+		_, _ = conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(CONCAT(DATABASE(), ':', ?))", m.tableName)
+		_ = conn.Close()
+	}, nil
+}
+
+// the mysql DDL statements cause an implicit commit, they are not rolled back if the migration fails
+func execStatements(tx *gorm.DB, sql string) error {
+	for _, statement := range SplitStatements(sql) {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package migrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/zhufuyi/sponge/pkg/gotest"
+	"github.com/zhufuyi/sponge/pkg/sqlite"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := sqlite.Init(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = sqlite.Close(db) })
+	return db
+}
+
+func writeFile(t *testing.T, dir string, name string, content string) {
+	err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigrator(t *testing.T) {
+	db := newTestDB(t)
+	dir := t.TempDir()
+	writeFile(t, dir, "1_create_users.up.sql", "CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT); -- users")
+	writeFile(t, dir, "1_create_users.down.sql", "DROP TABLE users;")
+	writeFile(t, dir, "2_add_age.up.sql", "ALTER TABLE users ADD COLUMN age INTEGER;\nINSERT INTO users (name) VALUES ('a;b');")
+	writeFile(t, dir, "2_add_age.down.sql", "ALTER TABLE users DROP COLUMN age;")
+	writeFile(t, dir, "README.md", "ignored")
+
+	ctx := context.Background()
+	m := New(db, dir, WithTableName("versions"))
+
+	statuses, err := m.Status(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 2)
+	assert.Nil(t, statuses[0].AppliedAt)
+
+	done, err := m.Up(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, done, 1)
+	assert.Equal(t, "create_users", done[0].Name)
+
+	done, err = m.Up(ctx, 0)
+	assert.NoError(t, err)
+	assert.Len(t, done, 1)
+	assert.Equal(t, int64(2), done[0].Version)
+	var name string
+	assert.NoError(t, db.Raw("SELECT name FROM users WHERE age IS NULL").Scan(&name).Error)
+	assert.Equal(t, "a;b", name)
+
+	// no pending migrations
+	done, err = m.Up(ctx, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, done)
+
+	statuses, err = m.Status(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, statuses[1].AppliedAt)
+
+	done, err = m.Down(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, done, 1)
+	assert.Equal(t, int64(2), done[0].Version)
+	assert.Error(t, db.Exec("SELECT age FROM users").Error)
+
+	done, err = m.Down(ctx, 0)
+	assert.NoError(t, err)
+	assert.Len(t, done, 1)
+	assert.False(t, db.Migrator().HasTable("users"))
+
+	// failed migration is not recorded
+	writeFile(t, dir, "3_bad.up.sql", "CREATE TABLE;")
+	_, err = m.Up(ctx, 0)
+	assert.Error(t, err)
+	statuses, err = m.Status(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, statuses[0].AppliedAt)
+	assert.NotNil(t, statuses[1].AppliedAt)
+	assert.Nil(t, statuses[2].AppliedAt)
+
+	// the file of the applied migration is removed
+	assert.NoError(t, os.Remove(filepath.Join(dir, "2_add_age.up.sql")))
+	assert.NoError(t, os.Remove(filepath.Join(dir, "2_add_age.down.sql")))
+	statuses, err = m.Status(ctx)
+	assert.NoError(t, err)
+	assert.True(t, statuses[1].IsMissing)
+	_, err = m.Down(ctx, 1)
+	assert.Error(t, err)
+}
+
+func TestMigrator_lock(t *testing.T) {
+	d := gotest.NewDao(nil, nil)
+	defer d.Close()
+	m := New(d.DB, t.TempDir(), WithLockTimeout(time.Second*3))
+
+	d.SQLMock.ExpectQuery("SELECT GET_LOCK\\(CONCAT\\(DATABASE\\(\\), ':', \\?\\), \\?\\)").
+		WithArgs("schema_migrations", 3).
+		WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(1))
+	d.SQLMock.ExpectExec("SELECT RELEASE_LOCK.*").
+		WithArgs("schema_migrations").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	unlock, err := m.lock(context.Background())
+	assert.NoError(t, err)
+	unlock()
+
+	// the lock is held by others until timeout, the migrations are not applied
+	d.SQLMock.ExpectQuery("SELECT GET_LOCK.*").
+		WithArgs("schema_migrations", 3).
+		WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(0))
+	_, err = m.Up(context.Background(), 0)
+	assert.Error(t, err)
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "1_a.up.sql", "")
+	writeFile(t, dir, "1_b.down.sql", "")
+	_, err := Load(dir)
+	assert.Error(t, err)
+
+	_, err = Load(filepath.Join(dir, "not_found"))
+	assert.Error(t, err)
+}
+
+func TestSave(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "migrations")
+	upFile, downFile, err := Save(dir, "add users", "CREATE TABLE users (id INTEGER);\n", "DROP TABLE users;\n")
+	assert.NoError(t, err)
+	assert.FileExists(t, upFile)
+	assert.FileExists(t, downFile)
+
+	// the version is increasing in the same second
+	_, _, err = Save(dir, "add-orders", "CREATE TABLE orders (id INTEGER);\n", "DROP TABLE orders;\n")
+	assert.NoError(t, err)
+	migrations, err := Load(dir)
+	assert.NoError(t, err)
+	assert.Len(t, migrations, 2)
+	assert.Equal(t, "add_users", migrations[0].Name)
+	assert.Equal(t, "add_orders", migrations[1].Name)
+	assert.Less(t, migrations[0].Version, migrations[1].Version)
+
+	_, _, err = Save(dir, "!", "", "")
+	assert.Error(t, err)
+}
+
+func TestSplitStatements(t *testing.T) {
+	sql := "-- comment; here\n" +
+		"CREATE TABLE `a;b` (id int COMMENT 'it''s; \\' ok');\n" +
+		"# another; comment\n" +
+		"INSERT INTO t VALUES (\"x;y\") /* block; comment */;\n" +
+		"SELECT 1--2;\n" +
+		"  ;\n" +
+		"SELECT 3"
+	assert.Equal(t, []string{
+		"CREATE TABLE `a;b` (id int COMMENT 'it''s; \\' ok')",
+		"INSERT INTO t VALUES (\"x;y\")",
+		"SELECT 1--2",
+		"SELECT 3",
+	}, SplitStatements(sql))
+}
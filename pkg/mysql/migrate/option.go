@@ -0,0 +1,44 @@
+package migrate
+
+import "time"
+
+// Option set the migrator options.
+type Option func(*options)
+
+type options struct {
+	tableName   string
+	lockTimeout time.Duration
+}
+
+func (o *options) apply(opts ...Option) {
+	for _, opt := range opts {
+		opt(o)
+	}
+}
+
+// default settings
+func defaultOptions() *options {
+	return &options{
+		tableName:   "schema_migrations", // the table that records the applied migrations
+		lockTimeout: time.Minute * 10,    // the time to wait for the other instances applying the migrations
+	}
+}
+
+// WithTableName set the name of the table that records the applied migrations
+func WithTableName(name string) Option {
+	return func(o *options) {
+		if name != "" {
+			o.tableName = name
+		}
+	}
+}
+
+// WithLockTimeout set the time to wait for the lock of the migrations, the lock is held by the instance that is
+// applying the migrations, e.g. several replicas of the service start at the same time, default is 10 minutes
+func WithLockTimeout(d time.Duration) Option {
+	return func(o *options) {
+		if d > 0 {
+			o.lockTimeout = d
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 
+	driver "github.com/go-sql-driver/mysql"
 	"github.com/uptrace/opentelemetry-go-extra/otelgorm"
 	mysqlDriver "gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -18,13 +19,10 @@ func Init(dns string, opts ...Option) (*gorm.DB, error) {
 	o := defaultOptions()
 	o.apply(opts...)
 
-	sqlDB, err := sql.Open("mysql", dns)
+	sqlDB, err := openDB(dns, o)
 	if err != nil {
 		return nil, err
 	}
-	sqlDB.SetMaxIdleConns(o.maxIdleConns)       // set the maximum number of connections in the idle connection pool
-	sqlDB.SetMaxOpenConns(o.maxOpenConns)       // set the maximum number of open database connections
-	sqlDB.SetConnMaxLifetime(o.connMaxLifetime) // set the maximum time a connection can be reused
 
 	db, err := gorm.Open(mysqlDriver.New(mysqlDriver.Config{Conn: sqlDB}), gormConfig(o))
 	if err != nil {
@@ -32,6 +30,21 @@ func Init(dns string, opts ...Option) (*gorm.DB, error) {
 	}
 	db.Set("gorm:table_options", "CHARSET=utf8mb4") // automatic appending of table suffixes when creating tables
 
+	if len(o.replicas) > 0 {
+		err = useReplicas(db, o)
+		if err != nil {
+			_ = sqlDB.Close()
+			return nil, err
+		}
+	}
+
+	if o.enableAudit {
+		err = db.Use(&auditPlugin{})
+		if err != nil {
+			return nil, fmt.Errorf("using gorm audit, err: %v", err)
+		}
+	}
+
 	if o.enableTrace {
 		err = db.Use(otelgorm.NewPlugin())
 		if err != nil {
@@ -39,9 +52,72 @@ func Init(dns string, opts ...Option) (*gorm.DB, error) {
 		}
 	}
 
+	if o.enableMetrics {
+		name := o.name
+		if name == "" {
+			name = getDBName(dns)
+		}
+		err = db.Use(newMetricsPlugin(name, o.metricsRegisterers...))
+		if err != nil {
+			return nil, fmt.Errorf("using gorm metrics, err: %v", err)
+		}
+	}
+
 	return db, nil
 }
 
+func openDB(dsn string, o *options) (*sql.DB, error) {
+	sqlDB, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxIdleConns(o.maxIdleConns)       // set the maximum number of connections in the idle connection pool
+	sqlDB.SetMaxOpenConns(o.maxOpenConns)       // set the maximum number of open database connections
+	sqlDB.SetConnMaxLifetime(o.connMaxLifetime) // set the maximum time a connection can be reused
+	return sqlDB, nil
+}
+
+// the replicas share the settings of connection pool with the primary
+func useReplicas(db *gorm.DB, o *options) error {
+	replicas := make([]*sql.DB, 0, len(o.replicas))
+	closeReplicas := func() {
+		for _, replica := range replicas {
+			_ = replica.Close()
+		}
+	}
+	for _, dsn := range o.replicas {
+		sqlDB, err := openDB(dsn, o)
+		if err != nil {
+			closeReplicas()
+			return err
+		}
+		replicas = append(replicas, sqlDB)
+		if err = sqlDB.Ping(); err != nil {
+			closeReplicas()
+			return fmt.Errorf("connect to replica error, err: %v", err)
+		}
+	}
+
+	plugin, err := newReplicaPlugin(replicas, o.replicaPolicy)
+	if err == nil {
+		err = db.Use(plugin)
+	}
+	if err != nil {
+		closeReplicas()
+		return fmt.Errorf("using replicas, err: %v", err)
+	}
+	return nil
+}
+
+// the database name in dsn, it is the label of metrics
+func getDBName(dsn string) string {
+	cfg, err := driver.ParseDSN(dsn)
+	if err != nil || cfg.DBName == "" {
+		return "mysql"
+	}
+	return cfg.DBName
+}
+
 // gorm setting
 func gormConfig(o *options) *gorm.Config {
 	config := &gorm.Config{
@@ -51,6 +127,19 @@ func gormConfig(o *options) *gorm.Config {
 		NamingStrategy: schema.NamingStrategy{SingularTable: true},
 	}
 
+	// output to zap, the level is the same as the default logger
+	if o.zapLog != nil {
+		level := logger.Silent
+		if o.isLog {
+			level = logger.Info
+		}
+		if o.slowThreshold > 0 {
+			level = logger.Warn
+		}
+		config.Logger = newZapLogger(o.zapLog, level, o.slowThreshold)
+		return config
+	}
+
 	// print all SQL
 	if o.isLog {
 		config.Logger = logger.Default.LogMode(logger.Info)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
 )
 
 var dsn = "root:123456@(192.168.3.37:3306)/test?charset=utf8mb4&parseTime=True&loc=Local"
@@ -35,6 +36,14 @@ func Test_gormConfig(t *testing.T) {
 
 	c := gormConfig(o)
 	assert.NotNil(t, c)
+	assert.False(t, o.enableAudit)
+	o.apply(WithAudit())
+	assert.True(t, o.enableAudit)
+
+	o.apply(WithLogger(zap.NewNop()), WithMetrics())
+	c = gormConfig(o)
+	assert.IsType(t, &zapLogger{}, c.Logger)
+	assert.True(t, o.enableMetrics)
 }
 
 func TestGetTableName(t *testing.T) {
@@ -2,6 +2,9 @@ package mysql
 
 import (
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
 )
 
 // Option set the mysql options.
@@ -10,6 +13,7 @@ type Option func(*options)
 type options struct {
 	isLog         bool
 	slowThreshold time.Duration
+	zapLog        *zap.Logger
 
 	maxIdleConns    int
 	maxOpenConns    int
@@ -17,6 +21,14 @@ type options struct {
 
 	disableForeignKey bool
 	enableTrace       bool
+	enableAudit       bool
+
+	enableMetrics      bool
+	metricsRegisterers []prometheus.Registerer
+	name               string
+
+	replicas      []string
+	replicaPolicy string
 }
 
 func (o *options) apply(opts ...Option) {
@@ -37,6 +49,9 @@ func defaultOptions() *options {
 
 		disableForeignKey: true,  // disables the use of foreign keys, true is recommended for production environments, enabled by default
 		enableTrace:       false, // whether to enable link tracing, default is off
+		enableAudit:       false, // whether to fill the audit columns, default is off
+
+		replicaPolicy: ReplicaPolicyRandom, // the policy of selecting a replica
 	}
 }
 
@@ -54,6 +69,14 @@ func WithSlowThreshold(d time.Duration) Option {
 	}
 }
 
+// WithLogger set the zap logger to output the logs of gorm, e.g. logger.Get() of pkg/logger, the request id
+// in the context is added to the logs, the level of logs is still decided by WithLog and WithSlowThreshold.
+func WithLogger(zapLog *zap.Logger) Option {
+	return func(o *options) {
+		o.zapLog = zapLog
+	}
+}
+
 // WithMaxIdleConns set max idle conns
 func WithMaxIdleConns(size int) Option {
 	return func(o *options) {
@@ -88,3 +111,44 @@ func WithEnableTrace() Option {
 		o.enableTrace = true
 	}
 }
+
+// WithAudit fill the audit columns created_by and updated_by with the uid of the authenticated user in the context
+// when creating and updating, the uid is stored by middleware.Auth of pkg/gin/middleware
+func WithAudit() Option {
+	return func(o *options) {
+		o.enableAudit = true
+	}
+}
+
+// WithMetrics export the duration and errors of sql by table and operation and the stats of the connection pools
+// to prometheus, the metrics are registered to registerers, default is prometheus.DefaultRegisterer which is
+// served by pkg/gin/middleware/metrics, use ServerRegisterer() of pkg/grpc/metrics for the grpc server.
+func WithMetrics(registerers ...prometheus.Registerer) Option {
+	return func(o *options) {
+		o.enableMetrics = true
+		o.metricsRegisterers = append(o.metricsRegisterers, registerers...)
+	}
+}
+
+// WithName set the name of the connection, it is the label db of the metrics that distinguishes the connection
+// pools, default is the database name of dsn, the connections to the databases with the same name must use different names.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.name = name
+	}
+}
+
+// WithReplicas set the dsn of the read replicas, the reads outside of transactions are sent to the replicas,
+// the writes and transactions are sent to the primary
+func WithReplicas(dsns ...string) Option {
+	return func(o *options) {
+		o.replicas = append(o.replicas, dsns...)
+	}
+}
+
+// WithReplicaPolicy set the policy of selecting a replica, ReplicaPolicyRandom, ReplicaPolicyRoundRobin or ReplicaPolicyLeastConn
+func WithReplicaPolicy(policy string) Option {
+	return func(o *options) {
+		o.replicaPolicy = policy
+	}
+}
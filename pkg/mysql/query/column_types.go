@@ -0,0 +1,163 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParamsError the query parameters are not allowed or invalid, Field is the path of the parameter,
+// e.g. columns[0].value, sort
+type ParamsError struct {
+	Field  string
+	Reason string
+}
+
+// Error returns the field and reason
+func (e *ParamsError) Error() string {
+	return e.Field + ": " + e.Reason
+}
+
+// the layouts of the time values, the values without time zone are parsed in local time
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// CheckColumns check the filter and sort columns against the allow-list, and convert the values to the types of the columns.
+// the param of 'columnTypes' is the map of column names and go types, e.g. model.UserExampleColumns generated by sql2code,
+// the supported types are the integer, float, string, bool, time.Time and sql.NullXXX types, the json path of the column
+// with type json is allowed, the values of other types are not converted.
+func (p *Params) CheckColumns(columnTypes map[string]string) error {
+	if err := checkColumns(p.Columns, columnTypes, "columns"); err != nil {
+		return err
+	}
+
+	for _, name := range strings.Split(strings.Replace(p.Sort, " ", "", -1), ",") {
+		name = strings.TrimPrefix(name, "-")
+		if name == "" {
+			continue
+		}
+		if _, ok := columnTypes[name]; !ok {
+			return &ParamsError{Field: "sort", Reason: fmt.Sprintf("column '%s' is not allowed to be sorted", name)}
+		}
+	}
+
+	return nil
+}
+
+func checkColumns(columns []Column, columnTypes map[string]string, path string) error {
+	for i := range columns {
+		column := &columns[i]
+		field := fmt.Sprintf("%s[%d]", path, i)
+		if len(column.Columns) > 0 {
+			if err := checkColumns(column.Columns, columnTypes, field+".columns"); err != nil {
+				return err
+			}
+			continue
+		}
+
+		name, isJSONPath := column.Name, false
+		if n := strings.Index(name, "->"); n > 0 {
+			name, isJSONPath = name[:n], true
+		}
+		typ, ok := columnTypes[name]
+		if !ok {
+			return &ParamsError{Field: field + ".name", Reason: fmt.Sprintf("column '%s' is not allowed to be queried", column.Name)}
+		}
+		if isJSONPath {
+			if typ != "json" {
+				return &ParamsError{Field: field + ".name", Reason: fmt.Sprintf("column '%s' is not a json column", name)}
+			}
+			continue // the type of the value in json is unknown
+		}
+
+		value, err := convertColumnValue(column, typ)
+		if err != nil {
+			return &ParamsError{Field: field + ".value", Reason: err.Error()}
+		}
+		column.Value = value
+	}
+	return nil
+}
+
+func convertColumnValue(column *Column, typ string) (interface{}, error) {
+	switch strings.ToLower(column.Exp) {
+	case IsNull, NotNull:
+		return column.Value, nil
+	case Like, Prefix, Suffix:
+		if column.Value == nil {
+			return nil, nil // checked by checkValid
+		}
+		return fmt.Sprintf("%v", column.Value), nil
+	case In, NotIn, Between:
+		values := toValues(column.Value)
+		for i, v := range values {
+			value, err := convertValue(v, typ)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		return values, nil
+	}
+
+	return convertValue(column.Value, typ)
+}
+
+// convert the value of json or form to the type of the column
+func convertValue(value interface{}, typ string) (interface{}, error) {
+	if value == nil {
+		return nil, nil // checked by checkValid
+	}
+
+	str := fmt.Sprintf("%v", value)
+	switch v := value.(type) {
+	case float64:
+		str = strconv.FormatFloat(v, 'f', -1, 64) // avoid the exponent format of big numbers
+	case json.Number:
+		str = v.String()
+	case time.Time:
+		if strings.HasSuffix(typ, "time.Time") || typ == "sql.NullTime" {
+			return v, nil
+		}
+	}
+
+	var (
+		val interface{}
+		err error
+	)
+	switch strings.TrimPrefix(typ, "*") {
+	case "int", "int8", "int16", "int32", "int64", "sql.NullInt16", "sql.NullInt32", "sql.NullInt64", "sql.NullByte":
+		val, err = strconv.ParseInt(str, 10, 64)
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		val, err = strconv.ParseUint(str, 10, 64)
+	case "float32", "float64", "sql.NullFloat64":
+		val, err = strconv.ParseFloat(str, 64)
+	case "bool", "sql.NullBool":
+		val, err = strconv.ParseBool(str)
+	case "string", "sql.NullString":
+		val = str
+	case "time.Time", "sql.NullTime":
+		val, err = parseTime(str)
+	default:
+		return value, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("value '%v' is not a valid %s", value, typ)
+	}
+	return val, nil
+}
+
+func parseTime(str string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		t, err := time.ParseInLocation(layout, str, time.Local)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time '%s'", str)
+}
@@ -0,0 +1,105 @@
+package query
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testColumnTypes = map[string]string{
+	"id":         "uint64",
+	"name":       "string",
+	"age":        "int",
+	"score":      "sql.NullFloat64",
+	"is_vip":     "bool",
+	"created_at": "time.Time",
+	"profile":    "json",
+}
+
+func TestParams_CheckColumns(t *testing.T) {
+	p := &Params{
+		Sort: "-age,name",
+		Columns: []Column{
+			{Name: "id", Exp: In, Value: "1, 2"},
+			{Name: "age", Exp: Between, Value: []interface{}{float64(18), "30"}},
+			{Name: "name", Exp: Like, Value: 123},
+			{
+				Columns: []Column{
+					{Name: "score", Exp: Gt, Value: "9.5", Logic: OR},
+					{Name: "is_vip", Value: "true"},
+				},
+			},
+			{Name: "created_at", Exp: Gte, Value: "2023-01-02"},
+			{Name: "profile->$.city", Value: "Shenzhen"},
+			{Name: "name", Exp: NotNull},
+		},
+	}
+	err := p.CheckColumns(testColumnTypes)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{uint64(1), uint64(2)}, p.Columns[0].Value)
+	assert.Equal(t, []interface{}{int64(18), int64(30)}, p.Columns[1].Value)
+	assert.Equal(t, "123", p.Columns[2].Value)
+	assert.Equal(t, 9.5, p.Columns[3].Columns[0].Value)
+	assert.Equal(t, true, p.Columns[3].Columns[1].Value)
+	assert.Equal(t, time.Date(2023, 1, 2, 0, 0, 0, 0, time.Local), p.Columns[4].Value)
+	assert.Equal(t, "Shenzhen", p.Columns[5].Value)
+
+	_, _, err = p.ConvertToGormConditions()
+	assert.NoError(t, err)
+}
+
+func TestParams_CheckColumnsError(t *testing.T) {
+	tests := []struct {
+		name   string
+		params *Params
+		field  string
+	}{
+		{
+			name:   "not allowed column",
+			params: &Params{Columns: []Column{{Name: "password", Value: "123456"}}},
+			field:  "columns[0].name",
+		},
+		{
+			name:   "injected column",
+			params: &Params{Columns: []Column{{Name: "id = 1 or 1", Value: 1}}},
+			field:  "columns[0].name",
+		},
+		{
+			name:   "not allowed sort",
+			params: &Params{Sort: "-password"},
+			field:  "sort",
+		},
+		{
+			name:   "invalid value",
+			params: &Params{Columns: []Column{{Name: "id", Value: 1}, {Columns: []Column{{Name: "age", Value: "abc"}}}}},
+			field:  "columns[1].columns[0].value",
+		},
+		{
+			name:   "invalid value of in",
+			params: &Params{Columns: []Column{{Name: "id", Exp: In, Value: "1,-2"}}},
+			field:  "columns[0].value",
+		},
+		{
+			name:   "invalid time",
+			params: &Params{Columns: []Column{{Name: "created_at", Value: "yesterday"}}},
+			field:  "columns[0].value",
+		},
+		{
+			name:   "json path of not json column",
+			params: &Params{Columns: []Column{{Name: "name->$.first", Value: "foo"}}},
+			field:  "columns[0].name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.CheckColumns(testColumnTypes)
+			var paramsErr *ParamsError
+			if assert.True(t, errors.As(err, &paramsErr)) {
+				assert.Equal(t, tt.field, paramsErr.Field)
+				t.Log(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package query
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm/schema"
+)
+
+// ErrInvalidCursor the cursor is tampered or does not match the sort parameter
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+var (
+	cursorSecret = newCursorSecret()
+
+	columnNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+	schemaCache = &sync.Map{}
+)
+
+// SetCursorSecret set the secret used to sign the cursors, the default is a random secret generated at startup,
+// the services that share the cursors must use the same secret, e.g. multiple instances of a service.
+func SetCursorSecret(secret string) {
+	if secret == "" {
+		return
+	}
+	cursorSecret = []byte(secret)
+}
+
+func newCursorSecret() []byte {
+	secret := make([]byte, 32)
+	_, _ = rand.Read(secret)
+	return secret
+}
+
+// the cursor is base64(payload).base64(hmac-sha256(payload))
+type cursorPayload struct {
+	Sort   string        `json:"s"` // the sort of the query that created the cursor
+	Values []cursorValue `json:"v"` // the last values of the sort columns
+}
+
+// the value is encoded as string with type, so that the big integers and times are decoded without loss
+type cursorValue struct {
+	Type  string `json:"t"`
+	Value string `json:"v"`
+}
+
+type sortColumn struct {
+	name string
+	desc bool
+}
+
+// ConvertToCursorPage converted to the order, limit and offset of gorm based on the page size sort parameter,
+// the same as ConvertToPage, but the id is appended to the order as a tie breaker, so that the records are
+// in a stable order and the next page can be queried by the cursor, see ConvertToCursor and NextCursor.
+func (p *Params) ConvertToCursorPage() (order string, limit int, offset int) {
+	order, limit, offset = p.ConvertToPage()
+	order = getCursorSort(p.Sort)
+	return
+}
+
+// ConvertToCursor converted to the keyset conditions of gorm based on the Cursor and Sort parameters,
+// only the records after the cursor are queried, used with the order of ConvertToCursorPage and without offset.
+// if the cursor is empty, it means the first page and the conditions are empty.
+func (p *Params) ConvertToCursor() (string, []interface{}, error) {
+	if p.Cursor == "" {
+		return "", nil, nil
+	}
+
+	columns, err := getSortColumns(p.Sort)
+	if err != nil {
+		return "", nil, err
+	}
+	payload, err := decodeCursor(p.Cursor)
+	if err != nil {
+		return "", nil, err
+	}
+	if payload.Sort != getCursorSort(p.Sort) || len(payload.Values) != len(columns) {
+		return "", nil, fmt.Errorf("%w, the cursor does not match the sort '%s'", ErrInvalidCursor, p.Sort)
+	}
+	values := make([]interface{}, 0, len(payload.Values))
+	for _, v := range payload.Values {
+		value, err := v.decode()
+		if err != nil {
+			return "", nil, err
+		}
+		values = append(values, value)
+	}
+
+	// the tuple comparison is expanded so that each column has its own direction, e.g. sort by age DESC, id ASC:
+	// (age < ?) OR (age = ? AND id > ?)
+	var (
+		ors  []string
+		args []interface{}
+	)
+	for i, column := range columns {
+		ands := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			ands = append(ands, columns[j].name+" = ?")
+			args = append(args, values[j])
+		}
+		if column.desc {
+			ands = append(ands, column.name+" < ?")
+		} else {
+			ands = append(ands, column.name+" > ?")
+		}
+		args = append(args, values[i])
+		ors = append(ors, "("+strings.Join(ands, " AND ")+")")
+	}
+
+	return "(" + strings.Join(ors, " OR ") + ")", args, nil
+}
+
+// NextCursor get the cursor of the next page based on the last record of the current page and the Sort parameter,
+// the param of 'record' must be pointer of the model struct, eg: &StructName.
+// the sort columns must be not null, the keyset conditions do not match the null values.
+func (p *Params) NextCursor(record interface{}) (string, error) {
+	columns, err := getSortColumns(p.Sort)
+	if err != nil {
+		return "", err
+	}
+	sch, err := schema.Parse(record, schemaCache, schema.NamingStrategy{})
+	if err != nil {
+		return "", err
+	}
+
+	rv := reflect.ValueOf(record)
+	payload := &cursorPayload{Sort: getCursorSort(p.Sort)}
+	for _, column := range columns {
+		field := sch.LookUpField(column.name)
+		if field == nil {
+			return "", fmt.Errorf("sort column '%s' not found in %s", column.name, sch.Name)
+		}
+		value, isZero := field.ValueOf(context.Background(), rv)
+		if isZero && reflect.ValueOf(value).Kind() == reflect.Ptr {
+			return "", fmt.Errorf("the value of sort column '%s' is null", column.name)
+		}
+		v, err := encodeValue(value)
+		if err != nil {
+			return "", fmt.Errorf("sort column '%s', %v", column.name, err)
+		}
+		payload.Values = append(payload.Values, v)
+	}
+
+	return encodeCursor(payload)
+}
+
+// get the sort of the keyset pagination, if id is not in the column names, it is appended in the direction
+// of the first column to make the order stable, e.g. columnNames="-age" means "age DESC, id DESC".
+func getCursorSort(columnNames string) string {
+	sort := getSort(columnNames)
+	sorts := strings.Split(sort, ", ")
+	for _, s := range sorts {
+		if name, _, _ := strings.Cut(s, " "); name == "id" {
+			return sort
+		}
+	}
+	if strings.HasSuffix(sorts[0], " DESC") {
+		return sort + ", id DESC"
+	}
+	return sort + ", id ASC"
+}
+
+// get the sort columns of the keyset pagination from the sort parameter, see getCursorSort
+func getSortColumns(columnNames string) ([]sortColumn, error) {
+	sorts := strings.Split(getCursorSort(columnNames), ", ")
+	columns := make([]sortColumn, 0, len(sorts))
+	for _, s := range sorts {
+		name, direction, _ := strings.Cut(s, " ")
+		if !columnNameRegexp.MatchString(name) {
+			return nil, fmt.Errorf("invalid sort column '%s'", name)
+		}
+		columns = append(columns, sortColumn{name: name, desc: direction == "DESC"})
+	}
+	return columns, nil
+}
+
+func encodeCursor(payload *cursorPayload) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data) + "." + base64.RawURLEncoding.EncodeToString(sign(data)), nil
+}
+
+func decodeCursor(cursor string) (*cursorPayload, error) {
+	data, signature, ok := strings.Cut(cursor, ".")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+	payloadData, err := base64.RawURLEncoding.DecodeString(data)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	signatureData, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(signatureData, sign(payloadData)) {
+		return nil, ErrInvalidCursor
+	}
+
+	payload := &cursorPayload{}
+	if err = json.Unmarshal(payloadData, payload); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return payload, nil
+}
+
+func sign(data []byte) []byte {
+	h := hmac.New(sha256.New, cursorSecret)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func encodeValue(value interface{}) (cursorValue, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return cursorValue{Type: "t", Value: v.Format(time.RFC3339Nano)}, nil
+	case *time.Time:
+		return cursorValue{Type: "t", Value: v.Format(time.RFC3339Nano)}, nil
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return cursorValue{}, err
+		}
+		if dv == nil {
+			return cursorValue{}, errors.New("the value is null")
+		}
+		return encodeValue(dv)
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(value))
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cursorValue{Type: "i", Value: strconv.FormatInt(rv.Int(), 10)}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return cursorValue{Type: "u", Value: strconv.FormatUint(rv.Uint(), 10)}, nil
+	case reflect.Float32, reflect.Float64:
+		return cursorValue{Type: "f", Value: strconv.FormatFloat(rv.Float(), 'g', -1, 64)}, nil
+	case reflect.String:
+		return cursorValue{Type: "s", Value: rv.String()}, nil
+	case reflect.Bool:
+		return cursorValue{Type: "b", Value: strconv.FormatBool(rv.Bool())}, nil
+	}
+
+	return cursorValue{}, fmt.Errorf("unsupported type %T", value)
+}
+
+func (v cursorValue) decode() (interface{}, error) {
+	var (
+		value interface{}
+		err   error
+	)
+	switch v.Type {
+	case "t":
+		value, err = time.Parse(time.RFC3339Nano, v.Value)
+	case "i":
+		value, err = strconv.ParseInt(v.Value, 10, 64)
+	case "u":
+		value, err = strconv.ParseUint(v.Value, 10, 64)
+	case "f":
+		value, err = strconv.ParseFloat(v.Value, 64)
+	case "s":
+		value = v.Value
+	case "b":
+		value, err = strconv.ParseBool(v.Value)
+	default:
+		err = fmt.Errorf("unknown type '%s'", v.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrInvalidCursor, err)
+	}
+	return value, nil
+}
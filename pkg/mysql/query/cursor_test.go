@@ -0,0 +1,99 @@
+package query
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type cursorTestUser struct {
+	ID        uint64 `gorm:"column:id;primary_key"`
+	Name      string `gorm:"column:name"`
+	Age       int    `gorm:"column:age"`
+	CreatedAt time.Time
+	DeletedAt *time.Time
+}
+
+func TestGetCursorSort(t *testing.T) {
+	assert.Equal(t, "id DESC", getCursorSort(""))
+	assert.Equal(t, "id ASC", getCursorSort("id"))
+	assert.Equal(t, "age DESC, id DESC", getCursorSort("-age"))
+	assert.Equal(t, "age ASC, name DESC, id ASC", getCursorSort("age, -name"))
+	assert.Equal(t, "name ASC, id DESC", getCursorSort("name,-id"))
+
+	// the id is not appended to the sort of the offset pagination
+	assert.Equal(t, "age DESC", getSort("-age"))
+	p := &Params{Page: 1, Size: 10, Sort: "-age"}
+	order, _, _ := p.ConvertToPage()
+	assert.Equal(t, "age DESC", order)
+	order, limit, offset := p.ConvertToCursorPage()
+	assert.Equal(t, "age DESC, id DESC", order)
+	assert.Equal(t, 10, limit)
+	assert.Equal(t, 10, offset)
+}
+
+func TestParams_ConvertToCursor(t *testing.T) {
+	SetCursorSecret("123456")
+
+	p := &Params{Size: 10, Sort: "-age,name"}
+	queryStr, args, err := p.ConvertToCursor()
+	assert.NoError(t, err)
+	assert.Empty(t, queryStr)
+	assert.Nil(t, args)
+
+	user := &cursorTestUser{ID: 1<<63 + 1, Name: "foo", Age: 20}
+	p.Cursor, err = p.NextCursor(user)
+	assert.NoError(t, err)
+	queryStr, args, err = p.ConvertToCursor()
+	assert.NoError(t, err)
+	assert.Equal(t, "((age < ?) OR (age = ? AND name > ?) OR (age = ? AND name = ? AND id < ?))", queryStr)
+	assert.Equal(t, []interface{}{int64(20), int64(20), "foo", int64(20), "foo", uint64(1<<63 + 1)}, args)
+
+	// time
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 6, time.Local)
+	p = &Params{Sort: "-created_at"}
+	p.Cursor, err = p.NextCursor(&cursorTestUser{ID: 2, CreatedAt: createdAt})
+	assert.NoError(t, err)
+	_, args, err = p.ConvertToCursor()
+	assert.NoError(t, err)
+	assert.True(t, createdAt.Equal(args[0].(time.Time)))
+
+	// the sort is changed
+	cursor := p.Cursor
+	p.Sort = "created_at"
+	_, _, err = p.ConvertToCursor()
+	assert.True(t, errors.Is(err, ErrInvalidCursor))
+
+	// the cursor is tampered
+	p.Sort = "-created_at"
+	p.Cursor = "x" + cursor
+	_, _, err = p.ConvertToCursor()
+	assert.True(t, errors.Is(err, ErrInvalidCursor))
+	p.Cursor = "foobar"
+	_, _, err = p.ConvertToCursor()
+	assert.True(t, errors.Is(err, ErrInvalidCursor))
+
+	// signed by another secret
+	SetCursorSecret("abcdef")
+	p.Cursor = cursor
+	_, _, err = p.ConvertToCursor()
+	assert.True(t, errors.Is(err, ErrInvalidCursor))
+}
+
+func TestParams_NextCursor(t *testing.T) {
+	p := &Params{Sort: "-deleted_at"}
+	_, err := p.NextCursor(&cursorTestUser{ID: 1})
+	assert.Error(t, err)
+
+	p.Sort = "not_found"
+	_, err = p.NextCursor(&cursorTestUser{ID: 1})
+	assert.Error(t, err)
+
+	p.Sort = "name;drop table user"
+	_, err = p.NextCursor(&cursorTestUser{ID: 1})
+	assert.Error(t, err)
+	_, _, err = (&Params{Sort: p.Sort, Cursor: "foo.bar"}).ConvertToCursor()
+	assert.Error(t, err)
+}
@@ -84,12 +84,18 @@ func getSort(columnNames string) string {
 	names := strings.Split(columnNames, ",")
 	strs := make([]string, 0, len(names))
 	for _, name := range names {
+		if name == "" {
+			continue
+		}
 		if name[0] == '-' && len(name) > 1 {
 			strs = append(strs, name[1:]+" DESC")
 		} else {
 			strs = append(strs, name+" ASC")
 		}
 	}
+	if len(strs) == 0 {
+		return "id DESC"
+	}
 
 	return strings.Join(strs, ", ")
 }
@@ -2,6 +2,8 @@ package query
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"strings"
 )
 
@@ -18,8 +20,22 @@ const (
 	Lt = "lt"
 	// Lte less than or equal
 	Lte = "lte"
-	// Like like
+	// Like like, the value is matched anywhere, e.g. %value%
 	Like = "like"
+	// Prefix like, the value is matched at the beginning, e.g. value%
+	Prefix = "prefix"
+	// Suffix like, the value is matched at the end, e.g. %value
+	Suffix = "suffix"
+	// In in, the value is an array or a string separated by commas
+	In = "in"
+	// NotIn not in, the value is an array or a string separated by commas
+	NotIn = "notin"
+	// Between between, the value is an array of two elements or a string separated by comma, e.g. 10,20
+	Between = "between"
+	// IsNull is null, the value is ignored
+	IsNull = "isnull"
+	// NotNull is not null, the value is ignored
+	NotNull = "notnull"
 
 	// AND logic and
 	AND string = "and"
@@ -36,6 +52,14 @@ var expMap = map[string]string{
 	Lte:  " <= ",
 	Like: " LIKE ",
 
+	Prefix:  " LIKE ",
+	Suffix:  " LIKE ",
+	In:      " IN ",
+	NotIn:   " NOT IN ",
+	Between: " BETWEEN ",
+	IsNull:  " IS NULL",
+	NotNull: " IS NOT NULL",
+
 	"=":  " = ",
 	"!=": " <> ",
 	">":  " > ",
@@ -44,6 +68,9 @@ var expMap = map[string]string{
 	"<=": " <= ",
 }
 
+// the column name with json path, e.g. profile->$.city, profile->>$.tags[0], the path can be quoted with single quotes
+var jsonPathRegexp = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)(->>?)'?(\$(?:\.[A-Za-z_][A-Za-z0-9_]*|\[[0-9]+\])*)'?$`)
+
 var logicMap = map[string]string{
 	AND: " AND ",
 	OR:  " OR ",
@@ -59,42 +86,90 @@ type Params struct {
 	Page int    `form:"page" binding:"gte=0" json:"page"`
 	Size int    `form:"size" binding:"gt=0" json:"size"`
 	Sort string `form:"sort" binding:"" json:"sort,omitempty"`
+	// the cursor of the next page returned by the previous query, if not empty, the records after the cursor
+	// are queried by keyset pagination and the page is ignored, the sort must be the same as the previous query.
+	Cursor string `form:"cursor" binding:"" json:"cursor,omitempty"`
 
 	Columns []Column `json:"columns,omitempty"` // not required
 }
 
-// Column search information
+// Column search information, if Columns is not empty, it is a group of conditions in parentheses,
+// and Name, Exp and Value are ignored.
 type Column struct {
-	Name  string      `json:"name"`  // column name
-	Exp   string      `json:"exp"`   // expressions, which default to = when the value is null, have =, ! =, >, >=, <, <=, like
+	Name  string      `json:"name"`  // column name, the json path of the column is supported, e.g. profile->$.city
+	Exp   string      `json:"exp"`   // expressions, which default to = when the value is null, have =, ! =, >, >=, <, <=, like, prefix, suffix, in, notin, between, isnull, notnull
 	Value interface{} `json:"value"` // column value
-	Logic string      `json:"logic"` // logical type, defaults to and when the value is null, with &(and), ||(or)
+	Logic string      `json:"logic"` // logical type connecting with the next column, defaults to and when the value is null, with &(and), ||(or)
+
+	Columns []Column `json:"columns,omitempty"` // nested conditions in parentheses
 }
 
 func (c *Column) checkValid() error {
 	if c.Name == "" {
 		return fmt.Errorf("field 'name' cannot be empty")
 	}
-	if c.Value == nil {
+	exp := strings.ToLower(c.Exp)
+	if c.Value == nil && exp != IsNull && exp != NotNull {
 		return fmt.Errorf("field 'value' cannot be nil")
 	}
 	return nil
 }
 
+// converting the json path of the name to the sql expression of mysql, e.g. profile->$.city --> profile->'$.city',
+// the operator ->> gets the unquoted value.
+func (c *Column) convertName() error {
+	if !strings.Contains(c.Name, "->") {
+		return nil
+	}
+	matches := jsonPathRegexp.FindStringSubmatch(c.Name)
+	if len(matches) != 4 {
+		return fmt.Errorf("invalid json path '%s'", c.Name)
+	}
+	c.Name = matches[1] + matches[2] + "'" + matches[3] + "'"
+	return nil
+}
+
 // converting ExpType to sql expressions and LogicType to sql using characters
 func (c *Column) convert() error {
+	if err := c.convertName(); err != nil {
+		return err
+	}
+
 	if c.Exp == "" {
 		c.Exp = Eq
 	}
-	if v, ok := expMap[strings.ToLower(c.Exp)]; ok {
-		c.Exp = v
-		if c.Exp == " LIKE " {
-			c.Value = fmt.Sprintf("%%%v%%", c.Value)
-		}
-	} else {
+	exp := strings.ToLower(c.Exp)
+	v, ok := expMap[exp]
+	if !ok {
 		return fmt.Errorf("unknown c expression type '%s'", c.Exp)
 	}
+	c.Exp = v
 
+	switch exp {
+	case Like:
+		c.Value = fmt.Sprintf("%%%v%%", c.Value)
+	case Prefix:
+		c.Value = fmt.Sprintf("%v%%", c.Value)
+	case Suffix:
+		c.Value = fmt.Sprintf("%%%v", c.Value)
+	case In, NotIn:
+		values := toValues(c.Value)
+		if len(values) == 0 {
+			return fmt.Errorf("the value of column '%s' cannot be empty with expression '%s'", c.Name, exp)
+		}
+		c.Value = values
+	case Between:
+		values := toValues(c.Value)
+		if len(values) != 2 {
+			return fmt.Errorf("the value of column '%s' must be two elements with expression '%s'", c.Name, exp)
+		}
+		c.Value = values
+	}
+
+	return c.convertLogic()
+}
+
+func (c *Column) convertLogic() error {
 	if c.Logic == "" {
 		c.Logic = AND
 	}
@@ -103,10 +178,47 @@ func (c *Column) convert() error {
 	} else {
 		return fmt.Errorf("unknown logic type '%s'", c.Logic)
 	}
-
 	return nil
 }
 
+// get the sql condition and arguments of the converted column
+func (c *Column) condition() (string, []interface{}) {
+	switch c.Exp {
+	case expMap[IsNull], expMap[NotNull]:
+		return c.Name + c.Exp, nil
+	case expMap[In], expMap[NotIn]:
+		return c.Name + c.Exp + "(?)", []interface{}{c.Value}
+	case expMap[Between]:
+		return c.Name + c.Exp + "? AND ?", c.Value.([]interface{})
+	}
+	return c.Name + c.Exp + "?", []interface{}{c.Value}
+}
+
+// convert the value to a slice, the string is separated by commas
+func toValues(value interface{}) []interface{} {
+	if str, ok := value.(string); ok {
+		if strings.TrimSpace(str) == "" {
+			return nil
+		}
+		strs := strings.Split(str, ",")
+		values := make([]interface{}, 0, len(strs))
+		for _, s := range strs {
+			values = append(values, strings.TrimSpace(s))
+		}
+		return values
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []interface{}{value}
+	}
+	values := make([]interface{}, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		values = append(values, rv.Index(i).Interface())
+	}
+	return values
+}
+
 // ConvertToPage converted to conform to gorm rules based on the page size sort parameter
 func (p *Params) ConvertToPage() (order string, limit int, offset int) {
 	page := NewPage(p.Page, p.Size, p.Sort)
@@ -116,38 +228,64 @@ func (p *Params) ConvertToPage() (order string, limit int, offset int) {
 	return
 }
 
-// ConvertToGormConditions conversion to gorm-compliant parameters based on the Columns parameter
-// ignore the logical type of the last column, whether it is a one-column or multi-column query
+// ConvertToGormConditions conversion to gorm-compliant parameters based on the Columns parameter,
+// the logic of a column connects it with the next column, the columns with nested Columns are grouped in parentheses.
 func (p *Params) ConvertToGormConditions() (string, []interface{}, error) {
+	if len(p.Columns) == 0 {
+		return "", nil, nil
+	}
+	return convertColumns(p.Columns)
+}
+
+// convert the columns of the same group to conditions
+func convertColumns(columns []Column) (string, []interface{}, error) {
 	str := ""
 	args := []interface{}{}
-	l := len(p.Columns)
+	l := len(columns)
 	if l == 0 {
-		return "", nil, nil
+		return "", nil, fmt.Errorf("field 'columns' of the group cannot be empty")
+	}
+	if err := checkLastLogic(columns); err != nil {
+		return "", nil, err
 	}
 
 	isUseIN := true
 	if l == 1 {
 		isUseIN = false
 	}
-	field := p.Columns[0].Name
-
-	for i, column := range p.Columns {
-		if err := column.checkValid(); err != nil {
-			return "", nil, err
-		}
+	field := columns[0].Name
 
-		err := column.convert()
-		if err != nil {
-			return "", nil, err
+	for i, column := range columns {
+		var (
+			condition string
+			values    []interface{}
+		)
+		if len(column.Columns) > 0 {
+			groupStr, groupArgs, err := convertColumns(column.Columns)
+			if err != nil {
+				return "", nil, err
+			}
+			if err = column.convertLogic(); err != nil {
+				return "", nil, err
+			}
+			condition, values = "("+groupStr+")", groupArgs
+			isUseIN = false
+		} else {
+			if err := column.checkValid(); err != nil {
+				return "", nil, err
+			}
+			if err := column.convert(); err != nil {
+				return "", nil, err
+			}
+			condition, values = column.condition()
 		}
 
-		if i == l-1 { // ignore the logical type of the last column
-			str += column.Name + column.Exp + "?"
+		if i == l-1 { // the last column has no next column to connect
+			str += condition
 		} else {
-			str += column.Name + column.Exp + "?" + column.Logic
+			str += condition + column.Logic
 		}
-		args = append(args, column.Value)
+		args = append(args, values...)
 
 		if isUseIN {
 			if field != column.Name {
@@ -168,6 +306,28 @@ func (p *Params) ConvertToGormConditions() (string, []interface{}, error) {
 	return str, args, nil
 }
 
+// the logic of the last column has no next column to connect, it is only allowed to be empty
+// or the same as the previous column, otherwise the logic is ambiguous.
+func checkLastLogic(columns []Column) error {
+	l := len(columns)
+	last := columns[l-1]
+	if l == 1 || last.Logic == "" {
+		return nil
+	}
+	lastLogic, ok := logicMap[strings.ToLower(last.Logic)]
+	if !ok {
+		return fmt.Errorf("unknown logic type '%s'", last.Logic)
+	}
+	prevLogic := logicMap[AND]
+	if prev := columns[l-2].Logic; prev != "" {
+		prevLogic = logicMap[strings.ToLower(prev)]
+	}
+	if lastLogic != prevLogic {
+		return fmt.Errorf("the logic '%s' of the last column '%s' has no next column to connect", last.Logic, last.Name)
+	}
+	return nil
+}
+
 func getExpsAndLogics(keyLen int, paramSrc string) ([]string, []string) { //nolint
 	exps, logics := []string{}, []string{}
 	param := strings.Replace(paramSrc, " ", "", -1)
@@ -359,6 +359,182 @@ func TestParams_ConvertToGormConditions(t *testing.T) {
 			wantErr: false,
 		},
 
+		// ------------------------------ more expressions ----------------------------------
+		{
+			name: "in and not in",
+			args: args{
+				columns: []Column{
+					{
+						Name:  "id",
+						Exp:   In,
+						Value: []interface{}{1, 2, 3},
+					},
+					{
+						Name:  "name",
+						Exp:   NotIn,
+						Value: "LiSi, ZhangSan",
+					},
+				},
+			},
+			want:    "id IN (?) AND name NOT IN (?)",
+			want1:   []interface{}{[]interface{}{1, 2, 3}, []interface{}{"LiSi", "ZhangSan"}},
+			wantErr: false,
+		},
+		{
+			name: "between",
+			args: args{
+				columns: []Column{
+					{
+						Name:  "age",
+						Exp:   Between,
+						Value: "10,20",
+					},
+				},
+			},
+			want:    "age BETWEEN ? AND ?",
+			want1:   []interface{}{"10", "20"},
+			wantErr: false,
+		},
+		{
+			name: "null and like",
+			args: args{
+				columns: []Column{
+					{
+						Name: "deleted_at",
+						Exp:  IsNull,
+					},
+					{
+						Name: "email",
+						Exp:  NotNull,
+					},
+					{
+						Name:  "name",
+						Exp:   Prefix,
+						Value: "Li",
+					},
+					{
+						Name:  "email",
+						Exp:   Suffix,
+						Value: "@bar.com",
+					},
+				},
+			},
+			want:    "deleted_at IS NULL AND email IS NOT NULL AND name LIKE ? AND email LIKE ?",
+			want1:   []interface{}{"Li%", "%@bar.com"},
+			wantErr: false,
+		},
+		{
+			name: "nested groups",
+			args: args{
+				columns: []Column{
+					{
+						Name:  "gender",
+						Value: "male",
+					},
+					{
+						Columns: []Column{
+							{
+								Name:  "age",
+								Exp:   Lt,
+								Value: 20,
+								Logic: OR,
+							},
+							{
+								Columns: []Column{
+									{
+										Name:  "age",
+										Exp:   Gt,
+										Value: 60,
+									},
+									{
+										Name: "email",
+										Exp:  NotNull,
+									},
+								},
+							},
+						},
+						Logic: OR,
+					},
+					{
+						Name:  "name",
+						Value: "LiSi",
+					},
+				},
+			},
+			want:    "gender = ? AND (age < ? OR (age > ? AND email IS NOT NULL)) OR name = ?",
+			want1:   []interface{}{"male", 20, 60, "LiSi"},
+			wantErr: false,
+		},
+		{
+			name: "same logic of the last column",
+			args: args{
+				columns: []Column{
+					{
+						Name:  "name",
+						Value: "LiSi",
+						Logic: OR,
+					},
+					{
+						Name:  "age",
+						Value: 20,
+						Logic: "||",
+					},
+				},
+			},
+			want:    "name = ? OR age = ?",
+			want1:   []interface{}{"LiSi", 20},
+			wantErr: false,
+		},
+		{
+			name: "logic of the last column err",
+			args: args{
+				columns: []Column{
+					{
+						Name:  "name",
+						Value: "LiSi",
+					},
+					{
+						Name:  "age",
+						Value: 20,
+						Logic: OR,
+					},
+				},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "between value err",
+			args: args{
+				columns: []Column{
+					{
+						Name:  "age",
+						Exp:   Between,
+						Value: []int{10},
+					},
+				},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+		{
+			name: "in value err",
+			args: args{
+				columns: []Column{
+					{
+						Name:  "id",
+						Exp:   In,
+						Value: "",
+					},
+				},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
+
 		// ---------------------------- error ----------------------------------------------
 		{
 			name: "exp type err",
@@ -390,6 +566,57 @@ func TestParams_ConvertToGormConditions(t *testing.T) {
 			want1:   nil,
 			wantErr: true,
 		},
+
+		// --------------------------- json path query ------------------------------
+		{
+			name: "json path eq",
+			args: args{
+				columns: []Column{
+					{
+						Name:  "profile->$.city",
+						Exp:   Eq,
+						Value: "Shenzhen",
+					},
+				},
+			},
+			want:    "profile->'$.city' = ?",
+			want1:   []interface{}{"Shenzhen"},
+			wantErr: false,
+		},
+		{
+			name: "json path unquote like",
+			args: args{
+				columns: []Column{
+					{
+						Name:  "profile->>'$.tags[0]'",
+						Exp:   Like,
+						Value: "go",
+					},
+					{
+						Name:  "age",
+						Exp:   Gt,
+						Value: 20,
+					},
+				},
+			},
+			want:    "profile->>'$.tags[0]' LIKE ? AND age > ?",
+			want1:   []interface{}{"%go%", 20},
+			wantErr: false,
+		},
+		{
+			name: "json path error",
+			args: args{
+				columns: []Column{
+					{
+						Name:  "profile->$.city' or 1=1 --",
+						Value: "Shenzhen",
+					},
+				},
+			},
+			want:    "",
+			want1:   nil,
+			wantErr: true,
+		},
 		{
 			name: "empty",
 			args: args{
@@ -0,0 +1,180 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync/atomic"
+
+	"gorm.io/gorm"
+)
+
+// the policies of selecting a replica
+const (
+	// ReplicaPolicyRandom select a replica randomly
+	ReplicaPolicyRandom = "random"
+	// ReplicaPolicyRoundRobin select the replicas in turn
+	ReplicaPolicyRoundRobin = "roundRobin"
+	// ReplicaPolicyLeastConn select the replica with the least connections in use
+	ReplicaPolicyLeastConn = "leastConn"
+)
+
+const replicaPluginName = "sponge:replicas"
+
+type primaryKey struct{}
+
+// WithPrimary force the queries with the returned ctx to read from the primary, e.g. read the record just written,
+// the queries in transaction always read from the primary.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+func isForcePrimary(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	v, _ := ctx.Value(primaryKey{}).(bool)
+	return v
+}
+
+// the plugin of gorm that sends the reads to the replicas, and the writes and transactions to the primary
+type replicaPlugin struct {
+	primary  gorm.ConnPool
+	replicas []*sql.DB
+	policy   string
+	next     uint64
+}
+
+func newReplicaPlugin(replicas []*sql.DB, policy string) (*replicaPlugin, error) {
+	switch policy {
+	case "":
+		policy = ReplicaPolicyRandom
+	case ReplicaPolicyRandom, ReplicaPolicyRoundRobin, ReplicaPolicyLeastConn:
+	default:
+		return nil, fmt.Errorf("unknown replica policy '%s'", policy)
+	}
+	return &replicaPlugin{replicas: replicas, policy: policy}, nil
+}
+
+// Name of plugin
+func (p *replicaPlugin) Name() string {
+	return replicaPluginName
+}
+
+// Initialize register the callbacks to switch the connection pool
+func (p *replicaPlugin) Initialize(db *gorm.DB) error {
+	p.primary = db.ConnPool
+
+	callbacks := []error{
+		db.Callback().Query().Before("gorm:query").Register(replicaPluginName+":query", p.switchReplica),
+		db.Callback().Row().Before("gorm:row").Register(replicaPluginName+":row", p.switchReplica),
+		db.Callback().Create().Before("*").Register(replicaPluginName+":create", p.switchPrimary),
+		db.Callback().Update().Before("*").Register(replicaPluginName+":update", p.switchPrimary),
+		db.Callback().Delete().Before("*").Register(replicaPluginName+":delete", p.switchPrimary),
+		db.Callback().Raw().Before("gorm:raw").Register(replicaPluginName+":raw", p.switchPrimary),
+	}
+	for _, err := range callbacks {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *replicaPlugin) switchReplica(db *gorm.DB) {
+	if db.Error != nil || !p.isReadFromReplica(db) {
+		p.switchPrimary(db)
+		return
+	}
+	db.Statement.ConnPool = p.choose()
+}
+
+// the statement may be reused after a query, e.g. db.Where(...).Count(&total) and then db.Updates(...)
+func (p *replicaPlugin) switchPrimary(db *gorm.DB) {
+	if p.isReplica(db.Statement.ConnPool) {
+		db.Statement.ConnPool = p.primary
+	}
+}
+
+func (p *replicaPlugin) isReadFromReplica(db *gorm.DB) bool {
+	// in transaction, the connection pool is *sql.Tx
+	if _, ok := db.Statement.ConnPool.(gorm.TxCommitter); ok {
+		return false
+	}
+	if isForcePrimary(db.Statement.Context) {
+		return false
+	}
+	// select ... for update
+	if _, ok := db.Statement.Clauses["FOR"]; ok {
+		return false
+	}
+	// the sql of db.Raw is built before the callbacks
+	if db.Statement.SQL.Len() > 0 {
+		rawSQL := strings.ToLower(strings.TrimSpace(db.Statement.SQL.String()))
+		return strings.HasPrefix(rawSQL, "select") && !strings.Contains(rawSQL, " for update") &&
+			!strings.Contains(rawSQL, " lock in share mode") && !strings.Contains(rawSQL, " for share")
+	}
+	return true
+}
+
+func (p *replicaPlugin) isReplica(connPool gorm.ConnPool) bool {
+	for _, replica := range p.replicas {
+		if connPool == replica {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *replicaPlugin) choose() *sql.DB {
+	if len(p.replicas) == 1 {
+		return p.replicas[0]
+	}
+
+	switch p.policy {
+	case ReplicaPolicyRoundRobin:
+		n := atomic.AddUint64(&p.next, 1)
+		return p.replicas[(n-1)%uint64(len(p.replicas))]
+	case ReplicaPolicyLeastConn:
+		replica, inUse := p.replicas[0], p.replicas[0].Stats().InUse
+		for _, r := range p.replicas[1:] {
+			if n := r.Stats().InUse; n < inUse {
+				replica, inUse = r, n
+			}
+		}
+		return replica
+	}
+	return p.replicas[rand.Intn(len(p.replicas))] //nolint
+}
+
+func (p *replicaPlugin) close() error {
+	var errs []string
+	for _, replica := range p.replicas {
+		if err := replica.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("close replicas error: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// Close close the connections of the primary and replicas
+func Close(db *gorm.DB) error {
+	var err error
+	if plugin, ok := db.Config.Plugins[replicaPluginName].(*replicaPlugin); ok {
+		err = plugin.close()
+	}
+
+	sqlDB, e := db.DB()
+	if e != nil {
+		return e
+	}
+	if e = sqlDB.Close(); e != nil {
+		return e
+	}
+	return err
+}
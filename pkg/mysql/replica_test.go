@@ -0,0 +1,122 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/zhufuyi/sponge/pkg/gotest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/clause"
+)
+
+func newReplicaDao(t *testing.T, policy string, size int) (*gotest.Dao, []sqlmock.Sqlmock) {
+	d := newUserExampleDao()
+
+	var (
+		replicas []*sql.DB
+		mocks    []sqlmock.Sqlmock
+	)
+	for i := 0; i < size; i++ {
+		sqlDB, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		replicas = append(replicas, sqlDB)
+		mocks = append(mocks, mock)
+	}
+
+	plugin, err := newReplicaPlugin(replicas, policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.DB.Use(plugin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return d, mocks
+}
+
+func TestReplicaPlugin(t *testing.T) {
+	d, mocks := newReplicaDao(t, ReplicaPolicyRandom, 1)
+	defer d.Close()
+	replica := mocks[0]
+	testData := d.TestData.(*userExample)
+
+	// read from replica
+	replica.ExpectQuery("SELECT .*").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	err := d.DB.WithContext(d.Ctx).Where("id = ?", 1).First(&userExample{}).Error
+	assert.NoError(t, err)
+	replica.ExpectQuery("SELECT .*").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	var count int64
+	err = d.DB.Raw("SELECT count(*) FROM user_example").Scan(&count).Error
+	assert.NoError(t, err)
+
+	// write to primary, the statement used by a query is switched back to the primary
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .*").WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectCommit()
+	replica.ExpectQuery("SELECT .*").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	db := d.DB.Model(testData).Where("id = ?", testData.ID)
+	err = db.Count(&count).Error
+	assert.NoError(t, err)
+	err = db.Update("age", 21).Error
+	assert.NoError(t, err)
+
+	// force read from primary
+	d.SQLMock.ExpectQuery("SELECT .*").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	err = d.DB.WithContext(WithPrimary(d.Ctx)).Where("id = ?", 1).First(&userExample{}).Error
+	assert.NoError(t, err)
+
+	// locking read from primary
+	d.SQLMock.ExpectQuery("SELECT .* FOR UPDATE").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	err = d.DB.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", 1).First(&userExample{}).Error
+	assert.NoError(t, err)
+
+	// read from primary in transaction
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectQuery("SELECT .*").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	d.SQLMock.ExpectCommit()
+	err = WithTx(d.Ctx, d.DB, func(ctx context.Context) error {
+		return GetDB(ctx, d.DB).Where("id = ?", 1).First(&userExample{}).Error
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+	assert.NoError(t, replica.ExpectationsWereMet())
+}
+
+func TestReplicaPlugin_choose(t *testing.T) {
+	d, mocks := newReplicaDao(t, ReplicaPolicyRoundRobin, 3)
+	defer d.Close()
+	for i := 0; i < 6; i++ {
+		mocks[i%3].ExpectQuery("SELECT .*").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	}
+	for i := 0; i < 6; i++ {
+		err := d.DB.Where("id = ?", 1).First(&userExample{}).Error
+		assert.NoError(t, err)
+	}
+	for _, mock := range mocks {
+		assert.NoError(t, mock.ExpectationsWereMet())
+	}
+
+	for _, policy := range []string{ReplicaPolicyRandom, ReplicaPolicyLeastConn} {
+		d, mocks := newReplicaDao(t, policy, 2)
+		plugin := d.DB.Config.Plugins[replicaPluginName].(*replicaPlugin)
+		assert.True(t, plugin.isReplica(plugin.choose()))
+
+		// close the primary and replicas
+		d.SQLMock.ExpectClose()
+		for _, mock := range mocks {
+			mock.ExpectClose()
+		}
+		assert.NoError(t, Close(d.DB))
+		assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+	}
+
+	_, err := newReplicaPlugin(nil, "unknown")
+	assert.Error(t, err)
+}
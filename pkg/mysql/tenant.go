@@ -0,0 +1,123 @@
+package mysql
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/zhufuyi/sponge/pkg/tenant"
+
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// the column of tenant id, the tables that have this column are isolated by tenant
+const columnTenantID = "tenant_id"
+
+var tenantSchemas sync.Map
+
+// TenantScope the scope of gorm that isolates the data of tenants, usage: db.Scopes(mysql.TenantScope),
+// if the table has the tenant_id column, the condition of tenant_id is added to the query, update and delete,
+// and the tenant_id of the created or updated record is set to the tenant id in the context of statement.
+// ErrMissingTenant is returned if there is no tenant id in the context, unless the context is set by tenant.WithBypass.
+// the unique keys of the tenant table should include tenant_id, because upsert is not limited by the condition,
+// Upsert returns an error if the conflict columns or any unique key of the table do not include tenant_id.
+func TenantScope(db *gorm.DB) *gorm.DB {
+	stmt := db.Statement
+	if tenant.IsBypass(stmt.Context) {
+		return db
+	}
+
+	model := stmt.Model
+	if model == nil {
+		model = stmt.Dest
+	}
+	if model == nil {
+		return db
+	}
+	if err := stmt.Parse(model); err != nil {
+		return db // the error is reported when executing
+	}
+	field := stmt.Schema.LookUpField(columnTenantID)
+	if field == nil {
+		return db
+	}
+
+	tenantID := tenant.FromContext(stmt.Context)
+	if tenantID == "" {
+		_ = db.AddError(tenant.ErrMissingTenant)
+		return db
+	}
+	if err := setTenantID(stmt, field, tenantID); err != nil {
+		_ = db.AddError(err)
+		return db
+	}
+
+	return db.Where(clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}, Value: tenantID})
+}
+
+// set the tenant id of the values to be written, the value of tenant id can not be changed by the caller
+func setTenantID(stmt *gorm.Statement, field *schema.Field, tenantID string) error {
+	switch dest := stmt.Dest.(type) {
+	case map[string]interface{}:
+		dest[field.DBName] = tenantID
+		return nil
+	case []map[string]interface{}:
+		for _, m := range dest {
+			m[field.DBName] = tenantID
+		}
+		return nil
+	}
+
+	rv := reflect.ValueOf(stmt.Dest)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Struct:
+		if rv.Type() == stmt.Schema.ModelType && rv.CanAddr() {
+			return field.Set(stmt.Context, rv, tenantID)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			elem := reflect.Indirect(rv.Index(i))
+			if elem.Kind() != reflect.Struct || elem.Type() != stmt.Schema.ModelType {
+				return nil
+			}
+			if err := field.Set(stmt.Context, elem, tenantID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// IsTenantTable determine if the table has the tenant_id column, the data of the table is isolated by tenant
+// the param of 'table' must be pointer, eg: &StructName
+func IsTenantTable(table interface{}) bool {
+	sch, err := schema.Parse(table, &tenantSchemas, schema.NamingStrategy{})
+	if err != nil {
+		return false
+	}
+	return sch.LookUpField(columnTenantID) != nil
+}
+
+// GetTenantID get the value of the tenant_id column of the record, return empty string if the table has no tenant_id column
+// the param of 'table' must be pointer, eg: &StructName
+func GetTenantID(table interface{}) string {
+	sch, err := schema.Parse(table, &tenantSchemas, schema.NamingStrategy{})
+	if err != nil {
+		return ""
+	}
+	field := sch.LookUpField(columnTenantID)
+	if field == nil {
+		return ""
+	}
+	value, _ := field.ValueOf(context.Background(), reflect.ValueOf(table))
+	return cast.ToString(value)
+}
@@ -0,0 +1,162 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zhufuyi/sponge/pkg/mysql/query"
+	"github.com/zhufuyi/sponge/pkg/tenant"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+type tenantExample struct {
+	ID       uint64 `gorm:"column:id;primary_key"`
+	Name     string `gorm:"column:name"`
+	TenantID string `gorm:"column:tenant_id"`
+}
+
+func TestIsTenantTable(t *testing.T) {
+	assert.True(t, IsTenantTable(&tenantExample{}))
+	assert.False(t, IsTenantTable(&userExample{}))
+	assert.False(t, IsTenantTable(nil))
+}
+
+func TestGetTenantID(t *testing.T) {
+	assert.Equal(t, "t1", GetTenantID(&tenantExample{TenantID: "t1"}))
+	assert.Equal(t, "", GetTenantID(&userExample{}))
+	assert.Equal(t, "", GetTenantID(nil))
+}
+
+func TestTenantScope(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	db := d.DB.Scopes(TenantScope)
+	ctx := tenant.NewContext(context.Background(), "t1")
+
+	// create, the tenant id is set
+	record := &tenantExample{Name: "foo", TenantID: "t2"}
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").
+		WithArgs("foo", "t1").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectCommit()
+	err := Create(ctx, db, record)
+	assert.NoError(t, err)
+	assert.Equal(t, "t1", record.TenantID)
+
+	// create in batches
+	records := []*tenantExample{{Name: "foo"}, {Name: "bar"}, {Name: "baz"}}
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").
+		WithArgs("foo", "t1", "bar", "t1").
+		WillReturnResult(sqlmock.NewResult(2, 2))
+	d.SQLMock.ExpectExec("INSERT INTO .*").
+		WithArgs("baz", "t1").
+		WillReturnResult(sqlmock.NewResult(4, 1))
+	d.SQLMock.ExpectCommit()
+	err = CreateBatch(ctx, db, records, 2)
+	assert.NoError(t, err)
+
+	// update, the condition of tenant is added and the tenant id can not be changed
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE `tenant_example` SET `name`=\\?,`tenant_id`=\\? WHERE `tenant_example`.`tenant_id` = \\? AND `id` = \\?").
+		WithArgs("bar", "t1", "t1", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectCommit()
+	err = UpdateByID(ctx, db, &tenantExample{ID: 1}, KV{"name": "bar", "tenant_id": "t2"})
+	assert.NoError(t, err)
+
+	// query
+	d.SQLMock.ExpectQuery("SELECT \\* FROM `tenant_example` WHERE id = \\? AND `tenant_example`.`tenant_id` = \\?").
+		WithArgs(1, "t1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "tenant_id"}).AddRow(1, "foo", "t1"))
+	err = GetByID(ctx, db, &tenantExample{}, 1)
+	assert.NoError(t, err)
+
+	d.SQLMock.ExpectQuery("SELECT \\* FROM `tenant_example` WHERE .*`tenant_example`.`tenant_id` = \\?").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "tenant_id"}).AddRow(1, "foo", "t1"))
+	var list []*tenantExample
+	err = List(ctx, db, &list, query.NewPage(0, 10, "id"), "id > ?", 0)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	// delete
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("DELETE FROM `tenant_example` WHERE id = \\? AND `tenant_example`.`tenant_id` = \\?").
+		WithArgs(1, "t1").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectCommit()
+	err = DeleteByID(ctx, db, &tenantExample{}, 1)
+	assert.NoError(t, err)
+
+	// bypass the tenant scope
+	d.SQLMock.ExpectQuery("SELECT \\* FROM `tenant_example` WHERE id = \\? ORDER BY").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "tenant_id"}).AddRow(1, "foo", "t2"))
+	err = GetByID(tenant.WithBypass(context.Background()), db, &tenantExample{}, 1)
+	assert.NoError(t, err)
+
+	// the table without tenant_id column is not affected
+	d.SQLMock.ExpectQuery("SELECT \\* FROM `user_example` WHERE id = \\? AND `user_example`.`deleted_at` IS NULL ORDER BY").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "foo"))
+	err = GetByID(context.Background(), db, &userExample{}, 1)
+	assert.NoError(t, err)
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+
+	// missing tenant
+	err = GetByID(context.Background(), db, &tenantExample{}, 1)
+	assert.ErrorIs(t, err, tenant.ErrMissingTenant)
+	err = Create(context.Background(), db, &tenantExample{Name: "foo"})
+	assert.ErrorIs(t, err, tenant.ErrMissingTenant)
+}
+
+func TestUpsert_Tenant(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	db := d.DB.Scopes(TenantScope)
+	ctx := tenant.NewContext(context.Background(), "t1")
+
+	// the conflict columns must include tenant_id
+	err := Upsert(ctx, db, &tenantExample{Name: "foo"}, "name")
+	assert.Error(t, err)
+	err = Upsert(ctx, db, &tenantExample{Name: "foo"})
+	assert.Error(t, err)
+
+	// the primary key is ignored, the record of other tenants can't be overwritten by id
+	d.SQLMock.ExpectQuery("SELECT INDEX_NAME FROM information_schema.STATISTICS .*").
+		WithArgs("tenant_example", "tenant_id").
+		WillReturnRows(sqlmock.NewRows([]string{"INDEX_NAME"}))
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO `tenant_example` \\(`name`,`tenant_id`\\) .* ON DUPLICATE KEY UPDATE .*").
+		WithArgs("foo", "t1").
+		WillReturnResult(sqlmock.NewResult(5, 2))
+	d.SQLMock.ExpectCommit()
+	d.SQLMock.ExpectQuery("SELECT `id` FROM `tenant_example` WHERE `name` = \\? AND `tenant_id` = \\?").
+		WithArgs("foo", "t1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	record := &tenantExample{ID: 8, Name: "foo"}
+	err = Upsert(ctx, db, record, "tenant_id", "name")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), record.ID)
+
+	// a global unique key without tenant_id, the record of other tenants would be overwritten
+	d.SQLMock.ExpectQuery("SELECT INDEX_NAME FROM information_schema.STATISTICS .*").
+		WithArgs("tenant_example", "tenant_id").
+		WillReturnRows(sqlmock.NewRows([]string{"INDEX_NAME"}).AddRow("uk_email"))
+	err = Upsert(ctx, db, &tenantExample{Name: "foo"}, "tenant_id", "name")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "uk_email")
+
+	// bypass the tenant scope
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .* ON DUPLICATE KEY UPDATE .*").
+		WithArgs("bar", "t2", 8).
+		WillReturnResult(sqlmock.NewResult(8, 1))
+	d.SQLMock.ExpectCommit()
+	err = Upsert(tenant.WithBypass(context.Background()), db, &tenantExample{ID: 8, Name: "bar", TenantID: "t2"})
+	assert.NoError(t, err)
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+}
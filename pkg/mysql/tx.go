@@ -0,0 +1,97 @@
+package mysql
+
+import (
+	"context"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+type txKey struct{}
+
+// the transaction stored in the context and the functions to be executed after it is committed
+type txContext struct {
+	tx       *gorm.DB
+	connPool gorm.ConnPool // the connection pool of the database that started the transaction
+
+	mu           sync.Mutex
+	afterCommits []func()
+}
+
+func (c *txContext) addAfterCommit(fn func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.afterCommits = append(c.afterCommits, fn)
+}
+
+func (c *txContext) runAfterCommits() {
+	c.mu.Lock()
+	fns := c.afterCommits
+	c.afterCommits = nil
+	c.mu.Unlock()
+
+	for _, fn := range fns {
+		fn()
+	}
+}
+
+func (c *txContext) isSameDB(db *gorm.DB) bool {
+	return db != nil && db.Statement != nil && c.connPool == db.Statement.ConnPool
+}
+
+func getTxContext(ctx context.Context, db *gorm.DB) (*txContext, bool) {
+	c, ok := ctx.Value(txKey{}).(*txContext)
+	if !ok || !c.isSameDB(db) {
+		return nil, false
+	}
+	return c, true
+}
+
+// WithTx execute fn in a transaction, the transaction is stored in the ctx passed to fn, the functions
+// that get the database handle by GetDB(ctx, db) take part in the transaction transparently.
+// the transaction is committed if fn returns nil, otherwise it is rolled back, and it is also rolled back
+// if fn panics. if the ctx is already in a transaction of the same database, fn is executed in the
+// existing transaction, and the outermost WithTx decides whether to commit or roll back.
+func WithTx(ctx context.Context, db *gorm.DB, fn func(ctx context.Context) error) error {
+	if _, ok := getTxContext(ctx, db); ok {
+		return fn(ctx)
+	}
+
+	c := &txContext{connPool: db.Statement.ConnPool}
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		c.tx = tx
+		return fn(context.WithValue(ctx, txKey{}, c))
+	})
+	if err != nil {
+		return err
+	}
+
+	c.runAfterCommits()
+	return nil
+}
+
+// GetDB get the database handle, if the ctx is in a transaction of db started by WithTx, the transaction is returned,
+// otherwise db.WithContext(ctx) is returned.
+func GetDB(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if c, ok := getTxContext(ctx, db); ok {
+		return c.tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// InTx determine if the ctx is in a transaction of db started by WithTx
+func InTx(ctx context.Context, db *gorm.DB) bool {
+	_, ok := getTxContext(ctx, db)
+	return ok
+}
+
+// AfterCommit execute fn after the transaction of db in ctx is committed, e.g. delete the cache of the modified records,
+// fn is discarded if the transaction is rolled back. if the ctx is not in a transaction of db, fn is executed immediately.
+func AfterCommit(ctx context.Context, db *gorm.DB, fn func()) {
+	c, ok := getTxContext(ctx, db)
+	if !ok {
+		fn()
+		return
+	}
+	c.addAfterCommit(fn)
+}
@@ -0,0 +1,109 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTx(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	testData := d.TestData.(*userExample)
+
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").
+		WithArgs(d.GetAnyArgs(testData)...).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectExec("UPDATE .*").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectCommit()
+
+	var calls []string
+	err := WithTx(d.Ctx, d.DB, func(ctx context.Context) error {
+		assert.True(t, InTx(ctx, d.DB))
+		if err := Create(ctx, GetDB(ctx, d.DB), testData); err != nil {
+			return err
+		}
+		AfterCommit(ctx, d.DB, func() { calls = append(calls, "create") })
+
+		// nested transaction uses the existing transaction
+		return WithTx(ctx, d.DB, func(ctx context.Context) error {
+			AfterCommit(ctx, d.DB, func() { calls = append(calls, "update") })
+			assert.Empty(t, calls)
+			return GetDB(ctx, d.DB).Model(testData).Update("age", 21).Error
+		})
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"create", "update"}, calls)
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+
+	// not in transaction
+	assert.False(t, InTx(d.Ctx, d.DB))
+	AfterCommit(d.Ctx, d.DB, func() { calls = append(calls, "no tx") })
+	assert.Equal(t, "no tx", calls[2])
+}
+
+func TestWithTx_Rollback(t *testing.T) {
+	d := newUserExampleDao()
+	defer d.Close()
+	testData := d.TestData.(*userExample)
+
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").
+		WithArgs(d.GetAnyArgs(testData)...).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	d.SQLMock.ExpectRollback()
+
+	called := false
+	mockErr := errors.New("mock error")
+	err := WithTx(d.Ctx, d.DB, func(ctx context.Context) error {
+		if err := GetDB(ctx, d.DB).Create(testData).Error; err != nil {
+			return err
+		}
+		AfterCommit(ctx, d.DB, func() { called = true })
+		return mockErr
+	})
+	assert.ErrorIs(t, err, mockErr)
+	assert.False(t, called)
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+
+	// rollback after panic
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectRollback()
+	assert.Panics(t, func() {
+		_ = WithTx(d.Ctx, d.DB, func(ctx context.Context) error {
+			AfterCommit(ctx, d.DB, func() { called = true })
+			panic("mock panic")
+		})
+	})
+	assert.False(t, called)
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+}
+
+func TestGetDB(t *testing.T) {
+	d1 := newUserExampleDao()
+	defer d1.Close()
+	d2 := newUserExampleDao()
+	defer d2.Close()
+
+	d1.SQLMock.ExpectBegin()
+	d1.SQLMock.ExpectCommit()
+	err := WithTx(d1.Ctx, d1.DB, func(ctx context.Context) error {
+		// the transaction of another database is not used
+		assert.False(t, InTx(ctx, d2.DB))
+		assert.Equal(t, d2.DB.ConnPool, GetDB(ctx, d2.DB).Statement.ConnPool)
+		assert.NotEqual(t, d1.DB.ConnPool, GetDB(ctx, d1.DB).Statement.ConnPool)
+
+		// not in the transaction of another database, it is executed immediately
+		called := false
+		AfterCommit(ctx, d2.DB, func() { called = true })
+		assert.True(t, called)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, d1.SQLMock.ExpectationsWereMet())
+}
@@ -0,0 +1,149 @@
+package replacer
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+)
+
+// nolint
+const (
+	FileStatusNew       = "new"
+	FileStatusModified  = "modified"
+	FileStatusUnchanged = "unchanged"
+)
+
+// the number of unchanged lines around the changes in the unified diff
+const diffContextLines = 3
+
+// FileChange the change of the file to be saved
+type FileChange struct {
+	Path       string // path of the file
+	Status     string // new, modified or unchanged
+	IsConflict bool   // whether the merged file has conflict markers
+	Diff       string // unified diff between the existing file and the file to be saved, empty if not modified
+}
+
+// CompareFile compare the data to be saved with the existing file
+func CompareFile(file string, data []byte) (FileChange, error) {
+	change := FileChange{Path: file, Status: FileStatusNew}
+
+	current, err := os.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return change, nil
+		}
+		return change, err
+	}
+
+	if bytes.Equal(current, data) {
+		change.Status = FileStatusUnchanged
+		return change, nil
+	}
+
+	change.Status = FileStatusModified
+	if isBinary(current) || isBinary(data) {
+		change.Diff = fmt.Sprintf("Binary files %s and %s differ\n", file, file)
+	} else {
+		change.Diff = UnifiedDiff(file, file, current, data)
+	}
+	return change, nil
+}
+
+type diffOp struct {
+	kind byte // ' ' unchanged, '-' deleted, '+' inserted
+	line []byte
+}
+
+// UnifiedDiff get the unified diff of the old and new data line by line, return empty if they are the same
+func UnifiedDiff(oldName string, newName string, oldData []byte, newData []byte) string {
+	a, b := splitLines(oldData), splitLines(newData)
+	matches := diffMatches(a, b)
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && matches[i] < 0:
+			ops = append(ops, diffOp{kind: '-', line: a[i]})
+			i++
+		case i >= len(a) || j < matches[i]:
+			ops = append(ops, diffOp{kind: '+', line: b[j]})
+			j++
+		default:
+			ops = append(ops, diffOp{kind: ' ', line: a[i]})
+			i++
+			j++
+		}
+	}
+
+	out := &bytes.Buffer{}
+	for k := 0; k < len(ops); {
+		if ops[k].kind == ' ' {
+			k++
+			continue
+		}
+
+		// the changes separated by no more than twice the context lines are in the same hunk
+		start, end := k-diffContextLines, k+1
+		if start < 0 {
+			start = 0
+		}
+		for e := k + 1; e < len(ops); e++ {
+			if ops[e].kind != ' ' {
+				end = e + 1
+			} else if e-end >= 2*diffContextLines {
+				break
+			}
+		}
+		stop := end + diffContextLines
+		if stop > len(ops) {
+			stop = len(ops)
+		}
+
+		if out.Len() == 0 {
+			fmt.Fprintf(out, "--- %s\n+++ %s\n", oldName, newName)
+		}
+		writeHunk(out, ops, start, stop)
+		k = stop
+	}
+
+	return out.String()
+}
+
+func writeHunk(out *bytes.Buffer, ops []diffOp, start int, stop int) {
+	oldStart, newStart := 0, 0
+	for _, op := range ops[:start] {
+		if op.kind != '+' {
+			oldStart++
+		}
+		if op.kind != '-' {
+			newStart++
+		}
+	}
+	oldCount, newCount := 0, 0
+	for _, op := range ops[start:stop] {
+		if op.kind != '+' {
+			oldCount++
+		}
+		if op.kind != '-' {
+			newCount++
+		}
+	}
+	// the start line number is the line before the hunk if the hunk is empty
+	if oldCount > 0 {
+		oldStart++
+	}
+	if newCount > 0 {
+		newStart++
+	}
+
+	fmt.Fprintf(out, "@@ -%d,%d +%d,%d @@\n", oldStart, oldCount, newStart, newCount)
+	for _, op := range ops[start:stop] {
+		out.WriteByte(op.kind)
+		out.Write(op.line)
+		if !bytes.HasSuffix(op.line, []byte("\n")) {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package replacer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		oldData string
+		newData string
+		want    string
+	}{
+		{
+			name:    "same",
+			oldData: "a\nb\n",
+			newData: "a\nb\n",
+			want:    "",
+		},
+		{
+			name:    "new file",
+			oldData: "",
+			newData: "a\nb\n",
+			want:    "--- old\n+++ new\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			name:    "modified",
+			oldData: "1\n2\n3\n4\n5\n6\n7\n8\n",
+			newData: "1\n2\n3\n4\nx\n6\n7\n8\n",
+			want:    "--- old\n+++ new\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+x\n 6\n 7\n 8\n",
+		},
+		{
+			name:    "two hunks",
+			oldData: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			newData: "x\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n",
+			want:    "--- old\n+++ new\n@@ -1,4 +1,4 @@\n-1\n+x\n 2\n 3\n 4\n@@ -9,4 +9,3 @@\n 9\n 10\n 11\n-12\n",
+		},
+		{
+			name:    "no newline at end of file",
+			oldData: "a\nb",
+			newData: "a\nc",
+			want:    "--- old\n+++ new\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+c\n\\ No newline at end of file\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := UnifiedDiff("old", "new", []byte(tt.oldData), []byte(tt.newData))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompareFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "foo.txt")
+
+	change, err := CompareFile(file, []byte("a\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, FileStatusNew, change.Status)
+	assert.Empty(t, change.Diff)
+
+	err = os.WriteFile(file, []byte("a\n"), 0666)
+	assert.NoError(t, err)
+	change, err = CompareFile(file, []byte("a\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, FileStatusUnchanged, change.Status)
+
+	change, err = CompareFile(file, []byte("b\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, FileStatusModified, change.Status)
+	assert.Equal(t, "--- "+file+"\n+++ "+file+"\n@@ -1,1 +1,1 @@\n-a\n+b\n", change.Diff)
+
+	change, err = CompareFile(file, []byte("b\x00"))
+	assert.NoError(t, err)
+	assert.Contains(t, change.Diff, "Binary files")
+}
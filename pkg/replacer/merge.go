@@ -0,0 +1,220 @@
+package replacer
+
+import (
+	"bytes"
+)
+
+// the markers of the conflict, the current content of the file is above the separator,
+// the newly generated content is below the separator.
+var (
+	conflictStartMarker = []byte("<<<<<<< current\n")
+	conflictSepMarker   = []byte("=======\n")
+	conflictEndMarker   = []byte(">>>>>>> generated\n")
+)
+
+// the maximum number of edits of the diff, if exceeded, the lines are treated as all changed
+const maxDiffEdits = 2000
+
+// Merge three-way merge the changes from base to current and the changes from base to generated line by line,
+// the overlapping changes are written with conflict markers, return the merged content and the number of conflicts.
+// if base is empty, only the lines that are the same in current and generated are merged.
+func Merge(base []byte, current []byte, generated []byte) ([]byte, int) {
+	baseLines, curLines, genLines := splitLines(base), splitLines(current), splitLines(generated)
+	curMatches := diffMatches(baseLines, curLines)
+	genMatches := diffMatches(baseLines, genLines)
+
+	out := &bytes.Buffer{}
+	conflicts := 0
+	b, c, g := 0, 0, 0
+	for b < len(baseLines) || c < len(curLines) || g < len(genLines) {
+		// the lines that are not changed in both current and generated
+		k := 0
+		for b+k < len(baseLines) && curMatches[b+k] == c+k && genMatches[b+k] == g+k {
+			k++
+		}
+		if k > 0 {
+			writeLines(out, baseLines[b:b+k])
+			b, c, g = b+k, c+k, g+k
+			continue
+		}
+
+		// the changed chunk ends at the next base line that exists in both current and generated
+		nb, nc, ng := len(baseLines), len(curLines), len(genLines)
+		for i := b; i < len(baseLines); i++ {
+			if curMatches[i] >= 0 && genMatches[i] >= 0 {
+				nb, nc, ng = i, curMatches[i], genMatches[i]
+				break
+			}
+		}
+		if mergeChunk(out, baseLines[b:nb], curLines[c:nc], genLines[g:ng]) {
+			conflicts++
+		}
+		b, c, g = nb, nc, ng
+	}
+
+	return out.Bytes(), conflicts
+}
+
+// write the result of the changed chunk, return true if there is a conflict
+func mergeChunk(out *bytes.Buffer, base [][]byte, current [][]byte, generated [][]byte) bool {
+	switch {
+	case equalLines(current, base):
+		writeLines(out, generated)
+		return false
+	case equalLines(generated, base), equalLines(current, generated):
+		writeLines(out, current)
+		return false
+	}
+
+	// only the different lines are in conflict
+	prefix := 0
+	for prefix < len(current) && prefix < len(generated) && bytes.Equal(current[prefix], generated[prefix]) {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(current)-prefix && suffix < len(generated)-prefix &&
+		bytes.Equal(current[len(current)-1-suffix], generated[len(generated)-1-suffix]) {
+		suffix++
+	}
+
+	writeLines(out, current[:prefix])
+	out.Write(conflictStartMarker)
+	writeLines(out, current[prefix:len(current)-suffix])
+	endLine(out)
+	out.Write(conflictSepMarker)
+	writeLines(out, generated[prefix:len(generated)-suffix])
+	endLine(out)
+	out.Write(conflictEndMarker)
+	writeLines(out, current[len(current)-suffix:])
+	return true
+}
+
+func splitLines(data []byte) [][]byte {
+	if len(data) == 0 {
+		return nil
+	}
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	if len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func writeLines(out *bytes.Buffer, lines [][]byte) {
+	for _, line := range lines {
+		out.Write(line)
+	}
+}
+
+// the conflict marker must start on a new line
+func endLine(out *bytes.Buffer) {
+	if data := out.Bytes(); len(data) > 0 && data[len(data)-1] != '\n' {
+		out.WriteByte('\n')
+	}
+}
+
+func equalLines(a [][]byte, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// the index of the matched line in b for each line in a, -1 means the line is deleted
+func diffMatches(a [][]byte, b [][]byte) []int {
+	matches := make([]int, len(a))
+	for i := range matches {
+		matches[i] = -1
+	}
+
+	start := 0
+	for start < len(a) && start < len(b) && bytes.Equal(a[start], b[start]) {
+		matches[start] = start
+		start++
+	}
+	endA, endB := len(a), len(b)
+	for endA > start && endB > start && bytes.Equal(a[endA-1], b[endB-1]) {
+		endA--
+		endB--
+		matches[endA] = endB
+	}
+
+	myersDiff(a[start:endA], b[start:endB], func(i int, j int) {
+		matches[start+i] = start + j
+	})
+	return matches
+}
+
+// the shortest edit script of Myers, the matched lines are reported by the match function
+func myersDiff(a [][]byte, b [][]byte, match func(i int, j int)) {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return
+	}
+
+	maxD := n + m
+	if maxD > maxDiffEdits {
+		maxD = maxDiffEdits
+	}
+	offset := maxD + 1
+	v := make([]int, 2*maxD+3)
+	var trace [][]int // the values of v in the range [-d, d] before each step d
+
+	for d := 0; d <= maxD; d++ {
+		snapshot := make([]int, 2*d+1)
+		copy(snapshot, v[offset-d:offset+d+1])
+		trace = append(trace, snapshot)
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && bytes.Equal(a[x], b[y]) {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				backtrackMyers(trace, n, m, match)
+				return
+			}
+		}
+	}
+}
+
+func backtrackMyers(trace [][]int, n int, m int, match func(i int, j int)) {
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d]
+		get := func(k int) int { return v[k+d] }
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && get(k-1) < get(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := get(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			match(x, y)
+		}
+		x, y = prevX, prevY
+	}
+	for x > 0 && y > 0 {
+		x--
+		y--
+		match(x, y)
+	}
+}
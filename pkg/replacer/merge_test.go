@@ -0,0 +1,101 @@
+package replacer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	base := "package demo\n\nfunc a() {}\n\nfunc b() {}\n\nfunc c() {}\n"
+	tests := []struct {
+		name      string
+		base      string
+		current   string
+		generated string
+		want      string
+		conflicts int
+	}{
+		{
+			name:      "no change",
+			base:      base,
+			current:   base,
+			generated: base,
+			want:      base,
+		},
+		{
+			name:      "only generated changed",
+			base:      base,
+			current:   base,
+			generated: strings.Replace(base, "func b() {}", "func b(x int) {}", 1),
+			want:      strings.Replace(base, "func b() {}", "func b(x int) {}", 1),
+		},
+		{
+			name:      "only current changed",
+			base:      base,
+			current:   strings.Replace(base, "func a() {}", "func a() { println() }", 1),
+			generated: base,
+			want:      strings.Replace(base, "func a() {}", "func a() { println() }", 1),
+		},
+		{
+			name:      "changes not overlapped",
+			base:      base,
+			current:   strings.Replace(base, "func a() {}", "func a() { println() }", 1),
+			generated: strings.Replace(base, "func c() {}", "func c() {}\n\nfunc d() {}", 1),
+			want:      "package demo\n\nfunc a() { println() }\n\nfunc b() {}\n\nfunc c() {}\n\nfunc d() {}\n",
+		},
+		{
+			name:      "same changes",
+			base:      base,
+			current:   strings.Replace(base, "func b() {}", "func b(x int) {}", 1),
+			generated: strings.Replace(base, "func b() {}", "func b(x int) {}", 1),
+			want:      strings.Replace(base, "func b() {}", "func b(x int) {}", 1),
+		},
+		{
+			name:      "changes overlapped",
+			base:      base,
+			current:   strings.Replace(base, "func b() {}", "func b() { println() }", 1),
+			generated: strings.Replace(base, "func b() {}", "func b(x int) {}", 1),
+			want: "package demo\n\nfunc a() {}\n\n" +
+				"<<<<<<< current\nfunc b() { println() }\n=======\nfunc b(x int) {}\n>>>>>>> generated\n" +
+				"\nfunc c() {}\n",
+			conflicts: 1,
+		},
+		{
+			name:      "no base",
+			base:      "",
+			current:   "a\nb\nc\n",
+			generated: "a\nx\nc\n",
+			want:      "a\n<<<<<<< current\nb\n=======\nx\n>>>>>>> generated\nc\n",
+			conflicts: 1,
+		},
+		{
+			name:      "no newline at end of file",
+			base:      "a\nb",
+			current:   "a\nc",
+			generated: "a\nd",
+			want:      "a\n<<<<<<< current\nc\n=======\nd\n>>>>>>> generated\n",
+			conflicts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, conflicts := Merge([]byte(tt.base), []byte(tt.current), []byte(tt.generated))
+			assert.Equal(t, tt.want, string(got))
+			assert.Equal(t, tt.conflicts, conflicts)
+		})
+	}
+}
+
+func Test_myersDiff(t *testing.T) {
+	a := splitLines([]byte("a\nb\nc\na\nb\nb\na\n"))
+	b := splitLines([]byte("c\nb\na\nb\na\nc\n"))
+	count := 0
+	myersDiff(a, b, func(i int, j int) {
+		assert.Equal(t, a[i], b[j])
+		count++
+	})
+	assert.Equal(t, 4, count) // the length of the longest common subsequence
+}
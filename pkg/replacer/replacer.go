@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -23,7 +24,11 @@ type Replacer interface {
 	SetOutputDir(absDir string, name ...string) error
 	GetOutputDir() string
 	GetSourcePath() string
+	SetPristineDir(dir string)
+	SetOverrideDir(dir string)
 	SaveFiles() error
+	PreviewFiles() ([]FileChange, error)
+	GetConflictFiles() []string
 	ReadFile(filename string) ([]byte, error)
 }
 
@@ -37,6 +42,9 @@ type replacerInfo struct {
 	ignoreDirs        []string // ignore processed subdirectories
 	replacementFields []Field  // characters to be replaced when converting from a template file to a new file
 	outPath           string   // the directory where the file is saved after replacement
+	pristineDir       string   // the directory where the pristine files are saved, relative to outPath
+	conflictFiles     []string // the merged files with conflicts
+	overrideDir       string   // the directory of the user-supplied files that override the template files
 }
 
 // New create replacer with local directory
@@ -176,6 +184,42 @@ func (r *replacerInfo) SetOutputDir(absPath string, name ...string) error {
 	return nil
 }
 
+// SetPristineDir specify the directory relative to the output directory to save the pristine files,
+// the existing files are merged with the pristine files and the new files instead of cancelling the save,
+// the dir should start with '.' or '_' if the files are go files, otherwise they are compiled as packages by ./...
+func (r *replacerInfo) SetPristineDir(dir string) {
+	r.pristineDir = dir
+}
+
+// SetOverrideDir specify the directory of the user-supplied files, the file in the directory with the same relative path
+// as the template file is used instead of the template file, the files in the directory are not treated as template files
+func (r *replacerInfo) SetOverrideDir(dir string) {
+	if dir == "" {
+		r.overrideDir = ""
+		return
+	}
+	if abs, err := filepath.Abs(dir); err == nil {
+		dir = abs
+	}
+	r.overrideDir = dir
+
+	if !r.isActual {
+		return
+	}
+	var files []string
+	for _, file := range r.files {
+		if !strings.HasPrefix(file, dir+string(filepath.Separator)) {
+			files = append(files, file)
+		}
+	}
+	r.files = files
+}
+
+// GetConflictFiles get the merged files with conflict markers after saving
+func (r *replacerInfo) GetConflictFiles() []string {
+	return r.conflictFiles
+}
+
 // GetOutputDir get output directory
 func (r *replacerInfo) GetOutputDir() string {
 	return r.outPath
@@ -200,14 +244,97 @@ func (r *replacerInfo) ReadFile(filename string) ([]byte, error) {
 		return nil, fmt.Errorf("total %d file named '%s', files=%+v", len(foundFile), filename, foundFile)
 	}
 
+	return r.readTemplateFile(foundFile[0])
+}
+
+// read the template file, the user-supplied file in the override directory takes precedence
+func (r *replacerInfo) readTemplateFile(file string) ([]byte, error) {
+	if r.overrideDir != "" {
+		overrideFile := filepath.Join(r.overrideDir, filepath.FromSlash(strings.TrimPrefix(file, r.path)))
+		data, err := os.ReadFile(overrideFile)
+		if err == nil {
+			return data, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
 	if r.isActual {
-		return os.ReadFile(foundFile[0])
+		return os.ReadFile(file) // read from local files
 	}
-	return r.fs.ReadFile(foundFile[0])
+	return r.fs.ReadFile(file) // read from local embed.FS
 }
 
 // SaveFiles save file with setting
 func (r *replacerInfo) SaveFiles() error {
+	writeData, err := r.getWriteData()
+	if err != nil {
+		return err
+	}
+
+	r.conflictFiles = nil
+	for file, data := range writeData {
+		content, isConflict, err := r.getSaveContent(file, data)
+		if err != nil {
+			return err
+		}
+		if isConflict {
+			r.conflictFiles = append(r.conflictFiles, file)
+		}
+
+		err = saveToNewFile(file, content)
+		if err != nil {
+			return err
+		}
+		if r.pristineDir != "" {
+			err = saveToNewFile(r.getPristineFilePath(file), data)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	sort.Strings(r.conflictFiles)
+
+	return nil
+}
+
+// PreviewFiles get the changes of the files that SaveFiles would write, no files are written,
+// the pristine files are not included
+func (r *replacerInfo) PreviewFiles() ([]FileChange, error) {
+	writeData, err := r.getWriteData()
+	if err != nil {
+		return nil, err
+	}
+
+	r.conflictFiles = nil
+	var changes []FileChange
+	for file, data := range writeData {
+		content, isConflict, err := r.getSaveContent(file, data)
+		if err != nil {
+			return nil, err
+		}
+		if isConflict {
+			r.conflictFiles = append(r.conflictFiles, file)
+		}
+
+		change, err := CompareFile(file, content)
+		if err != nil {
+			return nil, err
+		}
+		change.IsConflict = isConflict
+		changes = append(changes, change)
+	}
+	sort.Strings(r.conflictFiles)
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes, nil
+}
+
+// get the replaced data of the files to be saved, the key is the new file path
+func (r *replacerInfo) getWriteData() (map[string][]byte, error) {
 	if r.outPath == "" {
 		r.outPath = gofile.GetRunPath() + gofile.GetPathDelimiter() + "generate_" + time.Now().Format("150405")
 	}
@@ -220,16 +347,9 @@ func (r *replacerInfo) SaveFiles() error {
 			continue
 		}
 
-		var data []byte
-		var err error
-
-		if r.isActual {
-			data, err = os.ReadFile(file) // read from local files
-		} else {
-			data, err = r.fs.ReadFile(file) // read from local embed.FS
-		}
+		data, err := r.readTemplateFile(file)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// replace text content
@@ -260,26 +380,57 @@ func (r *replacerInfo) SaveFiles() error {
 		writeData[newFilePath] = data
 	}
 
-	if len(existFiles) > 0 {
+	if len(existFiles) > 0 && r.pristineDir == "" {
 		//nolint
-		return fmt.Errorf("existing files detected\n    %s\nCode generation has been cancelled\n",
+		return nil, fmt.Errorf("existing files detected\n    %s\nCode generation has been cancelled\n",
 			strings.Join(existFiles, "\n    "))
 	}
 
 	for file, data := range writeData {
 		if isForbiddenFile(file, r.path) {
-			return fmt.Errorf("disable writing file(%s) to directory(%s), file size=%d", file, r.path, len(data))
+			return nil, fmt.Errorf("disable writing file(%s) to directory(%s), file size=%d", file, r.path, len(data))
 		}
 	}
 
-	for file, data := range writeData {
-		err := saveToNewFile(file, data)
-		if err != nil {
-			return err
+	return writeData, nil
+}
+
+// get the content to be saved, the existing file is merged with the newly generated data
+func (r *replacerInfo) getSaveContent(file string, data []byte) ([]byte, bool, error) {
+	if !gofile.IsExists(file) {
+		return data, false, nil
+	}
+	return r.mergeFile(file, data)
+}
+
+// merge the changes of the existing file and the newly generated file based on the pristine file
+func (r *replacerInfo) mergeFile(file string, data []byte) ([]byte, bool, error) {
+	current, err := os.ReadFile(file)
+	if err != nil {
+		return nil, false, err
+	}
+	if bytes.Equal(current, data) {
+		return data, false, nil
+	}
+	pristine, err := os.ReadFile(r.getPristineFilePath(file))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, false, err
+	}
+
+	// binary files are not merged, the edited file is kept
+	if isBinary(current) || isBinary(data) {
+		if pristine != nil && bytes.Equal(current, pristine) {
+			return data, false, nil
 		}
+		return current, true, nil
 	}
 
-	return nil
+	merged, conflicts := Merge(pristine, current, data)
+	return merged, conflicts > 0, nil
+}
+
+func (r *replacerInfo) getPristineFilePath(file string) string {
+	return filepath.Join(r.outPath, r.pristineDir, strings.TrimPrefix(file, r.outPath))
 }
 
 func (r *replacerInfo) isIgnoreFile(file string) bool {
@@ -348,6 +499,10 @@ func (r *replacerInfo) covertPathsDelimiter(filePaths ...string) []string {
 	return filePaths
 }
 
+func isBinary(data []byte) bool {
+	return bytes.IndexByte(data, 0) >= 0
+}
+
 func saveToNewFile(filePath string, data []byte) error {
 	// create directory
 	dir, _ := filepath.Split(filePath)
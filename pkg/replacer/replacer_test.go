@@ -107,3 +107,132 @@ func TestReplacerError(t *testing.T) {
 	err = r.SaveFiles()
 	assert.NoError(t, err)
 }
+
+func TestReplacerMerge(t *testing.T) {
+	r, err := New("testDir")
+	assert.NoError(t, err)
+	r.SetSubDirsAndFiles([]string{"testDir/replace"})
+	r.SetIgnoreSubFiles("test.txt", "abcdef.txt")
+	r.SetReplacementFields([]Field{{Old: "1234", New: "...."}})
+	outDir := fmt.Sprintf("%s/replacer_test/merge_%s", os.TempDir(), time.Now().Format("150405.000"))
+	_ = os.RemoveAll(outDir)
+	err = r.SetOutputDir(outDir)
+	assert.NoError(t, err)
+
+	// cancel saving when the files exist and no pristine directory is set
+	err = r.SaveFiles()
+	assert.NoError(t, err)
+	err = r.SaveFiles()
+	assert.Error(t, err)
+
+	r.SetPristineDir("docs/.gen.pristine")
+	err = r.SaveFiles()
+	assert.NoError(t, err)
+	file := outDir + "/replace/replace.txt"
+	pristineFile := outDir + "/docs/.gen.pristine/replace/replace.txt"
+	assert.FileExists(t, pristineFile)
+
+	// the edited line is kept, the regenerated line is updated
+	err = os.WriteFile(file, []byte("....567890\nabcdefghijklmnopqrstuvwxyz\nedited\n"), 0666)
+	assert.NoError(t, err)
+	r.SetReplacementFields([]Field{{Old: "1234", New: "4321"}})
+	err = r.SaveFiles()
+	assert.NoError(t, err)
+	assert.Empty(t, r.GetConflictFiles())
+	data, _ := os.ReadFile(file)
+	assert.Equal(t, "4321567890\nabcdefghijklmnopqrstuvwxyz\nedited\n", string(data))
+	data, _ = os.ReadFile(pristineFile)
+	assert.Equal(t, "4321567890\nabcdefghijklmnopqrstuvwxyz\nABCDEFGHIJKLMNOPQRSTUVWXYZ\n", string(data))
+
+	// the overlapping changes are marked as conflicts
+	err = os.WriteFile(file, []byte("edited567890\nabcdefghijklmnopqrstuvwxyz\nedited\n"), 0666)
+	assert.NoError(t, err)
+	r.SetReplacementFields([]Field{{Old: "1234", New: "1111"}})
+	err = r.SaveFiles()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{file}, r.GetConflictFiles())
+	data, _ = os.ReadFile(file)
+	assert.Equal(t, "<<<<<<< current\nedited567890\n=======\n1111567890\n>>>>>>> generated\n"+
+		"abcdefghijklmnopqrstuvwxyz\nedited\n", string(data))
+}
+
+func TestReplacerPreview(t *testing.T) {
+	r, err := New("testDir")
+	assert.NoError(t, err)
+	r.SetSubDirsAndFiles([]string{"testDir/replace"})
+	r.SetIgnoreSubFiles("test.txt", "abcdef.txt")
+	r.SetReplacementFields([]Field{{Old: "1234", New: "...."}})
+	outDir := fmt.Sprintf("%s/replacer_test/preview_%s", os.TempDir(), time.Now().Format("150405.000"))
+	_ = os.RemoveAll(outDir)
+	err = r.SetOutputDir(outDir)
+	assert.NoError(t, err)
+	r.SetPristineDir("docs/.gen.pristine")
+
+	// no files are written
+	changes, err := r.PreviewFiles()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, changes)
+	for _, change := range changes {
+		assert.Equal(t, FileStatusNew, change.Status)
+	}
+	assert.NoDirExists(t, outDir)
+
+	err = r.SaveFiles()
+	assert.NoError(t, err)
+	file := outDir + "/replace/replace.txt"
+	err = os.WriteFile(file, []byte("....567890\nabcdefghijklmnopqrstuvwxyz\nedited\n"), 0666)
+	assert.NoError(t, err)
+
+	r.SetReplacementFields([]Field{{Old: "1234", New: "4321"}})
+	changes, err = r.PreviewFiles()
+	assert.NoError(t, err)
+	for _, change := range changes {
+		if change.Path != file {
+			continue
+		}
+		assert.Equal(t, FileStatusModified, change.Status)
+		assert.False(t, change.IsConflict)
+		assert.Contains(t, change.Diff, "-....567890\n+4321567890\n")
+	}
+	data, _ := os.ReadFile(file)
+	assert.Equal(t, "....567890\nabcdefghijklmnopqrstuvwxyz\nedited\n", string(data))
+}
+
+func TestReplacerOverride(t *testing.T) {
+	overrideDir := t.TempDir()
+	err := os.MkdirAll(overrideDir+"/replace", 0766)
+	assert.NoError(t, err)
+	err = os.WriteFile(overrideDir+"/replace/replace.txt", []byte("// license header\n1234\n"), 0666)
+	assert.NoError(t, err)
+
+	newReplacers := []func() (Replacer, error){
+		func() (Replacer, error) { return New("testDir") },
+		func() (Replacer, error) { return NewFS("testDir", fs) },
+	}
+	for _, fn := range newReplacers {
+		r, err := fn()
+		assert.NoError(t, err)
+		r.SetOverrideDir(overrideDir)
+		r.SetSubDirsAndFiles([]string{"testDir/replace"})
+		r.SetIgnoreSubFiles("test.txt", "abcdef.txt")
+		r.SetReplacementFields([]Field{{Old: "1234", New: "...."}})
+		data, err := r.ReadFile("replace/replace.txt")
+		assert.NoError(t, err)
+		assert.Equal(t, "// license header\n1234\n", string(data))
+
+		outDir := t.TempDir()
+		err = r.SetOutputDir(outDir)
+		assert.NoError(t, err)
+		err = r.SaveFiles()
+		assert.NoError(t, err)
+		data, _ = os.ReadFile(outDir + "/replace/replace.txt")
+		assert.Equal(t, "// license header\n....\n", string(data))
+	}
+
+	// the files in the override directory are not template files
+	r, err := New("testDir")
+	assert.NoError(t, err)
+	r.SetOverrideDir("testDir/ignore")
+	_, err = r.ReadFile("ignore/ignore.txt")
+	assert.Error(t, err)
+}
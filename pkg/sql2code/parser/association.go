@@ -0,0 +1,201 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/huandu/xstrings"
+	"github.com/jinzhu/inflection"
+)
+
+// foreign key of the table, declared by FOREIGN KEY, REFERENCES or the naming convention <table>_id
+type foreignKey struct {
+	Column    string // column name of the table
+	RefTable  string // referenced table name
+	RefColumn string // referenced column name, default is id
+}
+
+// table information parsed from ddl, used to generate code after the associations between tables are resolved
+type tableInfo struct {
+	data          tmplData
+	importPaths   []string
+	foreignKeys   []*foreignKey
+	uniqueColumns map[string]bool
+	associations  []tmplField // association fields, only used in the model struct
+	indexes       []*tableIndex
+}
+
+func (t *tableInfo) getField(colName string) *tmplField {
+	for i, field := range t.data.Fields {
+		if field.ColName == colName {
+			return &t.data.Fields[i]
+		}
+	}
+	return nil
+}
+
+func (t *tableInfo) hasFieldName(name string) bool {
+	for _, field := range t.data.Fields {
+		if field.Name == name {
+			return true
+		}
+	}
+	for _, field := range t.associations {
+		if field.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *tableInfo) addAssociation(name string, goType string, gormTag string, opt options) {
+	if name == "" || t.hasFieldName(name) {
+		return // avoid duplicate field names in the struct
+	}
+
+	tags := []string{"gorm", gormTag}
+	if opt.JSONTag {
+		jsonName := xstrings.ToSnakeCase(name)
+		if opt.JSONNamedType != 0 {
+			jsonName = firstLetterToLow(name) // use hump type json names
+		}
+		tags = append(tags, "json", jsonName+",omitempty")
+	}
+
+	t.associations = append(t.associations, tmplField{
+		Name:   name,
+		GoType: goType,
+		Tag:    makeTagStr(tags),
+	})
+}
+
+// the base name of the table without prefix, e.g. t_users --> users
+func (t *tableInfo) baseName(opt options) string {
+	name := t.data.RawTableName
+	if opt.TablePrefix != "" && strings.HasPrefix(name, opt.TablePrefix) {
+		name = name[len(opt.TablePrefix):]
+	}
+	return name
+}
+
+// setAssociations convert the foreign keys between tables into GORM associations,
+// belongs to and has one/many are generated for foreign keys, many to many is generated for join tables.
+// nolint
+func setAssociations(tables []*tableInfo, opt options) {
+	findTable := func(name string) *tableInfo {
+		for _, t := range tables {
+			if t.data.RawTableName == name {
+				return t
+			}
+		}
+		return nil
+	}
+
+	// the column named <table>_id refers to the id of the table if it has no foreign key declared
+	for _, t := range tables {
+		declared := make(map[string]bool)
+		for _, fk := range t.foreignKeys {
+			declared[fk.Column] = true
+		}
+		for _, field := range t.data.Fields {
+			if declared[field.ColName] || !strings.HasSuffix(field.ColName, "_id") {
+				continue
+			}
+			base := inflection.Singular(strings.TrimSuffix(field.ColName, "_id"))
+			for _, ref := range tables {
+				if ref == t || inflection.Singular(ref.baseName(opt)) != base || ref.getField(columnID) == nil {
+					continue
+				}
+				t.foreignKeys = append(t.foreignKeys, &foreignKey{Column: field.ColName, RefTable: ref.data.RawTableName, RefColumn: columnID})
+				break
+			}
+		}
+	}
+
+	for _, t := range tables {
+		joinRefs := getJoinTableRefs(t, findTable)
+
+		for _, fk := range t.foreignKeys {
+			ref := findTable(fk.RefTable)
+			field := t.getField(fk.Column)
+			if ref == nil || field == nil {
+				continue
+			}
+			refField := ref.getField(fk.RefColumn)
+			if refField == nil {
+				continue
+			}
+			references := ""
+			if fk.RefColumn != columnID {
+				references = ";references:" + refField.Name
+			}
+
+			// belongs to, e.g. order.user_id --> User *User
+			name := toCamel(strings.TrimSuffix(fk.Column, "_id"))
+			if name == field.Name {
+				name = inflection.Singular(ref.data.TableName)
+			}
+			t.addAssociation(name, "*"+ref.data.TableName, "foreignKey:"+field.Name+references, opt)
+
+			// the join table is associated with many to many
+			if joinRefs != nil || ref == t {
+				continue
+			}
+
+			// has one or has many, e.g. user --> Orders []*Order
+			prefix := name
+			if inflection.Singular(name) == inflection.Singular(ref.data.TableName) {
+				prefix = ""
+			}
+			if t.uniqueColumns[fk.Column] {
+				ref.addAssociation(prefix+inflection.Singular(t.data.TableName), "*"+t.data.TableName, "foreignKey:"+field.Name+references, opt)
+			} else {
+				ref.addAssociation(prefix+inflection.Plural(t.data.TableName), "[]*"+t.data.TableName, "foreignKey:"+field.Name+references, opt)
+			}
+		}
+
+		// many to many, e.g. user_roles(user_id, role_id) --> User.Roles []*Role, Role.Users []*User
+		if joinRefs != nil {
+			for i := 0; i < 2; i++ {
+				self, other := joinRefs[i], joinRefs[1-i]
+				tag := "many2many:" + t.data.RawTableName +
+					";joinForeignKey:" + t.getField(self.fk.Column).Name +
+					";joinReferences:" + t.getField(other.fk.Column).Name
+				self.table.addAssociation(inflection.Plural(other.table.data.TableName), "[]*"+other.table.data.TableName, tag, opt)
+			}
+		}
+	}
+}
+
+type joinTableRef struct {
+	table *tableInfo
+	fk    *foreignKey
+}
+
+// the join table has only two foreign keys referring to the id of two different tables,
+// the other columns can only be the id and time columns.
+func getJoinTableRefs(t *tableInfo, findTable func(name string) *tableInfo) []joinTableRef {
+	if len(t.foreignKeys) != 2 {
+		return nil
+	}
+
+	var refs []joinTableRef
+	for _, fk := range t.foreignKeys {
+		ref := findTable(fk.RefTable)
+		if ref == nil || ref == t || fk.RefColumn != columnID || t.getField(fk.Column) == nil {
+			return nil
+		}
+		refs = append(refs, joinTableRef{table: ref, fk: fk})
+	}
+	if refs[0].table == refs[1].table {
+		return nil
+	}
+
+	for _, field := range t.data.Fields {
+		if field.ColName == refs[0].fk.Column || field.ColName == refs[1].fk.Column || isIgnoreFields(field.ColName) {
+			continue
+		}
+		return nil
+	}
+
+	return refs
+}
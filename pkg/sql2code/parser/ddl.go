@@ -0,0 +1,856 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/huandu/xstrings"
+	"github.com/jinzhu/inflection"
+)
+
+// generic DDL parser for the databases not supported by the mysql parser, e.g. postgresql, sqlite
+
+type ddlColumn struct {
+	Name          string
+	Type          string // type name without parameters, e.g. character varying, timestamptz
+	RawType       string // type as written in ddl, e.g. character varying(50)
+	IsArray       bool
+	NotNull       bool
+	CanNull       bool
+	PrimaryKey    bool
+	Unique        bool
+	AutoIncrement bool
+	Default       string
+	Comment       string
+	Reference     *foreignKey
+	EnumValues    []string // values of the enum type created by CREATE TYPE ... AS ENUM
+}
+
+type ddlTable struct {
+	Name        string
+	Comment     string
+	Columns     []*ddlColumn
+	ForeignKeys []*foreignKey
+	Indexes     []*tableIndex
+}
+
+func (t *ddlTable) getColumn(name string) *ddlColumn {
+	for _, col := range t.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+// nolint
+func makeDDLTableInfo(table *ddlTable, opt options, toGoType func(*ddlColumn, NullStyle) (string, string)) *tableInfo {
+	importPath := make([]string, 0, 1)
+	data := tmplData{
+		TableName:    table.Name,
+		RawTableName: table.Name,
+		Fields:       make([]tmplField, 0, len(table.Columns)),
+		Comment:      table.Comment,
+	}
+	tablePrefix := opt.TablePrefix
+	if tablePrefix != "" && strings.HasPrefix(data.TableName, tablePrefix) {
+		data.NameFunc = true
+		data.TableName = data.TableName[len(tablePrefix):]
+	}
+	if opt.ForceTableName || data.RawTableName != inflection.Plural(data.RawTableName) {
+		data.NameFunc = true
+	}
+
+	data.TableName = toCamel(data.TableName)
+	data.TName = firstLetterToLow(data.TableName)
+
+	foreignKeys := table.ForeignKeys
+	isUnique := make(map[string]bool)
+	var indexes []*tableIndex
+	columnPrefix := opt.ColumnPrefix
+	for _, col := range table.Columns {
+		if col.Reference != nil {
+			foreignKeys = append(foreignKeys, col.Reference)
+		}
+		if col.Unique {
+			isUnique[col.Name] = true
+			indexes = append(indexes, &tableIndex{Columns: []string{col.Name}, IsUnique: true})
+		}
+
+		colName := col.Name
+		goFieldName := colName
+		if columnPrefix != "" && strings.HasPrefix(goFieldName, columnPrefix) {
+			goFieldName = goFieldName[len(columnPrefix):]
+		}
+
+		field := tmplField{
+			Name:    toCamel(goFieldName),
+			ColName: colName,
+			Comment: col.Comment,
+		}
+
+		tags := make([]string, 0, 4)
+		// make GORM's tag
+		gormTag := strings.Builder{}
+		gormTag.WriteString("column:")
+		gormTag.WriteString(colName)
+		if opt.GormType && col.RawType != "" {
+			gormTag.WriteString(";type:")
+			gormTag.WriteString(col.RawType)
+		}
+		if col.PrimaryKey {
+			gormTag.WriteString(";primary_key")
+		}
+		if !col.IsArray && isJSONColumnType(col.Type) {
+			field.JSON = opt.getJSONType(data.RawTableName, colName)
+			if field.JSON != nil && field.JSON.IsStruct() {
+				gormTag.WriteString(";serializer:json")
+			}
+		}
+		if col.AutoIncrement {
+			gormTag.WriteString(";AUTO_INCREMENT")
+		}
+		if col.Default != "" {
+			gormTag.WriteString(";default:")
+			gormTag.WriteString(col.Default)
+		}
+		if col.Unique {
+			gormTag.WriteString(";unique")
+		}
+		if !col.PrimaryKey && col.NotNull {
+			gormTag.WriteString(";NOT NULL")
+		}
+		tags = append(tags, "gorm", gormTag.String())
+
+		if opt.JSONTag {
+			if opt.JSONNamedType != 0 {
+				colName = xstrings.FirstRuneToLower(xstrings.ToCamelCase(colName)) // use hump type json names
+			}
+			tags = append(tags, "json", colName)
+		}
+
+		field.Tag = makeTagStr(tags)
+
+		// get type in golang
+		nullStyle := opt.NullStyle
+		if !col.CanNull {
+			nullStyle = NullDisable
+		}
+		if len(col.EnumValues) > 0 {
+			field.Enum = newTmplEnum(data.TableName+field.Name, col.EnumValues, false)
+			field.GoType = field.Enum.TypeName
+		} else if field.JSON != nil {
+			field.GoType = field.JSON.GoType()
+			if !field.JSON.IsStruct() {
+				importPath = append(importPath, jsonDatatypesPath)
+			}
+		} else {
+			goType, pkg := toGoType(col, nullStyle)
+			if pkg != "" {
+				importPath = append(importPath, pkg)
+			}
+			field.GoType = goType
+		}
+
+		data.Fields = append(data.Fields, field)
+	}
+
+	return &tableInfo{
+		data:          data,
+		importPaths:   importPath,
+		foreignKeys:   foreignKeys,
+		uniqueColumns: isUnique,
+		indexes:       append(indexes, table.Indexes...),
+	}
+}
+
+type ddlTokenKind int
+
+const (
+	ddlTokenIdent  ddlTokenKind = iota // keyword or identifier, lower case if not quoted
+	ddlTokenQuoted                     // quoted identifier
+	ddlTokenString                     // string literal
+	ddlTokenNumber
+	ddlTokenSymbol
+)
+
+type ddlToken struct {
+	kind ddlTokenKind
+	text string
+	orig string // original text of the keyword or identifier
+}
+
+func (t ddlToken) is(words ...string) bool {
+	if t.kind != ddlTokenIdent && t.kind != ddlTokenSymbol {
+		return false
+	}
+	for _, w := range words {
+		if t.text == w {
+			return true
+		}
+	}
+	return false
+}
+
+// raw text of the token as it should appear in sql
+func (t ddlToken) raw() string {
+	switch t.kind {
+	case ddlTokenQuoted:
+		return quoteIdent(t.text)
+	case ddlTokenString:
+		return quoteString(t.text)
+	case ddlTokenIdent:
+		return t.orig
+	}
+	return t.text
+}
+
+// nolint
+func ddlTokenize(sql string) ([]ddlToken, error) {
+	var tokens []ddlToken
+	l := len(sql)
+	for i := 0; i < l; {
+		c := sql[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '-' && i+1 < l && sql[i+1] == '-':
+			for i < l && sql[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < l && sql[i+1] == '*':
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				return nil, errors.New("unterminated comment")
+			}
+			i += end + 4
+		case c == '\'' || ((c == 'E' || c == 'e') && i+1 < l && sql[i+1] == '\''):
+			if c != '\'' {
+				i++
+			}
+			sb := strings.Builder{}
+			i++
+			for {
+				if i >= l {
+					return nil, errors.New("unterminated string literal")
+				}
+				if sql[i] == '\'' {
+					if i+1 < l && sql[i+1] == '\'' {
+						sb.WriteByte('\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				sb.WriteByte(sql[i])
+				i++
+			}
+			tokens = append(tokens, ddlToken{kind: ddlTokenString, text: sb.String()})
+		case c == '"' || c == '`':
+			end := strings.IndexByte(sql[i+1:], c)
+			if end < 0 {
+				return nil, errors.New("unterminated quoted identifier")
+			}
+			tokens = append(tokens, ddlToken{kind: ddlTokenQuoted, text: sql[i+1 : i+1+end]})
+			i += end + 2
+		case c >= '0' && c <= '9':
+			start := i
+			for i < l && (sql[i] >= '0' && sql[i] <= '9' || sql[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, ddlToken{kind: ddlTokenNumber, text: sql[start:i]})
+		case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80:
+			start := i
+			for i < l && (sql[i] == '_' || sql[i] == '$' || sql[i] >= 'a' && sql[i] <= 'z' ||
+				sql[i] >= 'A' && sql[i] <= 'Z' || sql[i] >= '0' && sql[i] <= '9' || sql[i] >= 0x80) {
+				i++
+			}
+			tokens = append(tokens, ddlToken{kind: ddlTokenIdent, text: strings.ToLower(sql[start:i]), orig: sql[start:i]})
+		case c == ':' && i+1 < l && sql[i+1] == ':':
+			tokens = append(tokens, ddlToken{kind: ddlTokenSymbol, text: "::"})
+			i += 2
+		default:
+			tokens = append(tokens, ddlToken{kind: ddlTokenSymbol, text: string(c)})
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+// split tokens by the separator at the top level of parentheses
+func ddlSplitTokens(tokens []ddlToken, sep string) [][]ddlToken {
+	var groups [][]ddlToken
+	depth, start := 0, 0
+	for i, t := range tokens {
+		if t.kind != ddlTokenSymbol {
+			continue
+		}
+		switch t.text {
+		case "(", "[":
+			depth++
+		case ")", "]":
+			depth--
+		case sep:
+			if depth == 0 {
+				groups = append(groups, tokens[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if start < len(tokens) {
+		groups = append(groups, tokens[start:])
+	}
+	return groups
+}
+
+// find the index of the parenthesis that closes the one at position start
+func ddlMatchParen(tokens []ddlToken, start int) int {
+	depth := 0
+	for i := start; i < len(tokens); i++ {
+		if tokens[i].kind != ddlTokenSymbol {
+			continue
+		}
+		switch tokens[i].text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parse qualified name, e.g. public.user, return the last part and the number of tokens consumed
+func ddlParseName(tokens []ddlToken) (string, int) {
+	name, n := "", 0
+	for n < len(tokens) {
+		if tokens[n].kind != ddlTokenIdent && tokens[n].kind != ddlTokenQuoted {
+			break
+		}
+		name = tokens[n].text
+		n++
+		if n < len(tokens) && tokens[n].is(".") {
+			n++
+			continue
+		}
+		break
+	}
+	return name, n
+}
+
+func ddlJoinTokens(tokens []ddlToken) string {
+	sb := strings.Builder{}
+	for i, t := range tokens {
+		if i > 0 && !t.is("(", ")", ",", "::", ".", "[", "]") && !tokens[i-1].is("(", "::", ".", "[") {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(t.raw())
+	}
+	return sb.String()
+}
+
+// parseDDLTables parse the CREATE TABLE, CREATE INDEX, CREATE TYPE and COMMENT ON statements in the sql
+func parseDDLTables(sql string) ([]*ddlTable, error) {
+	tokens, err := ddlTokenize(sql)
+	if err != nil {
+		return nil, err
+	}
+
+	var tables []*ddlTable
+	enums := make(map[string][]string)
+	for _, stmt := range ddlSplitTokens(tokens, ";") {
+		if len(stmt) < 2 {
+			continue
+		}
+		switch {
+		case stmt[0].is("create") && stmt[1].is("type"):
+			if name, values := parseDDLCreateEnum(stmt); name != "" {
+				enums[name] = values
+			}
+		case stmt[0].is("create"):
+			table, err := parseDDLCreateTable(stmt)
+			if err != nil {
+				return nil, err
+			}
+			if table != nil {
+				tables = append(tables, table)
+				continue
+			}
+			if tableName, index := parseDDLCreateIndex(stmt); index != nil {
+				for _, t := range tables {
+					if t.Name == tableName {
+						t.Indexes = append(t.Indexes, index)
+						break
+					}
+				}
+			}
+		case stmt[0].is("comment") && stmt[1].is("on"):
+			parseDDLComment(stmt, tables)
+		}
+	}
+
+	for _, table := range tables {
+		for _, col := range table.Columns {
+			if values, ok := enums[col.Type]; ok && !col.IsArray {
+				col.EnumValues = values
+			}
+		}
+	}
+
+	return tables, nil
+}
+
+// parse CREATE TYPE name AS ENUM ('value1', 'value2'), return the name and values of the enum type
+func parseDDLCreateEnum(stmt []ddlToken) (string, []string) {
+	name, n := ddlParseName(stmt[2:])
+	i := 2 + n
+	if n == 0 || i+2 >= len(stmt) || !stmt[i].is("as") || !stmt[i+1].is("enum") || !stmt[i+2].is("(") {
+		return "", nil
+	}
+	end := ddlMatchParen(stmt, i+2)
+	if end < 0 {
+		return "", nil
+	}
+	var values []string
+	for _, t := range stmt[i+3 : end] {
+		if t.kind == ddlTokenString {
+			values = append(values, t.text)
+		}
+	}
+	return name, values
+}
+
+func parseDDLCreateTable(stmt []ddlToken) (*ddlTable, error) {
+	i := 1
+	for i < len(stmt) && stmt[i].is("global", "local", "temp", "temporary", "unlogged") {
+		i++
+	}
+	if i >= len(stmt) || !stmt[i].is("table") {
+		return nil, nil // not a table, e.g. CREATE INDEX
+	}
+	i++
+	if i+2 < len(stmt) && stmt[i].is("if") && stmt[i+1].is("not") && stmt[i+2].is("exists") {
+		i += 3
+	}
+
+	name, n := ddlParseName(stmt[i:])
+	if n == 0 {
+		return nil, errors.New("postgresql ddl: missing table name")
+	}
+	i += n
+	if i >= len(stmt) || !stmt[i].is("(") {
+		return nil, fmt.Errorf("postgresql ddl: table '%s' missing column definitions", name)
+	}
+	end := ddlMatchParen(stmt, i)
+	if end < 0 {
+		return nil, fmt.Errorf("postgresql ddl: table '%s' unclosed parenthesis", name)
+	}
+
+	table := &ddlTable{Name: name}
+	for _, element := range ddlSplitTokens(stmt[i+1:end], ",") {
+		if len(element) == 0 {
+			continue
+		}
+		if element[0].kind == ddlTokenIdent && element[0].is("constraint", "primary", "unique", "foreign", "check", "exclude", "like") {
+			parseDDLTableConstraint(element, table)
+			continue
+		}
+		col, err := parseDDLColumn(element)
+		if err != nil {
+			return nil, fmt.Errorf("postgresql ddl: table '%s' %v", name, err)
+		}
+		table.Columns = append(table.Columns, col)
+	}
+
+	return table, nil
+}
+
+func parseDDLTableConstraint(element []ddlToken, table *ddlTable) {
+	i := 0
+	if element[i].is("constraint") {
+		i += 2
+	}
+	if i >= len(element) {
+		return
+	}
+
+	isPrimaryKey := element[i].is("primary")
+	isForeignKey := element[i].is("foreign")
+	if !isPrimaryKey && !isForeignKey && !element[i].is("unique") {
+		return
+	}
+	for i < len(element) && !element[i].is("(") {
+		i++
+	}
+	keys, end := ddlParseColumnNames(element, i)
+	if end < 0 {
+		return
+	}
+
+	if isForeignKey {
+		// FOREIGN KEY (column) REFERENCES table [(column)], composite foreign keys are not supported
+		i = end + 1
+		if i < len(element) && element[i].is("references") && len(keys) == 1 {
+			if fk := parseDDLReference(element[i+1:], keys[0]); fk != nil {
+				table.ForeignKeys = append(table.ForeignKeys, fk)
+			}
+		}
+		return
+	}
+
+	if isPrimaryKey {
+		for _, key := range keys {
+			if col := table.getColumn(key); col != nil {
+				col.PrimaryKey = true
+			}
+		}
+	} else if len(keys) == 1 { // composite unique keys are not column level attributes
+		if col := table.getColumn(keys[0]); col != nil {
+			col.Unique = true
+		}
+	} else if len(keys) > 1 {
+		table.Indexes = append(table.Indexes, &tableIndex{Columns: keys, IsUnique: true})
+	}
+}
+
+// parse CREATE [UNIQUE] INDEX [CONCURRENTLY] [IF NOT EXISTS] [name] ON [ONLY] table [USING method] (columns),
+// the expression indexes and partial indexes are ignored.
+func parseDDLCreateIndex(stmt []ddlToken) (string, *tableIndex) {
+	i := 1
+	index := &tableIndex{}
+	if i < len(stmt) && stmt[i].is("unique") {
+		index.IsUnique = true
+		i++
+	}
+	if i >= len(stmt) || !stmt[i].is("index") {
+		return "", nil
+	}
+	for i < len(stmt) && !stmt[i].is("on") {
+		i++
+	}
+	i++
+	if i < len(stmt) && stmt[i].is("only") {
+		i++
+	}
+	if i >= len(stmt) {
+		return "", nil
+	}
+	tableName, n := ddlParseName(stmt[i:])
+	if n == 0 {
+		return "", nil
+	}
+	i += n
+	if i+1 < len(stmt) && stmt[i].is("using") {
+		i += 2
+	}
+	if i >= len(stmt) || !stmt[i].is("(") {
+		return "", nil
+	}
+	end := ddlMatchParen(stmt, i)
+	if end < 0 {
+		return "", nil
+	}
+	for _, t := range stmt[end+1:] {
+		if t.kind == ddlTokenIdent && t.is("where") {
+			return "", nil // partial index
+		}
+	}
+
+	for _, element := range ddlSplitTokens(stmt[i+1:end], ",") {
+		if len(element) == 0 || (element[0].kind != ddlTokenIdent && element[0].kind != ddlTokenQuoted) {
+			return "", nil
+		}
+		// only the column name can be followed by the sort order, e.g. name DESC NULLS LAST
+		for _, t := range element[1:] {
+			if t.kind != ddlTokenIdent || !t.is("asc", "desc", "nulls", "first", "last") {
+				return "", nil
+			}
+		}
+		index.Columns = append(index.Columns, element[0].text)
+	}
+
+	return tableName, index
+}
+
+// nolint
+func parseDDLColumn(element []ddlToken) (*ddlColumn, error) {
+	if element[0].kind != ddlTokenIdent && element[0].kind != ddlTokenQuoted {
+		return nil, fmt.Errorf("invalid column definition '%s'", ddlJoinTokens(element))
+	}
+	col := &ddlColumn{Name: element[0].text, CanNull: true}
+	i, typeName, err := parseDDLType(element, 1, col)
+	if err != nil {
+		return nil, fmt.Errorf("column '%s' %v", col.Name, err)
+	}
+	col.Type = typeName
+
+	for i < len(element) {
+		t := element[i]
+		switch {
+		case t.is("not") && i+1 < len(element) && element[i+1].is("null"):
+			col.NotNull = true
+			col.CanNull = false
+			i += 2
+		case t.is("null"):
+			i++
+		case t.is("primary"):
+			col.PrimaryKey = true
+			col.CanNull = false
+			i += 2
+		case t.is("unique"):
+			col.Unique = true
+			i++
+		case t.is("as"): // sqlite generated column, e.g. AS (expr) STORED
+			i++
+			if i < len(element) && element[i].is("(") {
+				if i = ddlMatchParen(element, i) + 1; i == 0 {
+					i = len(element)
+				}
+			}
+		case t.is("autoincrement"):
+			col.AutoIncrement = true
+			i++
+		case t.is("constraint"):
+			i += 2
+		case t.is("collate"):
+			_, n := ddlParseName(element[i+1:])
+			i += 1 + n
+		case t.is("generated"):
+			// GENERATED {ALWAYS | BY DEFAULT} AS {IDENTITY [(options)] | (expr) STORED}
+			for i < len(element) && !element[i].is("identity", "(") {
+				i++
+			}
+			if i < len(element) && element[i].is("identity") {
+				col.AutoIncrement = true
+				col.CanNull = false
+				i++
+			}
+			if i < len(element) && element[i].is("(") {
+				if i = ddlMatchParen(element, i) + 1; i == 0 {
+					i = len(element)
+				}
+			}
+			if i < len(element) && element[i].is("stored") {
+				i++
+			}
+		case t.is("default"):
+			start := i + 1
+			i = start
+			for i < len(element) {
+				if element[i].is("(") {
+					i = ddlMatchParen(element, i) + 1
+					if i == 0 {
+						i = len(element)
+					}
+					continue
+				}
+				if element[i].is("not", "null", "primary", "unique", "check", "references", "constraint", "generated", "collate") {
+					break
+				}
+				i++
+			}
+			col.Default = ddlDefaultValue(element[start:i], col)
+		case t.is("check", "references"):
+			if t.is("references") {
+				col.Reference = parseDDLReference(element[i+1:], col.Name)
+			}
+			// skip the expression or the referenced table
+			i++
+			for i < len(element) && !element[i].is("not", "null", "primary", "unique", "default", "constraint", "generated") {
+				if element[i].is("(") {
+					i = ddlMatchParen(element, i)
+					if i < 0 {
+						i = len(element)
+						break
+					}
+				}
+				i++
+			}
+		default:
+			i++
+		}
+	}
+
+	return col, nil
+}
+
+// parse the column names in parentheses at position start, return the names and the position of the closing parenthesis
+func ddlParseColumnNames(tokens []ddlToken, start int) ([]string, int) {
+	end := ddlMatchParen(tokens, start)
+	if end < 0 {
+		return nil, end
+	}
+	var names []string
+	for _, t := range tokens[start+1 : end] {
+		if t.kind == ddlTokenIdent || t.kind == ddlTokenQuoted {
+			names = append(names, t.text)
+		}
+	}
+	return names, end
+}
+
+// parse the referenced table and column after REFERENCES, e.g. users (id)
+func parseDDLReference(tokens []ddlToken, column string) *foreignKey {
+	refTable, n := ddlParseName(tokens)
+	if n == 0 {
+		return nil
+	}
+	fk := &foreignKey{Column: column, RefTable: refTable, RefColumn: columnID}
+	if n < len(tokens) && tokens[n].is("(") {
+		refColumns, _ := ddlParseColumnNames(tokens, n)
+		if len(refColumns) > 1 {
+			return nil
+		}
+		if len(refColumns) == 1 {
+			fk.RefColumn = refColumns[0]
+		}
+	}
+	return fk
+}
+
+// keywords that end the type of the column definition
+var ddlColumnKeywords = map[string]struct{}{
+	"not": {}, "null": {}, "primary": {}, "unique": {}, "default": {}, "check": {}, "references": {},
+	"constraint": {}, "collate": {}, "generated": {}, "autoincrement": {}, "array": {}, "as": {},
+}
+
+func isDDLColumnKeyword(word string) bool {
+	_, ok := ddlColumnKeywords[word]
+	return ok
+}
+
+// parse the column type and return the position of the next token
+// nolint
+func parseDDLType(element []ddlToken, i int, col *ddlColumn) (int, string, error) {
+	// the type name may consist of several words, e.g. double precision, timestamp(6) with time zone,
+	// the type can be omitted in sqlite
+	start := i
+	var words []string
+	for i < len(element) {
+		t := element[i]
+		if t.is("(") {
+			end := ddlMatchParen(element, i)
+			if end < 0 {
+				return i, "", errors.New("invalid type parameters")
+			}
+			i = end + 1
+			continue
+		}
+		if (t.kind != ddlTokenIdent && t.kind != ddlTokenQuoted) || (t.kind == ddlTokenIdent && isDDLColumnKeyword(t.text)) {
+			break
+		}
+		if i+1 < len(element) && element[i+1].is(".") { // ignore the schema of the type, e.g. pg_catalog.int4
+			i += 2
+			continue
+		}
+		words = append(words, t.text)
+		i++
+	}
+	name := strings.Join(words, " ")
+
+	// array types, e.g. text[], integer[3], integer ARRAY, _int4
+	for i < len(element) && element[i].is("[") {
+		col.IsArray = true
+		for i < len(element) && !element[i].is("]") {
+			i++
+		}
+		i++
+	}
+	if i < len(element) && element[i].is("array") {
+		col.IsArray = true
+		i++
+		if i < len(element) && element[i].is("[") {
+			for i < len(element) && !element[i].is("]") {
+				i++
+			}
+			i++
+		}
+	}
+	if strings.HasPrefix(name, "_") {
+		col.IsArray = true
+		name = name[1:]
+	}
+
+	col.RawType = strings.Replace(ddlJoinTokens(element[start:i]), " array", "[]", 1)
+
+	return i, name, nil
+}
+
+func ddlDefaultValue(tokens []ddlToken, col *ddlColumn) string {
+	if len(tokens) == 0 {
+		return ""
+	}
+	// the sequence is implied by auto increment
+	if tokens[0].is("nextval") {
+		col.AutoIncrement = true
+		col.CanNull = false
+		return ""
+	}
+	// drop type casts, e.g. 'foo'::character varying
+	for i, t := range tokens {
+		if t.is("::") {
+			tokens = tokens[:i]
+			break
+		}
+	}
+	if len(tokens) == 1 && tokens[0].kind == ddlTokenString {
+		return tokens[0].text
+	}
+	if len(tokens) == 1 && tokens[0].is("null") {
+		return ""
+	}
+	if len(tokens) == 2 && tokens[0].is("-") && tokens[1].kind == ddlTokenNumber {
+		return "-" + tokens[1].text
+	}
+	return ddlJoinTokens(tokens)
+}
+
+func parseDDLComment(stmt []ddlToken, tables []*ddlTable) {
+	// COMMENT ON {TABLE | COLUMN} name IS 'text'
+	if len(stmt) < 5 {
+		return
+	}
+	var names []string
+	i := 3
+	for i < len(stmt) && !stmt[i].is("is") {
+		if stmt[i].kind == ddlTokenIdent || stmt[i].kind == ddlTokenQuoted {
+			names = append(names, stmt[i].text)
+		}
+		i++
+	}
+	if i+1 >= len(stmt) || stmt[i+1].kind != ddlTokenString || len(names) == 0 {
+		return
+	}
+	comment := stmt[i+1].text
+
+	switch {
+	case stmt[2].is("table"):
+		for _, table := range tables {
+			if table.Name == names[len(names)-1] {
+				table.Comment = comment
+			}
+		}
+	case stmt[2].is("column") && len(names) >= 2:
+		for _, table := range tables {
+			if table.Name != names[len(names)-2] {
+				continue
+			}
+			if col := table.getColumn(names[len(names)-1]); col != nil {
+				col.Comment = comment
+			}
+		}
+	}
+}
+
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func quoteString(str string) string {
+	return "'" + strings.ReplaceAll(str, "'", "''") + "'"
+}
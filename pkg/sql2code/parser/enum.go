@@ -0,0 +1,135 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/huandu/xstrings"
+)
+
+// values of ENUM can be used in the oneof rule of the validator
+var enumBindingValueRegexp = regexp.MustCompile(`^[A-Za-z0-9_.:\-]+$`)
+
+// the named go type of the ENUM or SET column
+type tmplEnum struct {
+	TypeName string // e.g. UserStatus
+	IsSet    bool
+	Values   []tmplEnumValue
+}
+
+type tmplEnumValue struct {
+	Name  string // name of the constant, e.g. UserStatusActive
+	Value string // value in the database, e.g. active
+}
+
+func newTmplEnum(typeName string, values []string, isSet bool) *tmplEnum {
+	enum := &tmplEnum{TypeName: typeName, IsSet: isSet}
+	isExist := make(map[string]bool)
+	for i, value := range values {
+		name := typeName + getEnumConstName(value)
+		if isExist[name] {
+			name = fmt.Sprintf("%s%d", name, i+1)
+		}
+		isExist[name] = true
+		enum.Values = append(enum.Values, tmplEnumValue{Name: name, Value: value})
+	}
+	return enum
+}
+
+// the value of the database is converted to the suffix of the constant name, e.g. in-progress --> InProgress
+func getEnumConstName(value string) string {
+	var sb strings.Builder
+	for _, r := range value {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteByte('_')
+		}
+	}
+	word := strings.Trim(sb.String(), "_")
+	if word == strings.ToUpper(word) {
+		word = strings.ToLower(word) // e.g. IN_PROGRESS --> InProgress
+	}
+	name := toCamel(word)
+	if name == "" {
+		return "Empty"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		return "V" + name
+	}
+	return name
+}
+
+// VarName name of the variable that holds the values
+func (e tmplEnum) VarName() string {
+	return firstLetterToLow(e.TypeName) + "Values"
+}
+
+// BaseType the underlying type, SET is a bitset of the values
+func (e tmplEnum) BaseType() string {
+	if e.IsSet {
+		return "uint64"
+	}
+	return "string"
+}
+
+// ProtoName name of the protobuf enum value, e.g. USER_STATUS_ACTIVE
+func (e tmplEnum) ProtoName(name string) string {
+	return strings.ToUpper(xstrings.ToSnakeCase(name))
+}
+
+// Mask all bits of the SET values
+func (e tmplEnum) Mask() uint64 {
+	if len(e.Values) >= 64 {
+		return ^uint64(0)
+	}
+	return 1<<uint(len(e.Values)) - 1
+}
+
+// BindingRule validation rule of the request params
+func (e tmplEnum) BindingRule() string {
+	if e.IsSet {
+		return fmt.Sprintf("omitempty,max=%d", e.Mask())
+	}
+	values := make([]string, 0, len(e.Values))
+	for _, v := range e.Values {
+		if !enumBindingValueRegexp.MatchString(v.Value) {
+			return ""
+		}
+		values = append(values, v.Value)
+	}
+	return "omitempty,oneof=" + strings.Join(values, " ")
+}
+
+// the enums of the fields, used to generate the named types and protobuf enums
+func getTmplEnums(fields []tmplField) []tmplEnum {
+	var enums []tmplEnum
+	for _, field := range fields {
+		if field.Enum != nil {
+			enums = append(enums, *field.Enum)
+		}
+	}
+	return enums
+}
+
+// the enum fields in the request and respond of handler use the underlying type,
+// the typed JSON fields use datatypes.JSON or the struct type of model
+func getHandlerFields(fields []tmplField) []tmplField {
+	newFields := make([]tmplField, 0, len(fields))
+	for _, field := range fields {
+		if field.Enum != nil {
+			field.GoType = field.Enum.BaseType()
+		}
+		if field.JSON != nil {
+			field.GoType = field.JSON.HandlerType()
+		}
+		newFields = append(newFields, field)
+	}
+	return newFields
+}
+
+// AddOne counter
+func (v tmplEnumValue) AddOne(i int) int {
+	return i + 1
+}
@@ -0,0 +1,335 @@
+package parser
+
+import (
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/huandu/xstrings"
+)
+
+// index of the table, declared by UNIQUE, KEY, INDEX or CREATE INDEX, the primary key is not included
+type tableIndex struct {
+	Columns  []string
+	IsUnique bool
+}
+
+// the names of the methods that already exist in the generated dao, handler and service
+var reservedIndexMethods = map[string]struct{}{
+	"GetByID":                 {},
+	"GetByIDs":                {},
+	"GetByIDWithAssociations": {},
+	"GetByColumns":            {},
+	"ListByIDs":               {},
+}
+
+// the names of the variables used in the generated index methods
+var reservedIndexVars = map[string]struct{}{
+	"ctx": {}, "d": {}, "h": {}, "s": {}, "c": {}, "err": {}, "id": {}, "ids": {}, "indexKey": {},
+	"record": {}, "records": {}, "recordMap": {}, "table": {}, "val": {}, "ok": {}, "form": {}, "data": {}, "req": {},
+}
+
+type tmplIndex struct {
+	TableName string
+	TName     string
+	Name      string // the name of the columns, e.g. TenantIDAndStatus
+	IsUnique  bool
+	Fields    []tmplIndexField
+}
+
+type tmplIndexField struct {
+	tmplField
+	VarName   string // parameter name, e.g. tenantID
+	ProtoName string // field name of the protobuf message in golang, e.g. TenantId
+	ProtoType string // field type of the protobuf message, e.g. int32
+}
+
+// MethodName name of the index method, a unique index gets a record, a normal index gets a list of records
+func (t tmplIndex) MethodName() string {
+	if t.IsUnique {
+		return "GetBy" + t.Name
+	}
+	return "ListBy" + t.Name
+}
+
+func (t tmplIndex) hasColumn(colName string) bool {
+	for _, field := range t.Fields {
+		if field.ColName == colName {
+			return true
+		}
+	}
+	return false
+}
+
+// RequestName name of the request struct and the protobuf message
+func (t tmplIndex) RequestName() string {
+	if t.IsUnique {
+		return "Get" + t.TableName + "By" + t.Name + "Request"
+	}
+	return "List" + t.TableName + "By" + t.Name + "Request"
+}
+
+// ReplyName name of the protobuf reply message
+func (t tmplIndex) ReplyName() string {
+	return strings.TrimSuffix(t.RequestName(), "Request") + "Reply"
+}
+
+// ColumnNames column names separated by comma, e.g. tenant_id, status
+func (t tmplIndex) ColumnNames() string {
+	names := make([]string, 0, len(t.Fields))
+	for _, field := range t.Fields {
+		names = append(names, field.ColName)
+	}
+	return strings.Join(names, ", ")
+}
+
+// Params parameters of the dao method, e.g. tenantID uint64, status model.UsersStatus
+func (t tmplIndex) Params() string {
+	params := make([]string, 0, len(t.Fields))
+	for _, field := range t.Fields {
+		goType := field.GoType
+		if field.Enum != nil {
+			goType = "model." + goType
+		}
+		params = append(params, field.VarName+" "+goType)
+	}
+	return strings.Join(params, ", ")
+}
+
+// Args arguments of the dao method, e.g. tenantID, status, if prefix is not empty, use the fields of the prefix,
+// e.g. form.TenantID, model.UsersStatus(form.Status)
+func (t tmplIndex) Args(prefix string) string {
+	args := make([]string, 0, len(t.Fields))
+	for _, field := range t.Fields {
+		switch {
+		case prefix == "":
+			args = append(args, field.VarName)
+		case field.Enum != nil:
+			args = append(args, "model."+field.GoType+"("+prefix+"."+field.Name+")")
+		default:
+			args = append(args, prefix+"."+field.Name)
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// ProtoArgs arguments of the dao method from the protobuf request, e.g. req.TenantId, int(req.Status),
+// the protobuf enum is converted by number, e.g. model.UsersStatusFromNumber(int32(req.Status))
+func (t tmplIndex) ProtoArgs() string {
+	args := make([]string, 0, len(t.Fields))
+	for _, field := range t.Fields {
+		arg := "req." + field.ProtoName
+		switch {
+		case field.Enum != nil && !field.Enum.IsSet:
+			arg = "model." + field.GoType + "FromNumber(int32(" + arg + "))"
+		case field.Enum != nil:
+			arg = "model." + field.GoType + "(" + arg + ")"
+		case field.ProtoType != field.GoType:
+			arg = field.GoType + "(" + arg + ")"
+		}
+		args = append(args, arg)
+	}
+	return strings.Join(args, ", ")
+}
+
+// Where query condition, e.g. tenant_id = ? AND status = ?
+func (t tmplIndex) Where() string {
+	conditions := make([]string, 0, len(t.Fields))
+	for _, field := range t.Fields {
+		conditions = append(conditions, field.ColName+" = ?")
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+// CacheKey the expression of the index cache key, if prefix is empty, use the parameters,
+// e.g. "tenant_id:" + cast.ToString(tenantID) + ":status:" + cast.ToString(status)
+func (t tmplIndex) CacheKey(prefix string) string {
+	keys := make([]string, 0, len(t.Fields))
+	for i, field := range t.Fields {
+		name := field.ColName + ":"
+		if i > 0 {
+			name = ":" + name
+		}
+		value := field.VarName
+		if prefix != "" {
+			value = prefix + "." + field.Name
+		}
+		if field.Enum != nil {
+			value = field.Enum.BaseType() + "(" + value + ")"
+		}
+		keys = append(keys, `"`+name+`" + cast.ToString(`+value+`)`)
+	}
+	return strings.Join(keys, " + ")
+}
+
+// Match the condition that the record matches the parameters, e.g. record.TenantID == tenantID && record.Status == status
+func (t tmplIndex) Match(record string) string {
+	conditions := make([]string, 0, len(t.Fields))
+	for _, field := range t.Fields {
+		conditions = append(conditions, record+"."+field.Name+" == "+field.VarName)
+	}
+	return strings.Join(conditions, " && ")
+}
+
+// RoutePath path of the gin route, e.g. tenant_id/:tenant_id/status/:status
+func (t tmplIndex) RoutePath() string {
+	paths := make([]string, 0, len(t.Fields))
+	for _, field := range t.Fields {
+		paths = append(paths, field.ColName+"/:"+field.ColName)
+	}
+	return strings.Join(paths, "/")
+}
+
+// ProtoPath path of the google.api.http option, e.g. tenant_id/{tenant_id}/status/{status}
+func (t tmplIndex) ProtoPath() string {
+	paths := make([]string, 0, len(t.Fields))
+	for _, field := range t.Fields {
+		paths = append(paths, field.ColName+"/{"+field.ColName+"}")
+	}
+	return strings.Join(paths, "/")
+}
+
+// TestArgs arguments of the dao method in the generated test, the fields of the test data,
+// e.g. testData.TenantID, testData.Status
+func (t tmplIndex) TestArgs() string {
+	args := make([]string, 0, len(t.Fields))
+	for _, field := range t.Fields {
+		args = append(args, "testData."+field.Name)
+	}
+	return strings.Join(args, ", ")
+}
+
+// TestColumns columns of the mock rows in the generated test, e.g. "id", "tenant_id", "status"
+func (t tmplIndex) TestColumns() string {
+	columns := []string{`"id"`}
+	for _, field := range t.Fields {
+		columns = append(columns, strconv.Quote(field.ColName))
+	}
+	return strings.Join(columns, ", ")
+}
+
+// TestRowValues values of the mock rows in the generated test, the ENUM and SET values are
+// the strings in the database, e.g. testData.ID, testData.TenantID, "active"
+func (t tmplIndex) TestRowValues() string {
+	values := []string{"testData.ID"}
+	for _, field := range t.Fields {
+		if field.Enum != nil && len(field.Enum.Values) > 0 {
+			values = append(values, strconv.Quote(field.Enum.Values[0].Value))
+		} else {
+			values = append(values, "testData."+field.Name)
+		}
+	}
+	return strings.Join(values, ", ")
+}
+
+// TestValue the value of the field in the generated test, ENUM and SET use the first value
+func (t tmplIndexField) TestValue() string {
+	if t.Enum != nil {
+		if len(t.Enum.Values) > 0 {
+			return "model." + t.Enum.Values[0].Name
+		}
+		if t.Enum.IsSet {
+			return "model." + t.GoType + "(0)"
+		}
+		return "model." + t.GoType + `("")`
+	}
+	switch t.GoType {
+	case "string":
+		return `"foo"`
+	case "bool":
+		return "true"
+	}
+	return "1"
+}
+
+// RequestType type of the field in the request struct of the handler,
+// the named types of ENUM and SET use the underlying type
+func (t tmplIndexField) RequestType() string {
+	if t.Enum != nil {
+		return t.Enum.BaseType()
+	}
+	return t.GoType
+}
+
+// SwaggerType type of the path parameter in swagger
+func (t tmplIndexField) SwaggerType() string {
+	switch t.RequestType() {
+	case "string":
+		return "string"
+	case "bool":
+		return "boolean"
+	case "float32", "float64":
+		return "number"
+	}
+	return "integer"
+}
+
+// only the columns of basic types, ENUM and SET can be used as parameters of the index methods
+func isIndexGoType(field *tmplField) bool {
+	if field.Enum != nil {
+		return true
+	}
+	switch field.GoType {
+	case "int8", "int16", "int32", "int64", "int", "uint8", "uint16", "uint32", "uint64", "uint",
+		"float32", "float64", "string", "bool":
+		return true
+	}
+	return false
+}
+
+func getIndexVarName(name string) string {
+	varName := firstLetterToLow(name)
+	if strings.HasPrefix(name, "ID") {
+		varName = "id" + name[2:]
+	}
+	if _, ok := reservedIndexVars[varName]; ok || token.Lookup(varName).IsKeyword() {
+		varName += "Val"
+	}
+	return varName
+}
+
+// convert the indexes of the table into the index methods, the unique indexes are preferred
+// when the same columns are declared more than once.
+func makeTmplIndexes(t *tableInfo) []tmplIndex {
+	var indexes []tmplIndex
+	isExist := make(map[string]bool)
+	for _, isUnique := range []bool{true, false} {
+	nextIndex:
+		for _, index := range t.indexes {
+			if index.IsUnique != isUnique || len(index.Columns) == 0 {
+				continue
+			}
+
+			fields := make([]tmplIndexField, 0, len(index.Columns))
+			names := make([]string, 0, len(index.Columns))
+			for _, colName := range index.Columns {
+				field := t.getField(colName)
+				if field == nil || isIgnoreFields(colName) || !isIndexGoType(field) {
+					continue nextIndex
+				}
+				protoType := goTypeToProto([]tmplField{*field})[0].GoType
+				fields = append(fields, tmplIndexField{
+					tmplField: *field,
+					VarName:   getIndexVarName(field.Name),
+					ProtoName: xstrings.ToCamelCase(colName),
+					ProtoType: protoType,
+				})
+				names = append(names, field.Name)
+			}
+
+			tIndex := tmplIndex{
+				TableName: t.data.TableName,
+				TName:     t.data.TName,
+				Name:      strings.Join(names, "And"),
+				IsUnique:  isUnique,
+				Fields:    fields,
+			}
+			if _, ok := reservedIndexMethods[tIndex.MethodName()]; ok || isExist[tIndex.Name] {
+				continue
+			}
+			isExist[tIndex.Name] = true
+			indexes = append(indexes, tIndex)
+		}
+	}
+	return indexes
+}
@@ -0,0 +1,70 @@
+package parser
+
+import "strings"
+
+const (
+	// JSONTypeDatatypes the JSON columns are converted to datatypes.JSON
+	JSONTypeDatatypes = "datatypes"
+
+	jsonDatatypesPath = "gorm.io/datatypes"
+)
+
+// the go type of the typed JSON column
+type tmplJSON struct {
+	TypeName string // the named struct type converted by the json serializer of GORM, empty means datatypes.JSON
+	Column   string
+}
+
+// IsStruct whether the column is converted to the named struct type
+func (j tmplJSON) IsStruct() bool {
+	return j.TypeName != ""
+}
+
+// GoType type of the field in the model struct
+func (j tmplJSON) GoType() string {
+	if j.IsStruct() {
+		return "*" + j.TypeName
+	}
+	return "datatypes.JSON"
+}
+
+// HandlerType type of the field in the request and respond struct of handler
+func (j tmplJSON) HandlerType() string {
+	if j.IsStruct() {
+		return "*model." + j.TypeName
+	}
+	return "datatypes.JSON"
+}
+
+// the typed JSON of the column, nil means the column is a string
+func (o options) getJSONType(table string, column string) *tmplJSON {
+	if name, ok := o.JSONStructs[table+"."+column]; ok {
+		return &tmplJSON{TypeName: name, Column: column}
+	}
+	if name, ok := o.JSONStructs[column]; ok {
+		return &tmplJSON{TypeName: name, Column: column}
+	}
+	if o.JSONType == JSONTypeDatatypes {
+		return &tmplJSON{Column: column}
+	}
+	return nil
+}
+
+func isJSONColumnType(colType string) bool {
+	colType = strings.ToLower(colType)
+	return colType == "json" || colType == "jsonb"
+}
+
+// the named struct types of the JSON columns, used to generate the struct definitions in the model
+func getTmplJSONStructs(fields []tmplField) []tmplJSON {
+	var structs []tmplJSON
+	isExist := make(map[string]bool)
+	for _, field := range fields {
+		if field.JSON == nil || !field.JSON.IsStruct() || isExist[field.JSON.TypeName] {
+			continue
+		}
+		isExist[field.JSON.TypeName] = true
+		structs = append(structs, *field.JSON)
+	}
+	return structs
+}
@@ -0,0 +1,458 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/blastrain/vitess-sqlparser/tidbparser/ast"
+	"github.com/blastrain/vitess-sqlparser/tidbparser/dependency/types"
+	"github.com/blastrain/vitess-sqlparser/tidbparser/parser"
+)
+
+// the name of the primary key index in mysql
+const primaryIndexName = "PRIMARY"
+
+type schemaColumn struct {
+	Name          string
+	Type          string // e.g. varchar(50), bigint(20) unsigned
+	NotNull       bool
+	AutoIncrement bool
+	Default       string // default value in sql, e.g. 'abc', 0, CURRENT_TIMESTAMP, empty means no default value
+	OnUpdate      string
+	Comment       string
+}
+
+// the definition of the column in the CREATE TABLE and ALTER TABLE statements
+func (c *schemaColumn) definition() string {
+	def := quoteName(c.Name) + " " + c.Type
+	if c.NotNull {
+		def += " NOT NULL"
+	}
+	if c.AutoIncrement {
+		def += " AUTO_INCREMENT"
+	}
+	if c.Default != "" {
+		def += " DEFAULT " + c.Default
+	}
+	if c.OnUpdate != "" {
+		def += " ON UPDATE " + c.OnUpdate
+	}
+	if c.Comment != "" {
+		def += " COMMENT " + quoteMysqlString(c.Comment)
+	}
+	return def
+}
+
+func (c *schemaColumn) equal(target *schemaColumn) bool {
+	return normalizeColumnType(c.Type) == normalizeColumnType(target.Type) &&
+		c.NotNull == target.NotNull &&
+		c.AutoIncrement == target.AutoIncrement &&
+		normalizeDefault(c.Default) == normalizeDefault(target.Default) &&
+		normalizeDefault(c.OnUpdate) == normalizeDefault(target.OnUpdate) &&
+		(target.Comment == "" || c.Comment == target.Comment)
+}
+
+type schemaIndex struct {
+	Name      string
+	Columns   []string // column names with the prefix length, e.g. `name`(10)
+	IsPrimary bool
+	IsUnique  bool
+}
+
+func (idx *schemaIndex) definition() string {
+	columns := "(" + strings.Join(idx.Columns, ",") + ")"
+	switch {
+	case idx.IsPrimary:
+		return "PRIMARY KEY " + columns
+	case idx.IsUnique:
+		return "UNIQUE KEY " + quoteName(idx.Name) + " " + columns
+	default:
+		return "KEY " + quoteName(idx.Name) + " " + columns
+	}
+}
+
+func (idx *schemaIndex) dropDefinition() string {
+	if idx.IsPrimary {
+		return "DROP PRIMARY KEY"
+	}
+	return "DROP INDEX " + quoteName(idx.Name)
+}
+
+func (idx *schemaIndex) equal(target *schemaIndex) bool {
+	return idx.IsPrimary == target.IsPrimary && idx.IsUnique == target.IsUnique &&
+		strings.Join(idx.Columns, ",") == strings.Join(target.Columns, ",")
+}
+
+type schemaTable struct {
+	Name    string
+	Columns []*schemaColumn
+	Indexes []*schemaIndex
+	Options []string // table options in sql, e.g. ENGINE=InnoDB
+	Comment string
+}
+
+func (t *schemaTable) getColumn(name string) *schemaColumn {
+	for _, col := range t.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+// get the index with the same name, otherwise the index with the same definition
+func (t *schemaTable) getIndex(index *schemaIndex) *schemaIndex {
+	for _, idx := range t.Indexes {
+		if idx.Name == index.Name {
+			return idx
+		}
+	}
+	for _, idx := range t.Indexes {
+		if idx.equal(index) {
+			return idx
+		}
+	}
+	return nil
+}
+
+// the position of the column in the ALTER TABLE statement
+func (t *schemaTable) columnPosition(name string) string {
+	for i, col := range t.Columns {
+		if col.Name == name {
+			if i == 0 {
+				return " FIRST"
+			}
+			return " AFTER " + quoteName(t.Columns[i-1].Name)
+		}
+	}
+	return ""
+}
+
+func (t *schemaTable) createSQL() string {
+	lines := make([]string, 0, len(t.Columns)+len(t.Indexes))
+	for _, col := range t.Columns {
+		lines = append(lines, "  "+col.definition())
+	}
+	for _, idx := range t.Indexes {
+		lines = append(lines, "  "+idx.definition())
+	}
+	sql := "CREATE TABLE " + quoteName(t.Name) + " (\n" + strings.Join(lines, ",\n") + "\n)"
+	if len(t.Options) > 0 {
+		sql += " " + strings.Join(t.Options, " ")
+	}
+	return sql
+}
+
+// DiffSQL compare the CREATE TABLE statements of the current database with the target CREATE TABLE statements,
+// return the statements that migrate the database to the target (up) and the statements that roll back (down).
+// the tables that are not in the target are ignored, the foreign keys are not compared,
+// the target tables can be limited by WithTableNames.
+func DiffSQL(current string, target string, options ...Option) ([]string, []string, error) {
+	opt := parseOption(options)
+	currentTables, err := parseSchemaTables(current, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse current sql error, %v", err)
+	}
+	targetTables, err := parseSchemaTables(target, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse target sql error, %v", err)
+	}
+
+	var up, down []string
+	for _, targetTable := range targetTables {
+		if !opt.isGenerateTable(targetTable.Name) {
+			continue
+		}
+		var currentTable *schemaTable
+		for _, t := range currentTables {
+			if t.Name == targetTable.Name {
+				currentTable = t
+				break
+			}
+		}
+
+		if currentTable == nil {
+			up = append(up, targetTable.createSQL())
+			down = append(down, "DROP TABLE "+quoteName(targetTable.Name))
+			continue
+		}
+
+		ups, downs := diffTable(currentTable, targetTable, opt)
+		up = append(up, ups...)
+		down = append(down, downs...)
+	}
+
+	// roll back in the reverse order
+	for i, j := 0, len(down)-1; i < j; i, j = i+1, j-1 {
+		down[i], down[j] = down[j], down[i]
+	}
+
+	return up, down, nil
+}
+
+// GetTableNames get the names of the tables in the CREATE TABLE statements
+func GetTableNames(sql string, options ...Option) ([]string, error) {
+	opt := parseOption(options)
+	tables, err := parseSchemaTables(sql, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(tables))
+	for _, t := range tables {
+		if opt.isGenerateTable(t.Name) {
+			names = append(names, t.Name)
+		}
+	}
+	return names, nil
+}
+
+// the down statements are in the same order as the up statements
+func diffTable(current *schemaTable, target *schemaTable, opt options) ([]string, []string) {
+	var up, down []string
+	alter := "ALTER TABLE " + quoteName(target.Name) + " "
+	add := func(u string, d string) {
+		up = append(up, alter+u)
+		down = append(down, alter+d)
+	}
+
+	// drop the indexes that are removed or changed
+	var addIndexes []*schemaIndex
+	for _, idx := range target.Indexes {
+		currentIdx := current.getIndex(idx)
+		if currentIdx == nil {
+			addIndexes = append(addIndexes, idx)
+			continue
+		}
+		if !currentIdx.equal(idx) {
+			add(currentIdx.dropDefinition(), "ADD "+currentIdx.definition())
+			addIndexes = append(addIndexes, idx)
+		}
+	}
+	if !opt.KeepIndexes {
+		for _, idx := range current.Indexes {
+			if target.getIndex(idx) == nil {
+				add(idx.dropDefinition(), "ADD "+idx.definition())
+			}
+		}
+	}
+
+	for _, col := range target.Columns {
+		currentCol := current.getColumn(col.Name)
+		if currentCol == nil {
+			add("ADD COLUMN "+col.definition()+target.columnPosition(col.Name), "DROP COLUMN "+quoteName(col.Name))
+			continue
+		}
+		if !currentCol.equal(col) {
+			targetCol := *col
+			if targetCol.Comment == "" {
+				targetCol.Comment = currentCol.Comment // keep the comment of the column
+			}
+			add("MODIFY COLUMN "+targetCol.definition(), "MODIFY COLUMN "+currentCol.definition())
+		}
+	}
+
+	for _, idx := range addIndexes {
+		add("ADD "+idx.definition(), idx.dropDefinition())
+	}
+
+	// drop the columns in the reverse order, so that the columns are added back in order when rolling back
+	for i := len(current.Columns) - 1; i >= 0; i-- {
+		col := current.Columns[i]
+		if target.getColumn(col.Name) == nil {
+			add("DROP COLUMN "+quoteName(col.Name), "ADD COLUMN "+col.definition()+current.columnPosition(col.Name))
+		}
+	}
+
+	if target.Comment != "" && target.Comment != current.Comment {
+		add("COMMENT="+quoteMysqlString(target.Comment), "COMMENT="+quoteMysqlString(current.Comment))
+	}
+
+	return up, down
+}
+
+func parseSchemaTables(sql string, opt options) ([]*schemaTable, error) {
+	stmts, err := parser.New().Parse(sql, opt.Charset, opt.Collation)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make([]*schemaTable, 0, len(stmts))
+	for _, stmt := range stmts {
+		if ct, ok := stmt.(*ast.CreateTableStmt); ok {
+			tables = append(tables, makeSchemaTable(ct))
+		}
+	}
+
+	// the indexes created by CREATE INDEX statements
+	for _, stmt := range stmts {
+		ci, ok := stmt.(*ast.CreateIndexStmt)
+		if !ok || ci.Table == nil {
+			continue
+		}
+		for _, t := range tables {
+			if t.Name == ci.Table.Name.String() {
+				t.Indexes = append(t.Indexes, getSchemaIndex(ci.IndexName, ci.IndexColNames, false, ci.Unique))
+				break
+			}
+		}
+	}
+
+	return tables, nil
+}
+
+func makeSchemaTable(stmt *ast.CreateTableStmt) *schemaTable {
+	table := &schemaTable{Name: stmt.Table.Name.String()}
+
+	for _, o := range stmt.Options {
+		switch o.Tp {
+		case ast.TableOptionEngine:
+			table.Options = append(table.Options, "ENGINE="+o.StrValue)
+		case ast.TableOptionCharset:
+			table.Options = append(table.Options, "DEFAULT CHARSET="+o.StrValue)
+		case ast.TableOptionCollate:
+			table.Options = append(table.Options, "COLLATE="+o.StrValue)
+		case ast.TableOptionComment:
+			table.Comment = o.StrValue
+			table.Options = append(table.Options, "COMMENT="+quoteMysqlString(o.StrValue))
+		}
+	}
+
+	for _, col := range stmt.Cols {
+		column := &schemaColumn{
+			Name: col.Name.Name.String(),
+			Type: col.Tp.InfoSchemaStr(),
+		}
+		for _, o := range col.Options {
+			switch o.Tp {
+			case ast.ColumnOptionPrimaryKey:
+				table.Indexes = append(table.Indexes, &schemaIndex{
+					Name:      primaryIndexName,
+					Columns:   []string{quoteName(column.Name)},
+					IsPrimary: true,
+				})
+			case ast.ColumnOptionUniqKey:
+				table.Indexes = append(table.Indexes, &schemaIndex{
+					Name:     column.Name,
+					Columns:  []string{quoteName(column.Name)},
+					IsUnique: true,
+				})
+			case ast.ColumnOptionNotNull:
+				column.NotNull = true
+			case ast.ColumnOptionAutoIncrement:
+				column.AutoIncrement = true
+			case ast.ColumnOptionDefaultValue:
+				column.Default = getDefaultSQL(o.Expr, col.Tp)
+			case ast.ColumnOptionOnUpdate:
+				column.OnUpdate = getDefaultSQL(o.Expr, col.Tp)
+			case ast.ColumnOptionComment:
+				column.Comment = fmt.Sprintf("%v", o.Expr.GetDatum().GetValue())
+			}
+		}
+		table.Columns = append(table.Columns, column)
+	}
+
+	for _, con := range stmt.Constraints {
+		switch con.Tp {
+		case ast.ConstraintPrimaryKey:
+			table.Indexes = append(table.Indexes, getSchemaIndex(primaryIndexName, con.Keys, true, false))
+		case ast.ConstraintUniq, ast.ConstraintUniqKey, ast.ConstraintUniqIndex:
+			table.Indexes = append(table.Indexes, getSchemaIndex(con.Name, con.Keys, false, true))
+		case ast.ConstraintKey, ast.ConstraintIndex:
+			table.Indexes = append(table.Indexes, getSchemaIndex(con.Name, con.Keys, false, false))
+		}
+	}
+
+	// the columns of the primary key are not null
+	for _, idx := range table.Indexes {
+		if !idx.IsPrimary {
+			continue
+		}
+		for _, col := range table.Columns {
+			for _, name := range idx.Columns {
+				if name == quoteName(col.Name) {
+					col.NotNull = true
+				}
+			}
+		}
+	}
+
+	return table
+}
+
+func getSchemaIndex(name string, keys []*ast.IndexColName, isPrimary bool, isUnique bool) *schemaIndex {
+	index := &schemaIndex{Name: name, IsPrimary: isPrimary, IsUnique: isUnique}
+	for _, key := range keys {
+		column := quoteName(key.Column.Name.String())
+		if key.Length > 0 {
+			column += "(" + strconv.Itoa(key.Length) + ")"
+		}
+		index.Columns = append(index.Columns, column)
+	}
+	// the index without name is named after the first column in mysql
+	if index.Name == "" && len(keys) > 0 {
+		index.Name = keys[0].Column.Name.String()
+	}
+	return index
+}
+
+// the default value in sql, the functions of the current time have the same precision as the column
+func getDefaultSQL(expr ast.ExprNode, tp *types.FieldType) string {
+	if funcExpr, ok := expr.(*ast.FuncCallExpr); ok {
+		name := strings.ToUpper(funcExpr.FnName.O)
+		switch name {
+		case "CURRENT_TIMESTAMP", "NOW", "LOCALTIME", "LOCALTIMESTAMP":
+			if tp.Decimal > 0 {
+				return fmt.Sprintf("CURRENT_TIMESTAMP(%d)", tp.Decimal)
+			}
+			return "CURRENT_TIMESTAMP"
+		}
+		return name + "()"
+	}
+
+	datum := expr.GetDatum()
+	switch datum.Kind() {
+	case types.KindNull:
+		return "" // DEFAULT NULL is the same as no default value
+	case types.KindInt64, types.KindUint64, types.KindFloat32, types.KindFloat64, types.KindMysqlDecimal:
+		return fmt.Sprintf("%v", datum.GetValue())
+	}
+	return quoteMysqlString(fmt.Sprintf("%v", datum.GetValue()))
+}
+
+var intDisplayWidthRegexp = regexp.MustCompile(`^(tinyint|smallint|mediumint|int|bigint)\(\d+\)`)
+
+// the display width of the integer types is deprecated and not shown since mysql 8.0, except tinyint(1)
+func normalizeColumnType(typ string) string {
+	typ = strings.ToLower(strings.TrimSpace(typ))
+	if strings.HasPrefix(typ, "tinyint(1)") {
+		return typ
+	}
+	return intDisplayWidthRegexp.ReplaceAllString(typ, "$1")
+}
+
+// the numbers are compared by value, the string '0' and the number 0 are the same default value
+func normalizeDefault(value string) string {
+	isString := len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\''
+	if isString {
+		value = strings.ReplaceAll(value[1:len(value)-1], "''", "'")
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	if isString {
+		return value
+	}
+	return strings.TrimSuffix(strings.ToUpper(value), "()")
+}
+
+func quoteName(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// the backslash is an escape character in the string literals of mysql
+func quoteMysqlString(s string) string {
+	return "'" + strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), "'", "''") + "'"
+}
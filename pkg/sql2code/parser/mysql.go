@@ -22,7 +22,7 @@ func GetTableInfo(dsn, tableName string) (string, error) {
 
 	defer rows.Close() //nolint
 	if !rows.Next() {
-		return "", fmt.Errorf("not found found table '%s'", tableName)
+		return "", fmt.Errorf("not found table '%s'", tableName)
 	}
 
 	var table string
@@ -1,5 +1,7 @@
 package parser
 
+import "strings"
+
 // NullStyle null type
 type NullStyle int
 
@@ -10,10 +12,18 @@ const (
 	NullInPointer
 )
 
+// nolint
+const (
+	DBDriverMysql      = "mysql"
+	DBDriverPostgresql = "postgresql"
+	DBDriverSqlite     = "sqlite"
+)
+
 // Option function
 type Option func(*options)
 
 type options struct {
+	DBDriver       string
 	Charset        string
 	Collation      string
 	JSONTag        bool
@@ -26,13 +36,30 @@ type options struct {
 	GormType       bool
 	ForceTableName bool
 	IsEmbed        bool // is gorm.Model embedded
+	TableNames     []string
+	JSONType       string            // go type of the JSON columns, string(default) or datatypes
+	JSONStructs    map[string]string // named struct types of the JSON columns, the key is column or table.column
+	TemplateDir    string            // directory of the user-supplied templates
+	KeepIndexes    bool              // the indexes that are not in the target are not dropped when diffing
+
+	tmpls *tmplSet // the templates parsed from TemplateDir
 }
 
 var defaultOptions = options{
+	DBDriver:  DBDriverMysql,
 	NullStyle: NullInSql,
 	Package:   "model",
 }
 
+// WithDBDriver set db driver, mysql(default), postgresql or sqlite
+func WithDBDriver(driver string) Option {
+	return func(o *options) {
+		if driver != "" {
+			o.DBDriver = driver
+		}
+	}
+}
+
 // WithCharset  set charset
 func WithCharset(charset string) Option {
 	return func(o *options) {
@@ -111,6 +138,67 @@ func WithEmbed() Option {
 	}
 }
 
+// WithTableNames only generate codes for the specified tables, the other tables in the sql
+// are still used to resolve the associations
+func WithTableNames(names ...string) Option {
+	return func(o *options) {
+		for _, name := range names {
+			if name = strings.TrimSpace(name); name != "" {
+				o.TableNames = append(o.TableNames, name)
+			}
+		}
+	}
+}
+
+// WithJSONType set the go type of the JSON columns, "datatypes" is datatypes.JSON, default is string
+func WithJSONType(typ string) Option {
+	return func(o *options) {
+		o.JSONType = typ
+	}
+}
+
+// WithJSONStruct set the named struct type of the JSON column, the value of the column is converted
+// by the json serializer of GORM, the column can be qualified by the table name, e.g. users.profile
+func WithJSONStruct(column string, typeName string) Option {
+	return func(o *options) {
+		if column == "" || typeName == "" {
+			return
+		}
+		if o.JSONStructs == nil {
+			o.JSONStructs = make(map[string]string)
+		}
+		o.JSONStructs[column] = typeName
+	}
+}
+
+// WithTemplateDir set the directory of the user-supplied templates, the file <name>.tmpl in the directory
+// overrides the built-in template with the same name, e.g. modelStruct.tmpl
+func WithTemplateDir(dir string) Option {
+	return func(o *options) {
+		o.TemplateDir = dir
+	}
+}
+
+// WithKeepIndexes the indexes of the current database that are not in the target are not dropped by DiffSQL,
+// used when the target is converted from the models which may not declare all the indexes
+func WithKeepIndexes() Option {
+	return func(o *options) {
+		o.KeepIndexes = true
+	}
+}
+
+func (o options) isGenerateTable(name string) bool {
+	if len(o.TableNames) == 0 {
+		return true
+	}
+	for _, n := range o.TableNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
 func parseOption(options []Option) options {
 	o := defaultOptions
 	for _, f := range options {
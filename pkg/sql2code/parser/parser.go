@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"go/format"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -32,6 +33,26 @@ const (
 	CodeTypeProto = "proto"
 	// CodeTypeService grpc service code
 	CodeTypeService = "service"
+	// CodeTypeDAOIndexInterface index methods declaration code of the dao interface
+	CodeTypeDAOIndexInterface = "daoIndexInterface"
+	// CodeTypeDAOIndex index methods code of the dao
+	CodeTypeDAOIndex = "daoIndex"
+	// CodeTypeDAOIndexTest index methods test code of the dao
+	CodeTypeDAOIndexTest = "daoIndexTest"
+	// CodeTypeHandlerIndexInterface index methods declaration code of the handler interface
+	CodeTypeHandlerIndexInterface = "handlerIndexInterface"
+	// CodeTypeHandlerIndex index methods code of the handler
+	CodeTypeHandlerIndex = "handlerIndex"
+	// CodeTypeRouterIndex index routes code
+	CodeTypeRouterIndex = "routerIndex"
+	// CodeTypeRouterTestIndex index methods code of the mock handler in routers test
+	CodeTypeRouterTestIndex = "routerTestIndex"
+	// CodeTypeServiceIndex index methods code of the grpc service
+	CodeTypeServiceIndex = "serviceIndex"
+	// CodeTypeServiceConvertRequest code to convert the protobuf enums and structs of the request to the types of model
+	CodeTypeServiceConvertRequest = "serviceConvertRequest"
+	// CodeTypeServiceConvertReply code to convert the types of model to the protobuf enums and structs of the reply
+	CodeTypeServiceConvertReply = "serviceConvertReply"
 )
 
 // Codes content
@@ -51,37 +72,61 @@ type modelCodes struct {
 
 // ParseSQL generate different usage codes based on sql
 func ParseSQL(sql string, options ...Option) (map[string]string, error) {
-	initTemplate()
 	opt := parseOption(options)
+	var err error
+	opt.tmpls, err = getTemplates(opt.TemplateDir)
+	if err != nil {
+		return nil, err
+	}
 
-	stmts, err := parser.New().Parse(sql, opt.Charset, opt.Collation)
+	var tables []*tableInfo
+	switch opt.DBDriver {
+	case DBDriverPostgresql:
+		tables, err = parsePostgresqlSQL(sql, opt)
+	case DBDriverSqlite:
+		tables, err = parseSqliteSQL(sql, opt)
+	default:
+		tables, err = parseMysqlSQL(sql, opt)
+	}
 	if err != nil {
 		return nil, err
 	}
-	modelStructCodes := make([]string, 0, len(stmts))
-	updateFieldsCodes := make([]string, 0, len(stmts))
-	handlerStructCodes := make([]string, 0, len(stmts))
-	protoFileCodes := make([]string, 0, len(stmts))
-	serviceStructCodes := make([]string, 0, len(stmts))
-	modelJSONCodes := make([]string, 0, len(stmts))
+	setAssociations(tables, opt)
+
+	codes := make([]*codeText, 0, len(tables))
+	for _, table := range tables {
+		if !opt.isGenerateTable(table.data.RawTableName) {
+			continue
+		}
+		code, err := makeCode(table, opt)
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+	}
+
+	modelStructCodes := make([]string, 0, len(codes))
+	updateFieldsCodes := make([]string, 0, len(codes))
+	handlerStructCodes := make([]string, 0, len(codes))
+	protoFileCodes := make([]string, 0, len(codes))
+	serviceStructCodes := make([]string, 0, len(codes))
+	modelJSONCodes := make([]string, 0, len(codes))
+	indexCodes := make(map[string][]string)
 	importPath := make(map[string]struct{})
-	tableNames := make([]string, 0, len(stmts))
-	for _, stmt := range stmts {
-		if ct, ok := stmt.(*ast.CreateTableStmt); ok {
-			code, err := makeCode(ct, opt) //nolint
-			if err != nil {
-				return nil, err
-			}
-			modelStructCodes = append(modelStructCodes, code.modelStruct)
-			updateFieldsCodes = append(updateFieldsCodes, code.updateFields)
-			handlerStructCodes = append(handlerStructCodes, code.handlerStruct)
-			protoFileCodes = append(protoFileCodes, code.protoFile)
-			serviceStructCodes = append(serviceStructCodes, code.serviceStruct)
-			modelJSONCodes = append(modelJSONCodes, code.modelJSON)
-			tableNames = append(tableNames, toCamel(ct.Table.Name.String()))
-			for _, s := range code.importPaths {
-				importPath[s] = struct{}{}
-			}
+	tableNames := make([]string, 0, len(codes))
+	for _, code := range codes {
+		modelStructCodes = append(modelStructCodes, code.modelStruct)
+		updateFieldsCodes = append(updateFieldsCodes, code.updateFields)
+		handlerStructCodes = append(handlerStructCodes, code.handlerStruct)
+		protoFileCodes = append(protoFileCodes, code.protoFile)
+		serviceStructCodes = append(serviceStructCodes, code.serviceStruct)
+		modelJSONCodes = append(modelJSONCodes, code.modelJSON)
+		for codeType, indexCode := range code.indexCodes {
+			indexCodes[codeType] = append(indexCodes[codeType], indexCode)
+		}
+		tableNames = append(tableNames, code.tableName)
+		for _, s := range code.importPaths {
+			importPath[s] = struct{}{}
 		}
 	}
 
@@ -96,7 +141,7 @@ func ParseSQL(sql string, options ...Option) (map[string]string, error) {
 		ImportPath: importPathArr,
 		StructCode: modelStructCodes,
 	}
-	modelCode, err := getModelCode(mc)
+	modelCode, err := getModelCode(opt.tmpls, mc)
 	if err != nil {
 		return nil, err
 	}
@@ -110,10 +155,46 @@ func ParseSQL(sql string, options ...Option) (map[string]string, error) {
 		CodeTypeService: strings.Join(serviceStructCodes, "\n\n"),
 		TableName:       strings.Join(tableNames, ", "),
 	}
+	// the index codes only exist when the tables have indexes
+	for _, codeType := range indexCodeTypes {
+		if code := strings.Join(indexCodes[codeType], ""); code != "" {
+			codesMap[codeType] = strings.Join(indexCodes[codeType], "\n\n")
+		}
+	}
 
 	return codesMap, nil
 }
 
+func parseMysqlSQL(sql string, opt options) ([]*tableInfo, error) {
+	stmts, err := parser.New().Parse(sql, opt.Charset, opt.Collation)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make([]*tableInfo, 0, len(stmts))
+	for _, stmt := range stmts {
+		if ct, ok := stmt.(*ast.CreateTableStmt); ok {
+			tables = append(tables, makeMysqlTableInfo(ct, opt))
+		}
+	}
+
+	// the indexes created by CREATE INDEX statements
+	for _, stmt := range stmts {
+		ci, ok := stmt.(*ast.CreateIndexStmt)
+		if !ok || ci.Table == nil {
+			continue
+		}
+		for _, t := range tables {
+			if t.data.RawTableName == ci.Table.Name.String() {
+				t.indexes = append(t.indexes, getMysqlIndex(ci.IndexColNames, ci.Unique))
+				break
+			}
+		}
+	}
+
+	return tables, nil
+}
+
 type tmplData struct {
 	TableName    string
 	TName        string
@@ -121,6 +202,66 @@ type tmplData struct {
 	RawTableName string
 	Fields       []tmplField
 	Comment      string
+	Indexes      []tmplIndex
+	Enums        []tmplEnum
+	JSONStructs  []tmplJSON
+	Columns      []tmplField // the columns allowed to be queried in the list api
+}
+
+// ConflictIndex the first unique index, its columns determine whether the record exists in upsert,
+// the upsert of the tenant table is not limited by the tenant scope, so the index must include tenant_id
+func (d tmplData) ConflictIndex() *tmplIndex {
+	isTenant := d.hasColumn(columnTenantID)
+	for i := range d.Indexes {
+		if d.Indexes[i].IsUnique && (!isTenant || d.Indexes[i].hasColumn(columnTenantID)) {
+			return &d.Indexes[i]
+		}
+	}
+	return nil
+}
+
+// ConflictColumns type and value of the conflict columns variable used by upsert in the dao,
+// the columns of the conflict index and tenant_id is the first, e.g. = []string{"tenant_id", "email"},
+// it is empty if there is no conflict index, the upsert of the tenant table returns an error in this case
+func (d tmplData) ConflictColumns() string {
+	index := d.ConflictIndex()
+	if index == nil {
+		return "[]string"
+	}
+	columns := make([]string, 0, len(index.Fields))
+	for _, field := range index.Fields {
+		if field.ColName == columnTenantID {
+			columns = append([]string{strconv.Quote(field.ColName)}, columns...)
+			continue
+		}
+		columns = append(columns, strconv.Quote(field.ColName))
+	}
+	return "= []string{" + strings.Join(columns, ", ") + "}"
+}
+
+func (d tmplData) hasColumn(colName string) bool {
+	for _, field := range d.Fields {
+		if field.ColName == colName {
+			return true
+		}
+	}
+	return false
+}
+
+// SoftDelete whether the table has the deleted_at column, the records are soft deleted by gorm,
+// and the methods to restore and permanently delete them are generated in the dao
+func (d tmplData) SoftDelete() bool {
+	return d.hasColumn(columnDeletedAt)
+}
+
+// HasJSON whether there is a typed JSON column
+func (d tmplData) HasJSON() bool {
+	for _, field := range d.Fields {
+		if field.JSON != nil {
+			return true
+		}
+	}
+	return false
 }
 
 type tmplField struct {
@@ -129,11 +270,36 @@ type tmplField struct {
 	GoType  string
 	Tag     string
 	Comment string
+	Enum    *tmplEnum // the named type of ENUM or SET column
+	JSON    *tmplJSON // the typed JSON column
+}
+
+// QueryType the type used to check the values of the column in the list query,
+// the ENUM and SET columns are stored as strings
+func (t tmplField) QueryType() string {
+	if t.Enum != nil {
+		return "string"
+	}
+	if t.JSON != nil {
+		return "json"
+	}
+	return strings.TrimPrefix(t.GoType, "*")
+}
+
+// the type used to determine the zero value, the named types of ENUM and SET use the underlying type
+func (t tmplField) zeroType() string {
+	if t.Enum != nil {
+		return t.Enum.BaseType()
+	}
+	if t.JSON != nil {
+		return "[]byte"
+	}
+	return t.GoType
 }
 
 // ConditionZero type of condition 0
 func (t tmplField) ConditionZero() string {
-	switch t.GoType {
+	switch t.zeroType() {
 	case "int8", "int16", "int32", "int64", "int", "uint8", "uint16", "uint32", "uint64", "uint", "float64", "float32", //nolint
 		"sql.NullInt32", "sql.NullInt64", "sql.NullFloat64": //nolint
 		return `!= 0`
@@ -141,6 +307,10 @@ func (t tmplField) ConditionZero() string {
 		return `!= ""`
 	case "time.Time", "*time.Time", "sql.NullTime": //nolint
 		return `.IsZero() == false`
+	case "bool": //nolint
+		return `!= false`
+	case "[]byte", "pq.StringArray", "pq.Int64Array", "pq.Float64Array", "pq.BoolArray": //nolint
+		return `!= nil`
 	}
 
 	return `!= ` + t.GoType
@@ -148,7 +318,13 @@ func (t tmplField) ConditionZero() string {
 
 // GoZero type of 0
 func (t tmplField) GoZero() string {
-	switch t.GoType {
+	if t.Enum != nil && !t.Enum.IsSet && len(t.Enum.Values) > 0 {
+		return `= "` + t.Enum.Values[0].Value + `"`
+	}
+	if t.JSON != nil {
+		return `= struct{}{}` // converted to an empty json object
+	}
+	switch t.zeroType() {
 	case "int8", "int16", "int32", "int64", "int", "uint8", "uint16", "uint32", "uint64", "uint", "float64", "float32", //nolint
 		"sql.NullInt32", "sql.NullInt64", "sql.NullFloat64": //nolint
 		return `= 0`
@@ -156,6 +332,12 @@ func (t tmplField) GoZero() string {
 		return `= "string"`
 	case "time.Time", "*time.Time", "sql.NullTime": //nolint
 		return `= "0000-01-00T00:00:00.000+08:00"`
+	case "bool": //nolint
+		return `= false`
+	case "[]byte": //nolint
+		return `= ""`
+	case "pq.StringArray", "pq.Int64Array", "pq.Float64Array", "pq.BoolArray": //nolint
+		return `= nil` // converted to an empty json array
 	}
 
 	return `= ` + t.GoType
@@ -163,6 +345,12 @@ func (t tmplField) GoZero() string {
 
 // GoTypeZero type of 0
 func (t tmplField) GoTypeZero() string {
+	if t.Enum != nil {
+		return `0` // the protobuf enum or bitset of the values
+	}
+	if t.JSON != nil {
+		return `nil`
+	}
 	switch t.GoType {
 	case "int8", "int16", "int32", "int64", "int", "uint8", "uint16", "uint32", "uint64", "uint", "float64", "float32", //nolint
 		"sql.NullInt32", "sql.NullInt64", "sql.NullFloat64": //nolint
@@ -171,11 +359,28 @@ func (t tmplField) GoTypeZero() string {
 		return `""`
 	case "time.Time", "*time.Time", "sql.NullTime": //nolint
 		return `0 /*time.Now().Second()*/`
+	case "bool": //nolint
+		return `false`
+	case "[]byte", "pq.StringArray", "pq.Int64Array", "pq.Float64Array", "pq.BoolArray": //nolint
+		return `nil`
 	}
 
 	return t.GoType
 }
 
+// ProtoFieldName field name of the protobuf message in golang, e.g. TenantId
+func (t tmplField) ProtoFieldName() string {
+	return xstrings.ToCamelCase(t.ColName)
+}
+
+// BindingRule validation rule of the request params
+func (t tmplField) BindingRule() string {
+	if t.Enum != nil {
+		return t.Enum.BindingRule()
+	}
+	return ""
+}
+
 // AddOne counter
 func (t tmplField) AddOne(i int) int {
 	return i + 1
@@ -228,7 +433,36 @@ func isIgnoreFields(colName string, falseColumn ...string) bool {
 	return ok
 }
 
+const (
+	columnVersion   = "version"
+	columnCreatedBy = "created_by"
+	columnUpdatedBy = "updated_by"
+	columnTenantID  = "tenant_id"
+)
+
+// the columns maintained by the dao and the plugins of pkg/mysql, they are neither the request params nor updated
+// by the update fields code, the version is increased by each update, the created_by and updated_by are filled
+// with the uid of the authenticated user, the tenant_id is filled with the tenant id in the context by mysql.TenantScope
+var managedColumns = map[string]struct{}{
+	columnVersion:   {},
+	columnCreatedBy: {},
+	columnUpdatedBy: {},
+	columnTenantID:  {},
+}
+
+func isManagedFields(colName string, falseColumn ...string) bool {
+	for _, v := range falseColumn {
+		if colName == v {
+			return false
+		}
+	}
+
+	_, ok := managedColumns[colName]
+	return ok
+}
+
 type codeText struct {
+	tableName     string
 	importPaths   []string
 	modelStruct   string
 	modelJSON     string
@@ -236,10 +470,11 @@ type codeText struct {
 	handlerStruct string
 	protoFile     string
 	serviceStruct string
+	indexCodes    map[string]string
 }
 
 // nolint
-func makeCode(stmt *ast.CreateTableStmt, opt options) (*codeText, error) {
+func makeMysqlTableInfo(stmt *ast.CreateTableStmt, opt options) *tableInfo {
 	importPath := make([]string, 0, 1)
 	data := tmplData{
 		TableName:    stmt.Table.Name.String(),
@@ -267,9 +502,24 @@ func makeCode(stmt *ast.CreateTableStmt, opt options) (*codeText, error) {
 	}
 
 	isPrimaryKey := make(map[string]bool)
+	isUnique := make(map[string]bool)
+	var foreignKeys []*foreignKey
+	var indexes []*tableIndex
 	for _, con := range stmt.Constraints {
-		if con.Tp == ast.ConstraintPrimaryKey {
+		switch con.Tp {
+		case ast.ConstraintPrimaryKey:
 			isPrimaryKey[con.Keys[0].Column.String()] = true
+		case ast.ConstraintUniq, ast.ConstraintUniqKey, ast.ConstraintUniqIndex:
+			if len(con.Keys) == 1 {
+				isUnique[con.Keys[0].Column.Name.String()] = true
+			}
+			indexes = append(indexes, getMysqlIndex(con.Keys, true))
+		case ast.ConstraintKey, ast.ConstraintIndex:
+			indexes = append(indexes, getMysqlIndex(con.Keys, false))
+		case ast.ConstraintForeignKey:
+			if fk := getMysqlForeignKey(con.Keys, con.Refer); fk != nil {
+				foreignKeys = append(foreignKeys, fk)
+			}
 		}
 	}
 
@@ -298,6 +548,12 @@ func makeCode(stmt *ast.CreateTableStmt, opt options) (*codeText, error) {
 		if isPrimaryKey[colName] {
 			gormTag.WriteString(";primary_key")
 		}
+		if col.Tp.Tp == mysql.TypeJSON {
+			field.JSON = opt.getJSONType(data.RawTableName, colName)
+			if field.JSON != nil && field.JSON.IsStruct() {
+				gormTag.WriteString(";serializer:json")
+			}
+		}
 		isNotNull := false
 		canNull := false
 		for _, o := range col.Options {
@@ -318,6 +574,12 @@ func makeCode(stmt *ast.CreateTableStmt, opt options) (*codeText, error) {
 				}
 			case ast.ColumnOptionUniqKey:
 				gormTag.WriteString(";unique")
+				isUnique[colName] = true
+				indexes = append(indexes, &tableIndex{Columns: []string{colName}, IsUnique: true})
+			case ast.ColumnOptionReference:
+				if fk := getMysqlForeignKey([]*ast.IndexColName{{Column: col.Name}}, o.Refer); fk != nil {
+					foreignKeys = append(foreignKeys, fk)
+				}
 			case ast.ColumnOptionNull:
 				//gormTag.WriteString(";NULL")
 				canNull = true
@@ -348,46 +610,104 @@ func makeCode(stmt *ast.CreateTableStmt, opt options) (*codeText, error) {
 		if !canNull {
 			nullStyle = NullDisable
 		}
-		goType, pkg := mysqlToGoType(col.Tp, nullStyle)
-		if pkg != "" {
-			importPath = append(importPath, pkg)
+		switch {
+		case col.Tp.Tp == mysql.TypeEnum || col.Tp.Tp == mysql.TypeSet:
+			field.Enum = newTmplEnum(data.TableName+field.Name, col.Tp.Elems, col.Tp.Tp == mysql.TypeSet)
+			field.GoType = field.Enum.TypeName
+		case field.JSON != nil:
+			field.GoType = field.JSON.GoType()
+			if !field.JSON.IsStruct() {
+				importPath = append(importPath, jsonDatatypesPath)
+			}
+		default:
+			goType, pkg := mysqlToGoType(col.Tp, nullStyle)
+			if pkg != "" {
+				importPath = append(importPath, pkg)
+			}
+			field.GoType = goType
 		}
-		field.GoType = goType
 
 		data.Fields = append(data.Fields, field)
 	}
 
-	updateFieldsCode, err := getUpdateFieldsCode(data, opt.IsEmbed)
+	return &tableInfo{
+		data:          data,
+		importPaths:   importPath,
+		foreignKeys:   foreignKeys,
+		uniqueColumns: isUnique,
+		indexes:       indexes,
+	}
+}
+
+func getMysqlIndex(keys []*ast.IndexColName, isUnique bool) *tableIndex {
+	index := &tableIndex{IsUnique: isUnique}
+	for _, key := range keys {
+		index.Columns = append(index.Columns, key.Column.Name.String())
+	}
+	return index
+}
+
+func getMysqlForeignKey(keys []*ast.IndexColName, refer *ast.ReferenceDef) *foreignKey {
+	// composite foreign keys are not supported by the generated associations
+	if refer == nil || refer.Table == nil || len(keys) != 1 || len(refer.IndexColNames) > 1 {
+		return nil
+	}
+	fk := &foreignKey{
+		Column:    keys[0].Column.Name.String(),
+		RefTable:  refer.Table.Name.String(),
+		RefColumn: columnID,
+	}
+	if len(refer.IndexColNames) == 1 {
+		fk.RefColumn = refer.IndexColNames[0].Column.Name.String()
+	}
+	return fk
+}
+
+func makeCode(table *tableInfo, opt options) (*codeText, error) {
+	data := table.data
+	data.Indexes = makeTmplIndexes(table)
+	data.Enums = getTmplEnums(data.Fields)
+	data.JSONStructs = getTmplJSONStructs(data.Fields)
+	updateFieldsCode, err := getUpdateFieldsCode(opt.tmpls, data, opt.IsEmbed)
+	if err != nil {
+		return nil, err
+	}
+
+	handlerStructCode, err := getHandlerStructCodes(opt.tmpls, data)
 	if err != nil {
 		return nil, err
 	}
 
-	handlerStructCode, err := getHandlerStructCodes(data)
+	// the association fields only exist in the model struct
+	modelData := data
+	modelData.Fields = append(append(make([]tmplField, 0, len(data.Fields)+len(table.associations)), data.Fields...), table.associations...)
+	modelStructCode, importPaths, err := getModelStructCode(opt.tmpls, modelData, table.importPaths, opt.IsEmbed)
 	if err != nil {
 		return nil, err
 	}
 
-	modelStructCode, importPaths, err := getModelStructCode(data, importPath, opt.IsEmbed)
+	modelJSONCode, err := getModelJSONCode(opt.tmpls, data)
 	if err != nil {
 		return nil, err
 	}
 
-	modelJSONCode, err := getModelJSONCode(data)
+	protoFileCode, err := getProtoFileCode(opt.tmpls, data)
 	if err != nil {
 		return nil, err
 	}
 
-	protoFileCode, err := getProtoFileCode(data)
+	serviceStructCode, err := getServiceStructCode(opt.tmpls, data)
 	if err != nil {
 		return nil, err
 	}
 
-	serviceStructCode, err := getServiceStructCode(data)
+	indexCodes, err := getIndexCodes(opt.tmpls, data)
 	if err != nil {
 		return nil, err
 	}
 
 	return &codeText{
+		tableName:     toCamel(data.RawTableName),
 		importPaths:   importPaths,
 		modelStruct:   modelStructCode,
 		modelJSON:     modelJSONCode,
@@ -395,10 +715,13 @@ func makeCode(stmt *ast.CreateTableStmt, opt options) (*codeText, error) {
 		handlerStruct: handlerStructCode,
 		protoFile:     protoFileCode,
 		serviceStruct: serviceStructCode,
+		indexCodes:    indexCodes,
 	}, nil
 }
 
-func getModelStructCode(data tmplData, importPaths []string, isEmbed bool) (string, []string, error) {
+func getModelStructCode(tmpls *tmplSet, data tmplData, importPaths []string, isEmbed bool) (string, []string, error) {
+	data.Columns = getQueryColumns(data.Fields)
+
 	// filter to ignore field fields
 	var newFields = []tmplField{}
 	var newImportPaths = []string{}
@@ -436,19 +759,61 @@ func getModelStructCode(data tmplData, importPaths []string, isEmbed bool) (stri
 		}
 		newImportPaths = append(newImportPaths, "github.com/zhufuyi/sponge/pkg/mysql")
 	} else {
+		isHaveTimeType, isHaveSQLType, isSoftDelete := false, false, false
 		for i, field := range data.Fields {
+			if field.ColName == columnDeletedAt {
+				// the records are soft deleted by gorm
+				data.Fields[i].GoType = "gorm.DeletedAt"
+				isSoftDelete = true
+				continue
+			}
 			if strings.Contains(field.GoType, "time.Time") {
 				data.Fields[i].GoType = "*time.Time"
+				isHaveTimeType = true
+			}
+			if strings.HasPrefix(field.GoType, "sql.") {
+				isHaveSQLType = true
 			}
 		}
-		newImportPaths = importPaths
+		// the package of deleted_at may be no longer used
+		for _, path := range importPaths {
+			if (path == "time" && !isHaveTimeType) || (path == "database/sql" && !isHaveSQLType) {
+				continue
+			}
+			newImportPaths = append(newImportPaths, path)
+		}
+		if isSoftDelete {
+			newImportPaths = append(newImportPaths, "gorm.io/gorm")
+		}
 	}
 
 	builder := strings.Builder{}
-	err := modelStructTmpl.Execute(&builder, data)
+	err := tmpls.modelStructTmpl.Execute(&builder, data)
 	if err != nil {
 		return "", nil, fmt.Errorf("modelStructTmpl.Execute error: %v", err)
 	}
+	// the named types of ENUM and SET columns
+	if len(data.Enums) > 0 {
+		err = tmpls.modelEnumTmpl.Execute(&builder, data)
+		if err != nil {
+			return "", nil, fmt.Errorf("modelEnumTmpl.Execute error: %v", err)
+		}
+		newImportPaths = append(newImportPaths, "database/sql/driver", "fmt")
+		for _, enum := range data.Enums {
+			if enum.IsSet {
+				newImportPaths = append(newImportPaths, "strings")
+				break
+			}
+		}
+	}
+	// the named struct types of JSON columns
+	if len(data.JSONStructs) > 0 {
+		err = tmpls.modelJSONStructTmpl.Execute(&builder, data)
+		if err != nil {
+			return "", nil, fmt.Errorf("modelJSONStructTmpl.Execute error: %v", err)
+		}
+		newImportPaths = append(newImportPaths, "database/sql/driver", "encoding/json")
+	}
 	code, err := format.Source([]byte(builder.String()))
 	if err != nil {
 		return "", nil, fmt.Errorf("modelStructTmpl format.Source error: %v", err)
@@ -463,9 +828,33 @@ func getModelStructCode(data tmplData, importPaths []string, isEmbed bool) (stri
 	return structCode, newImportPaths, nil
 }
 
-func getModelCode(data modelCodes) (string, error) {
+// the names of the sensitive columns that are not allowed to be queried
+var sensitiveColumnNames = []string{"password", "passwd", "secret", "token", "salt"}
+
+// the columns allowed to be filtered and sorted in the list query, the association fields and sensitive columns are excluded
+func getQueryColumns(fields []tmplField) []tmplField {
+	var columns []tmplField
+	for _, field := range fields {
+		if field.ColName == "" {
+			continue
+		}
+		isSensitive := false
+		for _, name := range sensitiveColumnNames {
+			if strings.Contains(strings.ToLower(field.ColName), name) {
+				isSensitive = true
+				break
+			}
+		}
+		if !isSensitive {
+			columns = append(columns, field)
+		}
+	}
+	return columns
+}
+
+func getModelCode(tmpls *tmplSet, data modelCodes) (string, error) {
 	builder := strings.Builder{}
-	err := modelTmpl.Execute(&builder, data)
+	err := tmpls.modelTmpl.Execute(&builder, data)
 	if err != nil {
 		return "", err
 	}
@@ -478,12 +867,12 @@ func getModelCode(data modelCodes) (string, error) {
 	return string(code), nil
 }
 
-func getUpdateFieldsCode(data tmplData, isEmbed bool) (string, error) {
+func getUpdateFieldsCode(tmpls *tmplSet, data tmplData, isEmbed bool) (string, error) {
 	// filter fields
 	var newFields = []tmplField{}
 	for _, field := range data.Fields {
 		falseColumns := []string{}
-		if isIgnoreFields(field.ColName, falseColumns...) {
+		if isIgnoreFields(field.ColName, falseColumns...) || isManagedFields(field.ColName) {
 			continue
 		}
 		newFields = append(newFields, field)
@@ -491,7 +880,7 @@ func getUpdateFieldsCode(data tmplData, isEmbed bool) (string, error) {
 	data.Fields = newFields
 
 	builder := strings.Builder{}
-	err := updateFieldTmpl.Execute(&builder, data)
+	err := tmpls.updateFieldTmpl.Execute(&builder, data)
 	if err != nil {
 		return "", err
 	}
@@ -504,30 +893,45 @@ func getUpdateFieldsCode(data tmplData, isEmbed bool) (string, error) {
 	return string(code), nil
 }
 
-func getHandlerStructCodes(data tmplData) (string, error) {
-	postStructCode, err := tmplExecuteWithFilter(data, handlerCreateStructTmpl)
+func getHandlerStructCodes(tmpls *tmplSet, data tmplData) (string, error) {
+	data.Fields = getHandlerFields(data.Fields)
+
+	postStructCode, err := tmplExecuteWithFilter(data, tmpls.handlerCreateStructTmpl)
 	if err != nil {
 		return "", fmt.Errorf("handlerCreateStructTmpl error: %v", err)
 	}
 
-	putStructCode, err := tmplExecuteWithFilter(data, handlerUpdateStructTmpl, columnID)
+	putStructCode, err := tmplExecuteWithFilter(data, tmpls.handlerUpdateStructTmpl, columnID, columnVersion)
 	if err != nil {
 		return "", fmt.Errorf("handlerUpdateStructTmpl error: %v", err)
 	}
 
-	getStructCode, err := tmplExecuteWithFilter(data, handlerDetailStructTmpl, columnID, columnCreatedAt, columnUpdatedAt)
+	getStructCode, err := tmplExecuteWithFilter(data, tmpls.handlerDetailStructTmpl, columnID, columnCreatedAt, columnUpdatedAt,
+		columnVersion, columnCreatedBy, columnUpdatedBy)
 	if err != nil {
 		return "", fmt.Errorf("handlerDetailStructTmpl error: %v", err)
 	}
 
-	return postStructCode + putStructCode + getStructCode, nil
+	indexStructCode, err := tmplExecuteWithFilter(data, tmpls.handlerIndexStructTmpl)
+	if err != nil {
+		return "", fmt.Errorf("handlerIndexStructTmpl error: %v", err)
+	}
+
+	code := postStructCode + putStructCode + getStructCode + indexStructCode
+	// postgresql array types are converted to slices of go types
+	code = strings.ReplaceAll(code, "pq.StringArray", "[]string")
+	code = strings.ReplaceAll(code, "pq.Int64Array", "[]int64")
+	code = strings.ReplaceAll(code, "pq.Float64Array", "[]float64")
+	code = strings.ReplaceAll(code, "pq.BoolArray", "[]bool")
+
+	return code, nil
 }
 
 // customised filter fields
 func tmplExecuteWithFilter(data tmplData, tmpl *template.Template, reservedColumns ...string) (string, error) {
 	var newFields = []tmplField{}
 	for _, field := range data.Fields {
-		if isIgnoreFields(field.ColName, reservedColumns...) {
+		if isIgnoreFields(field.ColName, reservedColumns...) || isManagedFields(field.ColName, reservedColumns...) {
 			continue
 		}
 		newFields = append(newFields, field)
@@ -542,9 +946,9 @@ func tmplExecuteWithFilter(data tmplData, tmpl *template.Template, reservedColum
 	return builder.String(), nil
 }
 
-func getModelJSONCode(data tmplData) (string, error) {
+func getModelJSONCode(tmpls *tmplSet, data tmplData) (string, error) {
 	builder := strings.Builder{}
-	err := modelJSONTmpl.Execute(&builder, data)
+	err := tmpls.modelJSONTmpl.Execute(&builder, data)
 	if err != nil {
 		return "", err
 	}
@@ -555,32 +959,35 @@ func getModelJSONCode(data tmplData) (string, error) {
 	}
 
 	modelJSONCode := strings.ReplaceAll(string(code), " =", ":")
+	modelJSONCode = strings.ReplaceAll(modelJSONCode, ": nil\n", ": []\n")
+	modelJSONCode = strings.ReplaceAll(modelJSONCode, ": struct{}{}\n", ": {}\n")
 	modelJSONCode = addCommaToJSON(modelJSONCode)
 
 	return modelJSONCode, nil
 }
 
-func getProtoFileCode(data tmplData) (string, error) {
+func getProtoFileCode(tmpls *tmplSet, data tmplData) (string, error) {
 	data.Fields = goTypeToProto(data.Fields)
 
 	builder := strings.Builder{}
-	err := protoFileTmpl.Execute(&builder, data)
+	err := tmpls.protoFileTmpl.Execute(&builder, data)
 	if err != nil {
 		return "", err
 	}
 	code := builder.String()
 
-	protoMessageCreateCode, err := tmplExecuteWithFilter(data, protoMessageCreateTmpl)
+	protoMessageCreateCode, err := tmplExecuteWithFilter(data, tmpls.protoMessageCreateTmpl)
 	if err != nil {
 		return "", fmt.Errorf("handlerCreateStructTmpl error: %v", err)
 	}
 
-	protoMessageUpdateCode, err := tmplExecuteWithFilter(data, protoMessageUpdateTmpl, columnID)
+	protoMessageUpdateCode, err := tmplExecuteWithFilter(data, tmpls.protoMessageUpdateTmpl, columnID, columnVersion)
 	if err != nil {
 		return "", fmt.Errorf("handlerCreateStructTmpl error: %v", err)
 	}
 
-	protoMessageDetailCode, err := tmplExecuteWithFilter(data, protoMessageDetailTmpl, columnID, columnCreatedAt, columnUpdatedAt)
+	protoMessageDetailCode, err := tmplExecuteWithFilter(data, tmpls.protoMessageDetailTmpl, columnID, columnCreatedAt, columnUpdatedAt,
+		columnVersion, columnCreatedBy, columnUpdatedBy)
 	if err != nil {
 		return "", fmt.Errorf("handlerCreateStructTmpl error: %v", err)
 	}
@@ -594,21 +1001,21 @@ func getProtoFileCode(data tmplData) (string, error) {
 	return code, nil
 }
 
-func getServiceStructCode(data tmplData) (string, error) {
+func getServiceStructCode(tmpls *tmplSet, data tmplData) (string, error) {
 	builder := strings.Builder{}
-	err := serviceStructTmpl.Execute(&builder, data)
+	err := tmpls.serviceStructTmpl.Execute(&builder, data)
 	if err != nil {
 		return "", err
 	}
 	code := builder.String()
 
-	serviceCreateStructCode, err := tmplExecuteWithFilter(data, serviceCreateStructTmpl)
+	serviceCreateStructCode, err := tmplExecuteWithFilter(data, tmpls.serviceCreateStructTmpl)
 	if err != nil {
 		return "", fmt.Errorf("handlerCreateStructTmpl error: %v", err)
 	}
 	serviceCreateStructCode = strings.ReplaceAll(serviceCreateStructCode, "ID:", "Id:")
 
-	serviceUpdateStructCode, err := tmplExecuteWithFilter(data, serviceUpdateStructTmpl, columnID)
+	serviceUpdateStructCode, err := tmplExecuteWithFilter(data, tmpls.serviceUpdateStructTmpl, columnID, columnVersion)
 	if err != nil {
 		return "", fmt.Errorf("handlerCreateStructTmpl error: %v", err)
 	}
@@ -620,6 +1027,46 @@ func getServiceStructCode(data tmplData) (string, error) {
 	return code, nil
 }
 
+var indexCodeTypes = []string{
+	CodeTypeDAOIndexInterface,
+	CodeTypeDAOIndex,
+	CodeTypeDAOIndexTest,
+	CodeTypeHandlerIndexInterface,
+	CodeTypeHandlerIndex,
+	CodeTypeRouterIndex,
+	CodeTypeRouterTestIndex,
+	CodeTypeServiceIndex,
+	CodeTypeServiceConvertRequest,
+	CodeTypeServiceConvertReply,
+}
+
+func getIndexCodes(tmpls *tmplSet, data tmplData) (map[string]string, error) {
+	indexTmpls := map[string]*template.Template{
+		CodeTypeDAOIndexInterface:     tmpls.daoIndexInterfaceTmpl,
+		CodeTypeDAOIndex:              tmpls.daoIndexTmpl,
+		CodeTypeDAOIndexTest:          tmpls.daoIndexTestTmpl,
+		CodeTypeHandlerIndexInterface: tmpls.handlerIndexInterfaceTmpl,
+		CodeTypeHandlerIndex:          tmpls.handlerIndexTmpl,
+		CodeTypeRouterIndex:           tmpls.routerIndexTmpl,
+		CodeTypeRouterTestIndex:       tmpls.routerTestIndexTmpl,
+		CodeTypeServiceIndex:          tmpls.serviceIndexTmpl,
+		CodeTypeServiceConvertRequest: tmpls.serviceConvertRequestTmpl,
+		CodeTypeServiceConvertReply:   tmpls.serviceConvertReplyTmpl,
+	}
+
+	codes := make(map[string]string, len(indexTmpls))
+	for codeType, tmpl := range indexTmpls {
+		builder := strings.Builder{}
+		err := tmpl.Execute(&builder, data)
+		if err != nil {
+			return nil, fmt.Errorf("%s tmpl.Execute error: %v", codeType, err)
+		}
+		codes[codeType] = strings.TrimSpace(builder.String()) // the indentation of the code is provided by the mark
+	}
+
+	return codes, nil
+}
+
 func addCommaToJSON(modelJSONCode string) string {
 	r := strings.NewReader(modelJSONCode)
 	buf := bufio.NewReader(r)
@@ -715,6 +1162,20 @@ func mysqlToGoType(colTp *types.FieldType, style NullStyle) (name string, path s
 func goTypeToProto(fields []tmplField) []tmplField {
 	var newFields []tmplField
 	for _, field := range fields {
+		if field.Enum != nil {
+			if field.Enum.IsSet {
+				field.GoType = "uint64"
+			} else {
+				field.GoType = field.Enum.TypeName
+			}
+			newFields = append(newFields, field)
+			continue
+		}
+		if field.JSON != nil {
+			field.GoType = "google.protobuf.Struct"
+			newFields = append(newFields, field)
+			continue
+		}
 		switch field.GoType {
 		case "int":
 			field.GoType = "int32"
@@ -726,6 +1187,16 @@ func goTypeToProto(fields []tmplField) []tmplField {
 			field.GoType = "float"
 		case "float64":
 			field.GoType = "double"
+		case "[]byte":
+			field.GoType = "bytes"
+		case "pq.StringArray":
+			field.GoType = "repeated string"
+		case "pq.Int64Array":
+			field.GoType = "repeated int64"
+		case "pq.Float64Array":
+			field.GoType = "repeated double"
+		case "pq.BoolArray":
+			field.GoType = "repeated bool"
 		}
 		newFields = append(newFields, field)
 	}
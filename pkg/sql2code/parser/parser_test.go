@@ -1,9 +1,14 @@
 package parser
 
 import (
+	"database/sql"
 	"fmt"
 	"github.com/blastrain/vitess-sqlparser/tidbparser/dependency/mysql"
 	"github.com/blastrain/vitess-sqlparser/tidbparser/dependency/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -34,6 +39,16 @@ func TestParseSql(t *testing.T) {
 		assert.NotEmpty(t, k)
 		assert.NotEmpty(t, v)
 	}
+	assert.Contains(t, codes[CodeTypeModel], "var PersonInfoColumns = map[string]string{")
+	assert.Contains(t, codes[CodeTypeModel], `"created_at": "time.Time",`)
+	assert.Contains(t, codes[CodeTypeModel], `"age":        "sql.NullInt32",`)
+
+	// the sensitive columns are excluded
+	codes, err = ParseSQL("CREATE TABLE users (id BIGINT PRIMARY KEY, name VARCHAR(30), password VARCHAR(64), api_token VARCHAR(64));")
+	assert.Nil(t, err)
+	assert.Contains(t, codes[CodeTypeModel], `"name": "string",`)
+	assert.NotContains(t, codes[CodeTypeModel], `"password":`)
+	assert.NotContains(t, codes[CodeTypeModel], `"api_token":`)
 }
 
 var testData = [][]string{
@@ -95,6 +110,451 @@ func TestParseSQLs(t *testing.T) {
 	}
 }
 
+func TestParsePostgresqlSQL(t *testing.T) {
+	sql := `CREATE TABLE IF NOT EXISTS public.t_person_info (
+    id bigserial PRIMARY KEY,
+    uid uuid NOT NULL DEFAULT gen_random_uuid(),
+    name character varying(30) NOT NULL DEFAULT 'default_name'::character varying,
+    age integer DEFAULT -1,
+    score numeric(10,2),
+    tags text[],
+    profile jsonb,
+    is_active boolean NOT NULL DEFAULT true,
+    login_at timestamp(6) with time zone,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    seq int GENERATED BY DEFAULT AS IDENTITY (START WITH 1),
+    email varchar(100) CONSTRAINT uq_email UNIQUE CHECK (email <> '')
+);
+COMMENT ON TABLE t_person_info IS 'person''s info';
+COMMENT ON COLUMN t_person_info.name IS 'name';
+CREATE INDEX idx_name ON t_person_info (name);`
+
+	codes, err := ParseSQL(sql, WithDBDriver(DBDriverPostgresql), WithTablePrefix("t_"), WithJSONTag(0), WithGormType())
+	assert.NoError(t, err)
+	for k, v := range codes {
+		assert.NotEmpty(t, k)
+		assert.NotEmpty(t, v)
+	}
+	assert.Contains(t, codes[CodeTypeModel], "// PersonInfo person's info")
+	assert.Contains(t, codes[CodeTypeModel], "ID        int64          `gorm:\"column:id;type:bigserial;primary_key;AUTO_INCREMENT\" json:\"id\"`")
+	assert.Contains(t, codes[CodeTypeModel], "Name      string         `gorm:\"column:name;type:character varying(30);default:default_name;NOT NULL\" json:\"name\"` // name")
+	assert.Contains(t, codes[CodeTypeModel], "Tags      pq.StringArray `gorm:\"column:tags;type:text[]\" json:\"tags\"`")
+	assert.Contains(t, codes[CodeTypeModel], "Seq       int            `gorm:\"column:seq;type:int;AUTO_INCREMENT\" json:\"seq\"`")
+	assert.Contains(t, codes[CodeTypeJSON], `"tags": [],`)
+	assert.Contains(t, codes[CodeTypeHandler], "Tags  []string")
+	assert.Contains(t, codes[CodeTypeProto], "repeated string tags = ")
+
+	codes, err = ParseSQL(sql, WithDBDriver(DBDriverPostgresql), WithJSONTag(1), WithEmbed())
+	assert.NoError(t, err)
+	assert.Contains(t, codes[CodeTypeModel], "mysql.Model")
+
+	_, err = ParseSQL("CREATE TABLE foo (id int", WithDBDriver(DBDriverPostgresql))
+	assert.Error(t, err)
+}
+
+func TestParseSQLWithAssociations(t *testing.T) {
+	sql := `CREATE TABLE users (
+  id bigint unsigned NOT NULL AUTO_INCREMENT,
+  name varchar(50) NOT NULL,
+  PRIMARY KEY (id)
+);
+CREATE TABLE profiles (
+  id bigint unsigned NOT NULL AUTO_INCREMENT,
+  user_id bigint unsigned NOT NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY uk_user_id (user_id),
+  CONSTRAINT fk_profiles_user FOREIGN KEY (user_id) REFERENCES users (id)
+);
+CREATE TABLE orders (
+  id bigint unsigned NOT NULL AUTO_INCREMENT,
+  user_id bigint unsigned NOT NULL,
+  buyer_id bigint unsigned NOT NULL,
+  parent_id bigint unsigned NOT NULL,
+  PRIMARY KEY (id),
+  FOREIGN KEY (buyer_id) REFERENCES users (id),
+  FOREIGN KEY (parent_id) REFERENCES orders (id)
+);
+CREATE TABLE roles (
+  id bigint unsigned NOT NULL AUTO_INCREMENT,
+  name varchar(50) NOT NULL,
+  PRIMARY KEY (id)
+);
+CREATE TABLE user_roles (
+  id bigint unsigned NOT NULL AUTO_INCREMENT,
+  user_id bigint unsigned NOT NULL,
+  role_id bigint unsigned NOT NULL,
+  created_at datetime DEFAULT NULL,
+  PRIMARY KEY (id)
+);`
+
+	codes, err := ParseSQL(sql, WithJSONTag(0), WithEmbed())
+	assert.NoError(t, err)
+	model := codes[CodeTypeModel]
+	// has one, has many and many to many
+	assert.Contains(t, model, "Profile     *Profiles `gorm:\"foreignKey:UserID\" json:\"profile,omitempty\"`")
+	assert.Contains(t, model, "BuyerOrders []*Orders `gorm:\"foreignKey:BuyerID\" json:\"buyer_orders,omitempty\"`")
+	assert.Contains(t, model, "Orders      []*Orders `gorm:\"foreignKey:UserID\" json:\"orders,omitempty\"`")
+	assert.Contains(t, model, "Roles       []*Roles  `gorm:\"many2many:user_roles;joinForeignKey:UserID;joinReferences:RoleID\" json:\"roles,omitempty\"`")
+	assert.Contains(t, model, "Users []*Users `gorm:\"many2many:user_roles;joinForeignKey:RoleID;joinReferences:UserID\" json:\"users,omitempty\"`")
+	// belongs to
+	assert.Contains(t, model, "Buyer    *Users  `gorm:\"foreignKey:BuyerID\" json:\"buyer,omitempty\"`")
+	assert.Contains(t, model, "Parent   *Orders `gorm:\"foreignKey:ParentID\" json:\"parent,omitempty\"`")
+	assert.Contains(t, model, "User     *Users  `gorm:\"foreignKey:UserID\" json:\"user,omitempty\"`")
+	// the association fields are not in the other codes
+	assert.NotContains(t, codes[CodeTypeHandler], "omitempty")
+	assert.NotContains(t, codes[CodeTypeDAO], "BuyerOrders")
+	assert.NotContains(t, codes[CodeTypeProto], "BuyerOrders")
+
+	// only generate the specified table
+	codes, err = ParseSQL(sql, WithJSONTag(1), WithTableNames("orders"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Orders", codes[TableName])
+	assert.Contains(t, codes[CodeTypeModel], "Buyer    *Users  `gorm:\"foreignKey:BuyerID\" json:\"buyer,omitempty\"`")
+	assert.NotContains(t, codes[CodeTypeModel], "type Users struct")
+
+	pgSQL := `CREATE TABLE users (id serial PRIMARY KEY, email text UNIQUE);
+CREATE TABLE posts (
+    id serial PRIMARY KEY,
+    author_email text REFERENCES users (email) ON DELETE CASCADE,
+    title text
+);
+CREATE TABLE comments (
+    id serial PRIMARY KEY,
+    post_id int NOT NULL,
+    body text,
+    CONSTRAINT fk_comments_post FOREIGN KEY (post_id) REFERENCES posts (id)
+);`
+	codes, err = ParseSQL(pgSQL, WithDBDriver(DBDriverPostgresql), WithJSONTag(1))
+	assert.NoError(t, err)
+	model = codes[CodeTypeModel]
+	assert.Contains(t, model, "User        *Users         `gorm:\"foreignKey:AuthorEmail;references:Email\" json:\"user,omitempty\"`")
+	assert.Contains(t, model, "Posts []*Posts       `gorm:\"foreignKey:AuthorEmail;references:Email\" json:\"posts,omitempty\"`")
+	assert.Contains(t, model, "Comments    []*Comments    `gorm:\"foreignKey:PostID\" json:\"comments,omitempty\"`")
+	assert.Contains(t, model, "Post   *Posts         `gorm:\"foreignKey:PostID\" json:\"post,omitempty\"`")
+}
+
+func TestParseSQLWithIndexes(t *testing.T) {
+	sql := `CREATE TABLE users (
+  id bigint unsigned NOT NULL AUTO_INCREMENT,
+  email varchar(50) NOT NULL,
+  phone varchar(20) NOT NULL UNIQUE,
+  tenant_id bigint unsigned NOT NULL,
+  status tinyint NOT NULL,
+  nickname varchar(20) NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY uk_email (email),
+  KEY idx_tenant_status (tenant_id, status),
+  KEY idx_nickname (nickname)
+);
+CREATE UNIQUE INDEX uk_tenant_phone ON users (tenant_id, phone);`
+
+	codes, err := ParseSQL(sql)
+	assert.NoError(t, err)
+	assert.Contains(t, codes[CodeTypeDAOIndexInterface], "GetByEmail(ctx context.Context, email string) (*model.Users, error)")
+	assert.Contains(t, codes[CodeTypeDAOIndexInterface], "GetByPhone(ctx context.Context, phone string) (*model.Users, error)")
+	assert.Contains(t, codes[CodeTypeDAOIndexInterface], "GetByTenantIDAndPhone(ctx context.Context, tenantID uint64, phone string) (*model.Users, error)")
+	assert.Contains(t, codes[CodeTypeDAOIndexInterface], "ListByTenantIDAndStatus(ctx context.Context, tenantID uint64, status int) ([]*model.Users, error)")
+	assert.NotContains(t, codes[CodeTypeDAOIndexInterface], "Nickname") // nullable column
+	assert.Contains(t, codes[CodeTypeDAOIndex], `Where("tenant_id = ? AND status = ?", tenantID, status)`)
+	assert.Contains(t, codes[CodeTypeDAOIndex], `indexKey := "tenant_id:" + cast.ToString(tenantID) + ":status:" + cast.ToString(status)`)
+	assert.Contains(t, codes[CodeTypeDAOIndex], `var usersConflictColumns = []string{"tenant_id", "phone"}`) // the unique index with tenant_id
+	assert.Contains(t, codes[CodeTypeRouterIndex], `group.GET("/users/email/:email", h.GetByEmail)`)
+	assert.Contains(t, codes[CodeTypeRouterIndex], `group.GET("/userss/tenant_id/:tenant_id/status/:status", h.ListByTenantIDAndStatus)`)
+	assert.Contains(t, codes[CodeTypeHandler], "type ListUsersByTenantIDAndStatusRequest struct")
+	assert.Contains(t, codes[CodeTypeProto], `get: "/api/v1/users/email/{email}"`)
+	assert.Contains(t, codes[CodeTypeProto], "message ListUsersByTenantIDAndStatusReply {\n  repeated Users userss = 1;")
+	assert.Contains(t, codes[CodeTypeProto], "rpc UpdateByIDs(UpdateUsersByIDsRequest) returns (UpdateUsersByIDsReply)")
+	assert.Contains(t, codes[CodeTypeProto], "message CreateBatchUsersRequest {\n  repeated CreateUsersRequest userss = 1;")
+	assert.Contains(t, codes[CodeTypeServiceIndex], "s.iDao.ListByTenantIDAndStatus(ctx, req.TenantId, int(req.Status))")
+	assert.Contains(t, codes[CodeTypeDAOIndexTest], "func Test_usersDao_UpsertConflict(t *testing.T) {")
+	assert.Contains(t, codes[CodeTypeDAOIndexTest], "d.IDao.(UsersDao).GetByTenantIDAndPhone(d.Ctx, testData.TenantID, testData.Phone)")
+
+	// no indexes, only the helper methods of dao are generated
+	codes, err = ParseSQL("CREATE TABLE foo (id bigint unsigned NOT NULL AUTO_INCREMENT, name varchar(50), PRIMARY KEY (id));")
+	assert.NoError(t, err)
+	assert.Contains(t, codes[CodeTypeDAOIndex], "func (d *fooDao) deleteIndexCache(")
+	assert.Contains(t, codes[CodeTypeDAOIndex], "var fooConflictColumns []string")
+	assert.Empty(t, codes[CodeTypeDAOIndexInterface])
+	assert.Empty(t, codes[CodeTypeRouterIndex])
+	assert.Empty(t, codes[CodeTypeDAOIndexTest])
+
+	// the conflict columns of the tenant table include tenant_id and it is the first
+	codes, err = ParseSQL("CREATE TABLE bar (id bigint unsigned NOT NULL AUTO_INCREMENT, tenant_id varchar(32) NOT NULL, name varchar(50) NOT NULL, " +
+		"code varchar(50) NOT NULL, PRIMARY KEY (id), UNIQUE KEY uk_code (code), UNIQUE KEY uk_name (name, tenant_id));")
+	assert.NoError(t, err)
+	assert.Contains(t, codes[CodeTypeDAOIndex], `var barConflictColumns = []string{"tenant_id", "name"}`)
+	codes, err = ParseSQL("CREATE TABLE bar (id bigint unsigned NOT NULL AUTO_INCREMENT, tenant_id varchar(32) NOT NULL, code varchar(50) NOT NULL, " +
+		"PRIMARY KEY (id), UNIQUE KEY uk_code (code));")
+	assert.NoError(t, err)
+	assert.Contains(t, codes[CodeTypeDAOIndex], "var barConflictColumns []string")
+
+	sql = `CREATE TABLE users (
+  id bigserial PRIMARY KEY,
+  email varchar(50) NOT NULL UNIQUE,
+  tenant_id bigint NOT NULL,
+  status smallint NOT NULL,
+  name varchar(50) NOT NULL,
+  UNIQUE (tenant_id, name)
+);
+CREATE INDEX IF NOT EXISTS idx_tenant_status ON public.users USING btree (tenant_id, status DESC NULLS LAST);
+CREATE INDEX idx_lower_name ON users (lower(name));
+CREATE INDEX idx_active ON users (status) WHERE status = 1;`
+	codes, err = ParseSQL(sql, WithDBDriver(DBDriverPostgresql))
+	assert.NoError(t, err)
+	assert.Contains(t, codes[CodeTypeDAOIndexInterface], "GetByEmail(ctx context.Context, email string)")
+	assert.Contains(t, codes[CodeTypeDAOIndexInterface], "GetByTenantIDAndName(ctx context.Context, tenantID int64, name string)")
+	assert.Contains(t, codes[CodeTypeDAOIndexInterface], "ListByTenantIDAndStatus(ctx context.Context, tenantID int64, status int)")
+	assert.NotContains(t, codes[CodeTypeDAOIndexInterface], "ListByName")
+	assert.NotContains(t, codes[CodeTypeDAOIndexInterface], "ListByStatus")
+
+	// the columns of ENUM and SET
+	sql = `CREATE TABLE users (
+  id bigint unsigned NOT NULL AUTO_INCREMENT,
+  tenant_id bigint unsigned NOT NULL,
+  status enum('active','banned') NOT NULL,
+  roles set('admin','editor') NOT NULL,
+  PRIMARY KEY (id),
+  KEY idx_tenant_status (tenant_id, status),
+  KEY idx_roles (roles)
+);`
+	codes, err = ParseSQL(sql)
+	assert.NoError(t, err)
+	assert.Contains(t, codes[CodeTypeDAOIndexInterface], "ListByTenantIDAndStatus(ctx context.Context, tenantID uint64, status model.UsersStatus) ([]*model.Users, error)")
+	assert.Contains(t, codes[CodeTypeDAOIndexInterface], "ListByRoles(ctx context.Context, roles model.UsersRoles) ([]*model.Users, error)")
+	assert.Contains(t, codes[CodeTypeDAOIndex], `indexKey := "tenant_id:" + cast.ToString(tenantID) + ":status:" + cast.ToString(string(status))`)
+	assert.Contains(t, codes[CodeTypeDAOIndex], `"roles:" + cast.ToString(uint64(table.Roles)),`)
+	assert.Contains(t, codes[CodeTypeHandler], "Status  string `uri:\"status\"")
+	assert.Contains(t, codes[CodeTypeHandlerIndex], "// @Param roles path integer true \"roles\"")
+	assert.Contains(t, codes[CodeTypeHandlerIndex], "h.iDao.ListByTenantIDAndStatus(c.Request.Context(), form.TenantID, model.UsersStatus(form.Status))")
+	assert.Contains(t, codes[CodeTypeProto], "UsersStatus status = 2 [(tagger.tags) = \"uri:\\\"status\\\"\" ];")
+	assert.Contains(t, codes[CodeTypeServiceIndex], "s.iDao.ListByTenantIDAndStatus(ctx, req.TenantId, model.UsersStatusFromNumber(int32(req.Status)))")
+	assert.Contains(t, codes[CodeTypeServiceIndex], "s.iDao.ListByRoles(ctx, model.UsersRoles(req.Roles))")
+	assert.Contains(t, codes[CodeTypeDAOIndexTest], "func Test_usersDao_ListByTenantIDAndStatus(t *testing.T) {")
+	assert.Contains(t, codes[CodeTypeDAOIndexTest], "testData.Status = model.UsersStatusActive")
+	assert.Contains(t, codes[CodeTypeDAOIndexTest], `sqlmock.NewRows([]string{"id", "roles"}).AddRow(testData.ID, "admin")`)
+	assert.NotContains(t, codes[CodeTypeDAOIndexTest], "UpsertConflict")
+}
+
+func TestParseSQLWithManagedColumns(t *testing.T) {
+	sql := `CREATE TABLE articles (
+  id bigint unsigned NOT NULL AUTO_INCREMENT,
+  title varchar(100) NOT NULL,
+  version int unsigned NOT NULL DEFAULT 0,
+  created_by varchar(32) NOT NULL,
+  updated_by varchar(32) NOT NULL,
+  tenant_id varchar(32) NOT NULL,
+  created_at datetime NULL,
+  updated_at datetime NULL,
+  deleted_at datetime NULL,
+  PRIMARY KEY (id)
+);`
+	codes, err := ParseSQL(sql)
+	assert.NoError(t, err)
+	model := codes[CodeTypeModel]
+	assert.Contains(t, model, "DeletedAt gorm.DeletedAt")
+	assert.Contains(t, model, `"gorm.io/gorm"`)
+	assert.Contains(t, model, "Version   uint")
+	assert.Contains(t, model, "CreatedBy string")
+	assert.Contains(t, codes[CodeTypeDAO], "table.Title")
+	assert.NotContains(t, codes[CodeTypeDAO], "table.Version")
+	assert.NotContains(t, codes[CodeTypeDAO], "table.CreatedBy")
+	assert.NotContains(t, codes[CodeTypeDAO], "table.TenantID")
+	assert.Contains(t, codes[CodeTypeDAOIndexInterface], "Restore(ctx context.Context, ids []uint64) error")
+	assert.Contains(t, codes[CodeTypeDAOIndex], "func (d *articlesDao) HardDelete(ctx context.Context, ids []uint64) error")
+
+	// the version is required by the update request, the audit columns are only in the detail
+	handler := codes[CodeTypeHandler]
+	create := handler[strings.Index(handler, "type CreateArticlesRequest"):strings.Index(handler, "type UpdateArticlesByIDRequest")]
+	update := handler[strings.Index(handler, "type UpdateArticlesByIDRequest"):strings.Index(handler, "type GetArticlesByIDRespond")]
+	detail := handler[strings.Index(handler, "type GetArticlesByIDRespond"):]
+	assert.NotContains(t, create, "Version")
+	assert.NotContains(t, create, "CreatedBy")
+	assert.NotContains(t, create, "TenantID")
+	assert.Contains(t, update, "Version")
+	assert.NotContains(t, update, "UpdatedBy")
+	assert.NotContains(t, update, "TenantID")
+	assert.Contains(t, detail, "CreatedBy")
+	assert.Contains(t, codes[CodeTypeProto], "uint32 version = ")
+
+	// the deleted_at of the embedded model, no time package
+	codes, err = ParseSQL(sql, WithEmbed())
+	assert.NoError(t, err)
+	assert.Contains(t, codes[CodeTypeModel], "mysql.Model")
+	assert.Contains(t, codes[CodeTypeModel], "Version   uint")
+	assert.NotContains(t, codes[CodeTypeModel], `"time"`)
+
+	// no deleted_at column
+	codes, err = ParseSQL("CREATE TABLE foo (id bigint unsigned NOT NULL AUTO_INCREMENT, created_at datetime, deleted_at_ms bigint, PRIMARY KEY (id));")
+	assert.NoError(t, err)
+	assert.NotContains(t, codes[CodeTypeDAOIndex], "Restore")
+	assert.Contains(t, codes[CodeTypeModel], "*time.Time")
+}
+
+func TestParseSQLWithEnums(t *testing.T) {
+	sql := `CREATE TABLE orders (
+  id bigint unsigned NOT NULL AUTO_INCREMENT,
+  status enum('pending','in-progress','DONE') NOT NULL DEFAULT 'pending',
+  flags set('gift','urgent') NULL,
+  PRIMARY KEY (id)
+);`
+	codes, err := ParseSQL(sql)
+	assert.NoError(t, err)
+	model := codes[CodeTypeModel]
+	assert.Contains(t, model, "Status OrdersStatus")
+	assert.Contains(t, model, "Flags  OrdersFlags")
+	assert.Contains(t, model, `OrdersStatusInProgress OrdersStatus = "in-progress"`)
+	assert.Contains(t, model, `OrdersStatusDone       OrdersStatus = "DONE"`)
+	assert.Contains(t, model, "OrdersFlags = 1 << iota // gift")
+	assert.Contains(t, model, "func ParseOrdersFlags(str string) (OrdersFlags, error)")
+	assert.Contains(t, model, `"database/sql/driver"`)
+	assert.Contains(t, model, `"flags":  "string",`)
+	assert.Contains(t, codes[CodeTypeDAO], `if table.Status != "" {`)
+	assert.Contains(t, codes[CodeTypeDAO], `if table.Flags != 0 {`)
+	assert.Contains(t, codes[CodeTypeHandler], "Status  string `json:\"status\" binding:\"omitempty,oneof=pending in-progress DONE\"`")
+	assert.Contains(t, codes[CodeTypeHandler], "Flags  uint64 `json:\"flags\" binding:\"omitempty,max=3\"`")
+	assert.Contains(t, codes[CodeTypeProto], "OrdersStatus status = ")
+	assert.Contains(t, codes[CodeTypeProto], "ORDERS_STATUS_IN_PROGRESS = 2; // in-progress")
+	assert.Contains(t, codes[CodeTypeProto], "uint64 flags = ")
+	assert.Contains(t, codes[CodeTypeServiceConvertRequest], "orders.Status = model.OrdersStatusFromNumber(int32(req.Status))")
+	assert.Contains(t, codes[CodeTypeServiceConvertReply], "value.Status = serverNameExampleV1.OrdersStatus(record.Status.Number())")
+
+	sql = `CREATE TYPE public.mood AS ENUM ('sad', 'ok', 'happy');
+CREATE TABLE person (
+  id serial PRIMARY KEY,
+  current_mood mood NOT NULL,
+  moods mood[]
+);`
+	codes, err = ParseSQL(sql, WithDBDriver(DBDriverPostgresql))
+	assert.NoError(t, err)
+	assert.Contains(t, codes[CodeTypeModel], "CurrentMood PersonCurrentMood")
+	assert.Contains(t, codes[CodeTypeModel], `PersonCurrentMoodHappy PersonCurrentMood = "happy"`)
+	assert.Contains(t, codes[CodeTypeModel], "Moods       pq.StringArray")
+}
+
+func TestParseSQLWithJSON(t *testing.T) {
+	sql := `CREATE TABLE users (
+  id bigint unsigned NOT NULL AUTO_INCREMENT,
+  profile json NULL,
+  settings json NULL,
+  PRIMARY KEY (id)
+);`
+	codes, err := ParseSQL(sql, WithNullStyle(NullInSql))
+	assert.NoError(t, err)
+	assert.Contains(t, codes[CodeTypeModel], "Profile  sql.NullString")
+
+	codes, err = ParseSQL(sql, WithJSONType(JSONTypeDatatypes), WithJSONStruct("users.profile", "UserProfile"))
+	assert.NoError(t, err)
+	model := codes[CodeTypeModel]
+	assert.Contains(t, model, `"gorm.io/datatypes"`)
+	assert.Contains(t, model, "Profile  *UserProfile   `gorm:\"column:profile;serializer:json\"`")
+	assert.Contains(t, model, "Settings datatypes.JSON `gorm:\"column:settings\"`")
+	assert.Contains(t, model, "type UserProfile struct {")
+	assert.Contains(t, model, `"profile":  "json",`)
+	assert.Contains(t, codes[CodeTypeDAO], "if table.Settings != nil {")
+	assert.Contains(t, codes[CodeTypeHandler], "Profile  *model.UserProfile")
+	assert.Contains(t, codes[CodeTypeJSON], `"settings": {}`)
+	assert.Contains(t, codes[CodeTypeProto], `import "google/protobuf/struct.proto";`)
+	assert.Contains(t, codes[CodeTypeProto], "google.protobuf.Struct settings = ")
+	assert.Contains(t, codes[CodeTypeServiceConvertRequest], "err = json.Unmarshal(data, users.Profile)")
+	assert.Contains(t, codes[CodeTypeServiceConvertRequest], "users.Settings, err = req.Settings.MarshalJSON()")
+	assert.Contains(t, codes[CodeTypeServiceConvertReply], "err = value.Settings.UnmarshalJSON(record.Settings)")
+
+	sql = `CREATE TABLE users (
+  id serial PRIMARY KEY,
+  profile jsonb,
+  tags jsonb[]
+);`
+	codes, err = ParseSQL(sql, WithDBDriver(DBDriverPostgresql), WithJSONType(JSONTypeDatatypes))
+	assert.NoError(t, err)
+	assert.Contains(t, codes[CodeTypeModel], "Profile datatypes.JSON")
+	assert.NotContains(t, codes[CodeTypeModel], "Tags    datatypes.JSON")
+}
+
+func Test_postgresqlToGoType(t *testing.T) {
+	tests := []struct {
+		ddl    string
+		style  NullStyle
+		goType string
+	}{
+		{"a smallint", NullDisable, "int"},
+		{"a bigint", NullDisable, "int64"},
+		{"a real", NullDisable, "float32"},
+		{"a double precision", NullDisable, "float64"},
+		{"a numeric(10, 2)", NullDisable, "string"},
+		{"a bool", NullDisable, "bool"},
+		{"a timestamp without time zone", NullDisable, "time.Time"},
+		{"a jsonb", NullDisable, "string"},
+		{"a uuid", NullDisable, "string"},
+		{"a bytea", NullDisable, "[]byte"},
+		{"a integer ARRAY", NullDisable, "pq.Int64Array"},
+		{"a _float8", NullDisable, "pq.Float64Array"},
+		{"a bigint", NullInSql, "sql.NullInt64"},
+		{"a timestamptz", NullInSql, "sql.NullTime"},
+		{"a varchar(10)", NullInPointer, "*string"},
+	}
+	for _, tt := range tests {
+		tokens, err := ddlTokenize(tt.ddl)
+		assert.NoError(t, err)
+		col, err := parseDDLColumn(tokens)
+		assert.NoError(t, err)
+		goType, _ := postgresqlToGoType(col, tt.style)
+		assert.Equal(t, tt.goType, goType, tt.ddl)
+	}
+}
+
+var sqliteSQL = `CREATE TABLE IF NOT EXISTS "t_person_info" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(30) NOT NULL DEFAULT 'default_name',
+    age UNSIGNED BIG INT,
+    score DECIMAL(10,5),
+    is_active BOOLEAN NOT NULL DEFAULT 0,
+    avatar BLOB,
+    data,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    email TEXT UNIQUE ON CONFLICT REPLACE,
+    FOREIGN KEY (age) REFERENCES other(id)
+);`
+
+func TestParseSqliteSQL(t *testing.T) {
+	codes, err := ParseSQL(sqliteSQL, WithDBDriver(DBDriverSqlite), WithTablePrefix("t_"), WithJSONTag(0), WithGormType())
+	assert.NoError(t, err)
+	for k, v := range codes {
+		assert.NotEmpty(t, k)
+		assert.NotEmpty(t, v)
+	}
+	assert.Contains(t, codes[CodeTypeModel], "ID        int64          `gorm:\"column:id;type:INTEGER;primary_key;AUTO_INCREMENT\" json:\"id\"`")
+	assert.Contains(t, codes[CodeTypeModel], "Age       sql.NullInt32  `gorm:\"column:age;type:UNSIGNED BIG INT\" json:\"age\"`")
+	assert.Contains(t, codes[CodeTypeModel], "Data      []byte         `gorm:\"column:data\" json:\"data\"`")
+	assert.Contains(t, codes[CodeTypeModel], "IsActive  bool           `gorm:\"column:is_active;type:BOOLEAN;default:0;NOT NULL\" json:\"is_active\"`")
+	assert.Contains(t, codes[CodeTypeModel], "CreatedAt *time.Time     `gorm:\"column:created_at;type:DATETIME;default:CURRENT_TIMESTAMP;NOT NULL\" json:\"created_at\"`")
+
+	codes, err = ParseSQL(sqliteSQL, WithDBDriver(DBDriverSqlite), WithNullStyle(NullInSql), WithEmbed())
+	assert.NoError(t, err)
+	assert.Contains(t, codes[CodeTypeModel], "mysql.Model")
+}
+
+func TestGetSqliteTableInfo(t *testing.T) {
+	dbFile := filepath.Join(t.TempDir(), "test.db")
+	db, err := sql.Open("sqlite", dbFile)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = db.Exec(sqliteSQL)
+	_ = db.Close()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	info, err := GetSqliteTableInfo(dbFile, "t_person_info")
+	assert.NoError(t, err)
+	codes, err := ParseSQL(info, WithDBDriver(DBDriverSqlite))
+	assert.NoError(t, err)
+	assert.Contains(t, codes[CodeTypeModel], "type TPersonInfo struct")
+
+	_, err = GetSqliteTableInfo(dbFile, "not_found")
+	assert.Error(t, err)
+}
+
 func Test_toCamel(t *testing.T) {
 	str := "user_example"
 	t.Log(toCamel(str))
@@ -102,6 +562,7 @@ func Test_toCamel(t *testing.T) {
 
 func Test_parseOption(t *testing.T) {
 	opts := []Option{
+		WithDBDriver(DBDriverPostgresql),
 		WithCharset("foo"),
 		WithCollation("foo"),
 		WithTablePrefix("foo"),
@@ -113,11 +574,91 @@ func Test_parseOption(t *testing.T) {
 		WithGormType(),
 		WithForceTableName(),
 		WithEmbed(),
+		WithTableNames("foo", " "),
+		WithTemplateDir("templates"),
+		WithKeepIndexes(),
 	}
 	o := parseOption(opts)
 	assert.NotNil(t, o)
 }
 
+func TestDiffSQL(t *testing.T) {
+	current := "CREATE TABLE `users` (\n" +
+		"  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,\n" +
+		"  `name` varchar(50) NOT NULL DEFAULT '' COMMENT 'user name',\n" +
+		"  `age` int(11) DEFAULT NULL,\n" +
+		"  `score` decimal(10,2) DEFAULT '0.00',\n" +
+		"  `old` varchar(10) DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`id`),\n" +
+		"  UNIQUE KEY `uk_name` (`name`),\n" +
+		"  KEY `idx_age` (`age`)\n" +
+		") ENGINE=InnoDB AUTO_INCREMENT=3 DEFAULT CHARSET=utf8mb4 COMMENT='users';"
+	target := `create table users (
+  id bigint unsigned auto_increment primary key,
+  name varchar(100) not null default '',
+  age int,
+  score decimal(10,2) default 0,
+  email varchar(50) not null comment 'email',
+  unique key uk_name(name),
+  key idx_email(email(10))
+) comment 'users';
+create table orders (
+  id bigint unsigned auto_increment primary key,
+  user_id bigint not null
+) comment 'orders';
+create index idx_user_id on orders(user_id);`
+
+	up, down, err := DiffSQL(current, target)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `users` DROP INDEX `idx_age`",
+		"ALTER TABLE `users` MODIFY COLUMN `name` varchar(100) NOT NULL DEFAULT '' COMMENT 'user name'",
+		"ALTER TABLE `users` ADD COLUMN `email` varchar(50) NOT NULL COMMENT 'email' AFTER `score`",
+		"ALTER TABLE `users` ADD KEY `idx_email` (`email`(10))",
+		"ALTER TABLE `users` DROP COLUMN `old`",
+		"CREATE TABLE `orders` (\n" +
+			"  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,\n" +
+			"  `user_id` bigint(20) NOT NULL,\n" +
+			"  PRIMARY KEY (`id`),\n" +
+			"  KEY `idx_user_id` (`user_id`)\n" +
+			") COMMENT='orders'",
+	}, up)
+	assert.Equal(t, []string{
+		"DROP TABLE `orders`",
+		"ALTER TABLE `users` ADD COLUMN `old` varchar(10) AFTER `score`",
+		"ALTER TABLE `users` DROP INDEX `idx_email`",
+		"ALTER TABLE `users` DROP COLUMN `email`",
+		"ALTER TABLE `users` MODIFY COLUMN `name` varchar(50) NOT NULL DEFAULT '' COMMENT 'user name'",
+		"ALTER TABLE `users` ADD KEY `idx_age` (`age`)",
+	}, down)
+
+	// the indexes that are not in the target are kept
+	up, _, err = DiffSQL(current, target, WithKeepIndexes())
+	assert.NoError(t, err)
+	assert.NotContains(t, up, "ALTER TABLE `users` DROP INDEX `idx_age`")
+
+	// no changes
+	up, down, err = DiffSQL(current, current)
+	assert.NoError(t, err)
+	assert.Empty(t, up)
+	assert.Empty(t, down)
+
+	_, _, err = DiffSQL("create table", target)
+	assert.Error(t, err)
+	_, _, err = DiffSQL(current, "create table")
+	assert.Error(t, err)
+}
+
+func Test_normalizeColumnType(t *testing.T) {
+	assert.Equal(t, "int", normalizeColumnType("int(11)"))
+	assert.Equal(t, "bigint unsigned", normalizeColumnType("BIGINT(20) UNSIGNED"))
+	assert.Equal(t, "tinyint(1)", normalizeColumnType("tinyint(1)"))
+	assert.Equal(t, "varchar(50)", normalizeColumnType("varchar(50)"))
+	assert.Equal(t, "0", normalizeDefault("'0.00'"))
+	assert.Equal(t, "CURRENT_TIMESTAMP", normalizeDefault("current_timestamp()"))
+	assert.Equal(t, "it's", normalizeDefault("'it''s'"))
+}
+
 func Test_mysqlToGoType(t *testing.T) {
 	testData := []*types.FieldType{
 		{Tp: uint8('n')},
@@ -148,6 +689,59 @@ func Test_goTypeToProto(t *testing.T) {
 	assert.NotNil(t, v)
 }
 
+func TestParseSQLWithTemplateDir(t *testing.T) {
+	sql := "CREATE TABLE users (id bigint unsigned NOT NULL AUTO_INCREMENT, name varchar(50) NOT NULL, PRIMARY KEY (id));"
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "modelStruct.tmpl"),
+		[]byte("// {{.TableName}} house conventions\ntype {{.TableName}} struct {\n{{- range .Fields}}\n\t{{.Name}} {{.GoType}}\n{{- end}}\n}\n"), 0666)
+	assert.NoError(t, err)
+
+	codes, err := ParseSQL(sql, WithTemplateDir(dir))
+	assert.NoError(t, err)
+	assert.Contains(t, codes[CodeTypeModel], "// Users house conventions")
+	assert.Contains(t, codes[CodeTypeModel], "Name string")
+
+	// the built-in templates are restored
+	codes, err = ParseSQL(sql)
+	assert.NoError(t, err)
+	assert.NotContains(t, codes[CodeTypeModel], "house conventions")
+
+	err = os.WriteFile(filepath.Join(dir, "daoIndex.tmpl"), []byte("{{if .foo}}"), 0666)
+	assert.NoError(t, err)
+	_, err = ParseSQL(sql, WithTemplateDir(dir))
+	assert.Error(t, err)
+	codes, err = ParseSQL(sql)
+	assert.NoError(t, err)
+	assert.NotContains(t, codes[CodeTypeModel], "house conventions")
+}
+
+func TestParseSQLWithTemplateDir_concurrent(t *testing.T) {
+	sql := "CREATE TABLE users (id bigint unsigned NOT NULL AUTO_INCREMENT, name varchar(50) NOT NULL, PRIMARY KEY (id));"
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "modelStruct.tmpl"),
+		[]byte("// {{.TableName}} house conventions\ntype {{.TableName}} struct {\n{{- range .Fields}}\n\t{{.Name}} {{.GoType}}\n{{- end}}\n}\n"), 0666)
+	assert.NoError(t, err)
+
+	// the templates of every call are not changed by the other calls
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				codes, err := ParseSQL(sql, WithTemplateDir(dir))
+				assert.NoError(t, err)
+				assert.Contains(t, codes[CodeTypeModel], "house conventions")
+			} else {
+				codes, err := ParseSQL(sql)
+				assert.NoError(t, err)
+				assert.NotContains(t, codes[CodeTypeModel], "house conventions")
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
 func TestGetTableInfo(t *testing.T) {
 	info, err := GetTableInfo("root:123456@(192.168.3.37:3306)/test", "user")
 	t.Log(err, info)
@@ -169,5 +763,9 @@ func Test_initTemplate(t *testing.T) {
 	serviceCreateStructTmplRaw = "{{if .foo}}"
 	serviceUpdateStructTmplRaw = "{{if .foo}}"
 	serviceStructTmplRaw = "{{if .foo}}"
+	modelEnumTmplRaw = "{{if .foo}}"
+	modelJSONStructTmplRaw = "{{if .foo}}"
+	daoIndexTmplRaw = "{{if .foo}}"
+	handlerIndexTmplRaw = "{{if .foo}}"
 	initTemplate()
 }
@@ -0,0 +1,217 @@
+package parser
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	_ "github.com/lib/pq" //nolint
+)
+
+// GetPostgresqlTableInfo get table info from postgresql, the table structure is converted to CREATE TABLE ddl
+func GetPostgresqlTableInfo(dsn, tableName string) (string, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return "", fmt.Errorf("connect postgresql error, %v", err)
+	}
+	defer db.Close() //nolint
+
+	rows, err := db.Query(`SELECT a.attname, format_type(a.atttypid, a.atttypmod), a.attnotnull,
+       COALESCE(pg_get_expr(d.adbin, d.adrelid), ''), a.attidentity::text, COALESCE(col_description(a.attrelid, a.attnum), '')
+FROM pg_attribute a
+LEFT JOIN pg_attrdef d ON a.attrelid = d.adrelid AND a.attnum = d.adnum
+WHERE a.attrelid = $1::regclass AND a.attnum > 0 AND NOT a.attisdropped
+ORDER BY a.attnum`, tableName)
+	if err != nil {
+		return "", fmt.Errorf("query table columns error, %v", err)
+	}
+	defer rows.Close() //nolint
+
+	var columns []string
+	var comments []string
+	for rows.Next() {
+		var name, colType, defaultValue, identity, comment string
+		var notNull bool
+		if err = rows.Scan(&name, &colType, &notNull, &defaultValue, &identity, &comment); err != nil {
+			return "", err
+		}
+		column := fmt.Sprintf("    %s %s", quoteIdent(name), colType)
+		if notNull {
+			column += " NOT NULL"
+		}
+		switch identity {
+		case "a":
+			column += " GENERATED ALWAYS AS IDENTITY"
+		case "d":
+			column += " GENERATED BY DEFAULT AS IDENTITY"
+		}
+		if defaultValue != "" {
+			column += " DEFAULT " + defaultValue
+		}
+		columns = append(columns, column)
+		if comment != "" {
+			comments = append(comments, fmt.Sprintf("COMMENT ON COLUMN %s.%s IS %s;", quoteIdent(tableName), quoteIdent(name), quoteString(comment)))
+		}
+	}
+	if len(columns) == 0 {
+		return "", fmt.Errorf("not found table '%s'", tableName)
+	}
+
+	pkRows, err := db.Query(`SELECT a.attname
+FROM pg_index i
+JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+WHERE i.indrelid = $1::regclass AND i.indisprimary`, tableName)
+	if err != nil {
+		return "", fmt.Errorf("query table primary key error, %v", err)
+	}
+	defer pkRows.Close() //nolint
+
+	var primaryKeys []string
+	for pkRows.Next() {
+		var name string
+		if err = pkRows.Scan(&name); err != nil {
+			return "", err
+		}
+		primaryKeys = append(primaryKeys, quoteIdent(name))
+	}
+	if len(primaryKeys) > 0 {
+		columns = append(columns, fmt.Sprintf("    PRIMARY KEY (%s)", strings.Join(primaryKeys, ", ")))
+	}
+
+	var tableComment string
+	_ = db.QueryRow(`SELECT COALESCE(obj_description($1::regclass, 'pg_class'), '')`, tableName).Scan(&tableComment)
+	if tableComment != "" {
+		comments = append(comments, fmt.Sprintf("COMMENT ON TABLE %s IS %s;", quoteIdent(tableName), quoteString(tableComment)))
+	}
+
+	ddl := fmt.Sprintf("CREATE TABLE %s (\n%s\n);\n", quoteIdent(tableName), strings.Join(columns, ",\n"))
+	if len(comments) > 0 {
+		ddl += strings.Join(comments, "\n") + "\n"
+	}
+
+	return ddl, nil
+}
+
+func parsePostgresqlSQL(sql string, opt options) ([]*tableInfo, error) {
+	tables, err := parseDDLTables(sql)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*tableInfo, 0, len(tables))
+	for _, table := range tables {
+		infos = append(infos, makePgTableInfo(table, opt))
+	}
+
+	return infos, nil
+}
+
+func makePgTableInfo(table *ddlTable, opt options) *tableInfo {
+	for _, col := range table.Columns {
+		if _, ok := pgSerialTypes[col.Type]; ok {
+			col.AutoIncrement = true
+			col.CanNull = false
+		}
+	}
+
+	return makeDDLTableInfo(table, opt, postgresqlToGoType)
+}
+
+// nolint
+func postgresqlToGoType(col *ddlColumn, style NullStyle) (name string, path string) {
+	colType := col.Type
+	if alias, ok := pgTypeAlias[colType]; ok {
+		colType = alias
+	}
+
+	if col.IsArray {
+		path = "github.com/lib/pq"
+		switch colType {
+		case "int2", "int4", "int8":
+			name = "pq.Int64Array"
+		case "float4", "float8":
+			name = "pq.Float64Array"
+		case "bool":
+			name = "pq.BoolArray"
+		default:
+			name = "pq.StringArray"
+		}
+		return name, path
+	}
+	if colType == "bytea" {
+		return "[]byte", ""
+	}
+
+	if style == NullInSql {
+		path = "database/sql"
+		switch colType {
+		case "int2", "int4":
+			name = "sql.NullInt32"
+		case "int8":
+			name = "sql.NullInt64"
+		case "float4", "float8":
+			name = "sql.NullFloat64"
+		case "bool":
+			name = "sql.NullBool"
+		case "timestamp", "timestamptz", "date":
+			name = "sql.NullTime"
+		default:
+			name = "sql.NullString"
+		}
+	} else {
+		switch colType {
+		case "int2", "int4":
+			name = "int"
+		case "int8":
+			name = "int64"
+		case "float4":
+			name = "float32"
+		case "float8":
+			name = "float64"
+		case "bool":
+			name = "bool"
+		case "timestamp", "timestamptz", "date":
+			path = "time"
+			name = "time.Time"
+		default: // text, varchar, char, numeric, json, jsonb, uuid, inet, time, interval, etc.
+			name = "string"
+		}
+		if style == NullInPointer {
+			name = "*" + name
+		}
+	}
+	return name, path
+}
+
+// normalize the alias of the postgresql type to the internal name
+var pgTypeAlias = map[string]string{
+	"smallint":                    "int2",
+	"smallserial":                 "int2",
+	"serial2":                     "int2",
+	"integer":                     "int4",
+	"int":                         "int4",
+	"serial":                      "int4",
+	"serial4":                     "int4",
+	"bigint":                      "int8",
+	"bigserial":                   "int8",
+	"serial8":                     "int8",
+	"real":                        "float4",
+	"double precision":            "float8",
+	"float":                       "float8",
+	"boolean":                     "bool",
+	"decimal":                     "numeric",
+	"money":                       "numeric",
+	"character varying":           "varchar",
+	"character":                   "bpchar",
+	"char":                        "bpchar",
+	"timestamp without time zone": "timestamp",
+	"timestamp with time zone":    "timestamptz",
+	"time without time zone":      "time",
+	"time with time zone":         "timetz",
+}
+
+var pgSerialTypes = map[string]struct{}{
+	"smallserial": {}, "serial2": {},
+	"serial": {}, "serial4": {},
+	"bigserial": {}, "serial8": {},
+}
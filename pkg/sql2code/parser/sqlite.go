@@ -0,0 +1,114 @@
+package parser
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	_ "github.com/glebarez/go-sqlite" //nolint
+)
+
+// GetSqliteTableInfo get table info from sqlite database file
+func GetSqliteTableInfo(dbFile, tableName string) (string, error) {
+	db, err := sql.Open("sqlite", dbFile)
+	if err != nil {
+		return "", fmt.Errorf("connect sqlite error, %v", err)
+	}
+	defer db.Close() //nolint
+
+	rows, err := db.Query("SELECT sql FROM sqlite_master WHERE type = 'table' AND name = ?", tableName)
+	if err != nil {
+		return "", fmt.Errorf("query sqlite_master error, %v", err)
+	}
+
+	defer rows.Close() //nolint
+	if !rows.Next() {
+		return "", fmt.Errorf("not found table '%s'", tableName)
+	}
+
+	var info string
+	err = rows.Scan(&info)
+	if err != nil {
+		return "", err
+	}
+
+	return info + ";", nil
+}
+
+func parseSqliteSQL(sql string, opt options) ([]*tableInfo, error) {
+	tables, err := parseDDLTables(sql)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*tableInfo, 0, len(tables))
+	for _, table := range tables {
+		infos = append(infos, makeSqliteTableInfo(table, opt))
+	}
+
+	return infos, nil
+}
+
+func makeSqliteTableInfo(table *ddlTable, opt options) *tableInfo {
+	for _, col := range table.Columns {
+		// INTEGER PRIMARY KEY is an alias for the rowid
+		if col.PrimaryKey && col.Type == "integer" {
+			col.AutoIncrement = true
+			col.CanNull = false
+		}
+	}
+
+	return makeDDLTableInfo(table, opt, sqliteToGoType)
+}
+
+// the go type is determined by the type affinity of the column, https://www.sqlite.org/datatype3.html
+// nolint
+func sqliteToGoType(col *ddlColumn, style NullStyle) (name string, path string) {
+	colType := col.Type
+	kind := ""
+	switch {
+	case colType == "integer" || strings.Contains(colType, "bigint"):
+		kind = "int64"
+	case strings.Contains(colType, "int"):
+		kind = "int"
+	case strings.Contains(colType, "char") || strings.Contains(colType, "clob") || strings.Contains(colType, "text"):
+		kind = "string"
+	case colType == "" || strings.Contains(colType, "blob"):
+		return "[]byte", ""
+	case strings.Contains(colType, "real") || strings.Contains(colType, "floa") || strings.Contains(colType, "doub"):
+		kind = "float64"
+	case strings.Contains(colType, "bool"):
+		kind = "bool"
+	case strings.Contains(colType, "date") || strings.Contains(colType, "time"):
+		kind = "time.Time"
+	default: // numeric, decimal, json, etc.
+		kind = "string"
+	}
+
+	if style == NullInSql {
+		path = "database/sql"
+		switch kind {
+		case "int":
+			name = "sql.NullInt32"
+		case "int64":
+			name = "sql.NullInt64"
+		case "float64":
+			name = "sql.NullFloat64"
+		case "bool":
+			name = "sql.NullBool"
+		case "time.Time":
+			name = "sql.NullTime"
+		default:
+			name = "sql.NullString"
+		}
+	} else {
+		name = kind
+		if kind == "time.Time" {
+			path = "time"
+		}
+		if style == NullInPointer {
+			name = "*" + name
+		}
+	}
+	return name, path
+}
@@ -1,13 +1,15 @@
 package parser
 
 import (
-	"github.com/pkg/errors"
+	"os"
+	"path/filepath"
 	"sync"
 	"text/template"
+
+	"github.com/pkg/errors"
 )
 
 var (
-	modelStructTmpl    *template.Template
 	modelStructTmplRaw = `
 {{- if .Comment -}}
 // {{.TableName}} {{.Comment}}
@@ -23,9 +25,190 @@ func (m *{{.TableName}}) TableName() string {
 	return "{{.RawTableName}}"
 }
 {{end}}
+{{- if .Columns}}
+// {{.TableName}}Columns the columns allowed to be filtered and sorted in the list query and their types,
+// the sensitive columns are excluded
+var {{.TableName}}Columns = map[string]string{
+{{- range .Columns}}
+	"{{.ColName}}": "{{.QueryType}}",
+{{- end}}
+}
+{{end}}
+`
+
+	modelEnumTmplRaw = `
+{{- range $e := .Enums}}
+{{- if .IsSet}}
+
+// {{.TypeName}} each bit is a value of the set
+type {{.TypeName}} uint64
+
+// the values of {{.TypeName}}
+const (
+{{- range $i, $v := .Values}}
+	{{$v.Name}}{{if not $i}} {{$e.TypeName}} = 1 << iota{{end}} // {{$v.Value}}
+{{- end}}
+)
+
+var {{.VarName}} = []string{ {{- range $i, $v := .Values}}{{if $i}}, {{end}}{{printf "%q" $v.Value}}{{end -}} }
+
+// Parse{{.TypeName}} parse the values separated by comma
+func Parse{{.TypeName}}(str string) ({{.TypeName}}, error) {
+	var s {{.TypeName}}
+	if str == "" {
+		return s, nil
+	}
+	for _, value := range strings.Split(str, ",") {
+		found := false
+		for i, v := range {{.VarName}} {
+			if v == value {
+				s |= 1 << uint(i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return s, fmt.Errorf("invalid {{.TypeName}} value '%s'", value)
+		}
+	}
+	return s, nil
+}
+
+// Has whether all the bits of flag are set
+func (s {{.TypeName}}) Has(flag {{.TypeName}}) bool {
+	return s&flag == flag
+}
+
+// IsValid whether only the bits of the values are set
+func (s {{.TypeName}}) IsValid() bool {
+	return s>>uint(len({{.VarName}})) == 0
+}
+
+// Values the values of the set bits
+func (s {{.TypeName}}) Values() []string {
+	values := []string{}
+	for i, v := range {{.VarName}} {
+		if s&(1<<uint(i)) != 0 {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// String the values separated by comma
+func (s {{.TypeName}}) String() string {
+	return strings.Join(s.Values(), ",")
+}
+
+// Scan implements the sql.Scanner interface
+func (s *{{.TypeName}}) Scan(value interface{}) error {
+	var err error
+	switch v := value.(type) {
+	case nil:
+		*s = 0
+	case []byte:
+		*s, err = Parse{{.TypeName}}(string(v))
+	case string:
+		*s, err = Parse{{.TypeName}}(v)
+	default:
+		err = fmt.Errorf("unsupported type %T for {{.TypeName}}", value)
+	}
+	return err
+}
+
+// Value implements the driver.Valuer interface
+func (s {{.TypeName}}) Value() (driver.Value, error) {
+	if !s.IsValid() {
+		return nil, fmt.Errorf("invalid {{.TypeName}} value %d", uint64(s))
+	}
+	return s.String(), nil
+}
+{{- else}}
+
+// {{.TypeName}} the values of the enum
+type {{.TypeName}} string
+
+// the values of {{.TypeName}}
+const (
+{{- range .Values}}
+	{{.Name}} {{$e.TypeName}} = {{printf "%q" .Value}}
+{{- end}}
+)
+
+var {{.VarName}} = []{{.TypeName}}{ {{- range $i, $v := .Values}}{{if $i}}, {{end}}{{$v.Name}}{{end -}} }
+
+// {{.TypeName}}FromNumber get the value by number, it is the same as the number of the protobuf enum
+func {{.TypeName}}FromNumber(n int32) {{.TypeName}} {
+	if n < 1 || int(n) > len({{.VarName}}) {
+		return ""
+	}
+	return {{.VarName}}[n-1]
+}
+
+// Number the number of the value starting from 1, 0 means invalid value
+func (e {{.TypeName}}) Number() int32 {
+	for i, v := range {{.VarName}} {
+		if v == e {
+			return int32(i + 1)
+		}
+	}
+	return 0
+}
+
+// IsValid whether the value is one of the values of the enum
+func (e {{.TypeName}}) IsValid() bool {
+	return e.Number() > 0
+}
+
+// String the value of the enum
+func (e {{.TypeName}}) String() string {
+	return string(e)
+}
+
+// Scan implements the sql.Scanner interface
+func (e *{{.TypeName}}) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*e = ""
+	case []byte:
+		*e = {{.TypeName}}(v)
+	case string:
+		*e = {{.TypeName}}(v)
+	default:
+		return fmt.Errorf("unsupported type %T for {{.TypeName}}", value)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface, empty value is saved as NULL
+func (e {{.TypeName}}) Value() (driver.Value, error) {
+	if e == "" {
+		return nil, nil
+	}
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid {{.TypeName}} value '%s'", string(e))
+	}
+	return string(e), nil
+}
+{{- end}}
+{{- end}}
+`
+
+	modelJSONStructTmplRaw = `
+{{- range .JSONStructs}}
+
+// {{.TypeName}} value of the json column {{.Column}}
+// todo fill in the fields of the json value
+type {{.TypeName}} struct {
+}
+
+// Value implements the driver.Valuer interface, used when the column is updated by a map
+func (v {{.TypeName}}) Value() (driver.Value, error) {
+	return json.Marshal(v)
+}
+{{- end}}
 `
 
-	modelTmpl    *template.Template
 	modelTmplRaw = `package {{.Package}}
 {{if .ImportPath}}
 import (
@@ -38,7 +221,6 @@ import (
 {{.}}
 {{end}}`
 
-	updateFieldTmpl    *template.Template
 	updateFieldTmplRaw = `
 {{- range .Fields}}
 	if table.{{.Name}} {{.ConditionZero}} {
@@ -46,28 +228,25 @@ import (
 	}
 {{- end}}`
 
-	handlerCreateStructTmpl    *template.Template
 	handlerCreateStructTmplRaw = `
 // Create{{.TableName}}Request create params
 // todo fill in the binding rules https://github.com/go-playground/validator
 type Create{{.TableName}}Request struct {
 {{- range .Fields}}
-	{{.Name}}  {{.GoType}} ` + "`" + `json:"{{.ColName}}" binding:""` + "`" + `{{if .Comment}} // {{.Comment}}{{end}}
+	{{.Name}}  {{.GoType}} ` + "`" + `json:"{{.ColName}}" binding:"{{.BindingRule}}"` + "`" + `{{if .Comment}} // {{.Comment}}{{end}}
 {{- end}}
 }
 `
 
-	handlerUpdateStructTmpl    *template.Template
 	handlerUpdateStructTmplRaw = `
 // Update{{.TableName}}ByIDRequest update params
 type Update{{.TableName}}ByIDRequest struct {
 {{- range .Fields}}
-	{{.Name}}  {{.GoType}} ` + "`" + `json:"{{.ColName}}" binding:""` + "`" + `{{if .Comment}} // {{.Comment}}{{end}}
+	{{.Name}}  {{.GoType}} ` + "`" + `json:"{{.ColName}}" binding:"{{.BindingRule}}"` + "`" + `{{if .Comment}} // {{.Comment}}{{end}}
 {{- end}}
 }
 `
 
-	handlerDetailStructTmpl    *template.Template
 	handlerDetailStructTmplRaw = `
 // Get{{.TableName}}ByIDRespond respond detail
 type Get{{.TableName}}ByIDRespond struct {
@@ -76,7 +255,6 @@ type Get{{.TableName}}ByIDRespond struct {
 {{- end}}
 }`
 
-	modelJSONTmpl    *template.Template
 	modelJSONTmplRaw = `{
 {{- range .Fields}}
 	"{{.ColName}}" {{.GoZero}}
@@ -84,7 +262,6 @@ type Get{{.TableName}}ByIDRespond struct {
 }
 `
 
-	protoFileTmpl    *template.Template
 	protoFileTmplRaw = `syntax = "proto3";
 
 package api.serverNameExample.v1;
@@ -94,6 +271,9 @@ import "google/api/annotations.proto";
 import "protoc-gen-openapiv2/options/annotations.proto";
 import "tagger/tagger.proto";
 //import "validate/validate.proto";
+{{- if .HasJSON}}
+import "google/protobuf/struct.proto";
+{{- end}}
 
 option go_package = "github.com/zhufuyi/sponge/api/serverNameExample/v1;v1";
 
@@ -124,6 +304,30 @@ service {{.TName}}Service {
     };
   }
 
+  rpc CreateBatch(CreateBatch{{.TableName}}Request) returns (CreateBatch{{.TableName}}Reply) {
+    option (google.api.http) = {
+      post: "/api/v1/{{.TName}}s/batch"
+      body: "*"
+    };
+    option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_operation) = {
+      summary: "create {{.TName}}s in batch",
+      description: "submit information to create multiple {{.TName}}s, the records are inserted in chunks",
+      tags: "{{.TName}}",
+    };
+  }
+
+  rpc Upsert(Create{{.TableName}}Request) returns (Upsert{{.TableName}}Reply) {
+    option (google.api.http) = {
+      put: "/api/v1/{{.TName}}"
+      body: "*"
+    };
+    option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_operation) = {
+      summary: "create or update {{.TName}}",
+      description: "create {{.TName}}, or update all the fields of {{.TName}} if the unique key already exists",
+      tags: "{{.TName}}",
+    };
+  }
+
   rpc DeleteByID(Delete{{.TableName}}ByIDRequest) returns (Delete{{.TableName}}ByIDReply) {
     option (google.api.http) = {
       delete: "/api/v1/{{.TName}}/{id}"
@@ -147,6 +351,18 @@ service {{.TName}}Service {
     };
   }
 
+  rpc UpdateByIDs(Update{{.TableName}}ByIDsRequest) returns (Update{{.TableName}}ByIDsReply) {
+    option (google.api.http) = {
+      put: "/api/v1/{{.TName}}s/ids"
+      body: "*"
+    };
+    option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_operation) = {
+      summary: "update {{.TName}}s by multiple ids",
+      description: "update the same information of {{.TName}}s by multiple ids, fields are updated with non-zero values",
+      tags: "{{.TName}}",
+    };
+  }
+
   rpc GetByID(Get{{.TableName}}ByIDRequest) returns (Get{{.TableName}}ByIDReply) {
     option (google.api.http) = {
       get: "/api/v1/{{.TName}}/{id}"
@@ -181,6 +397,28 @@ service {{.TName}}Service {
       tags: "{{.TName}}",
     };
   }
+{{- range .Indexes}}
+
+  rpc {{.MethodName}}({{.RequestName}}) returns ({{.ReplyName}}) {
+    option (google.api.http) = {
+{{- if .IsUnique}}
+      get: "/api/v1/{{.TName}}/{{.ProtoPath}}"
+{{- else}}
+      get: "/api/v1/{{.TName}}s/{{.ProtoPath}}"
+{{- end}}
+    };
+    option (grpc.gateway.protoc_gen_openapiv2.options.openapiv2_operation) = {
+{{- if .IsUnique}}
+      summary: "get {{.TName}} details by {{.ColumnNames}}",
+      description: "get {{.TName}} details by the unique index {{.ColumnNames}}",
+{{- else}}
+      summary: "get a list of {{.TName}} by {{.ColumnNames}}",
+      description: "get a list of {{.TName}} by the index {{.ColumnNames}}",
+{{- end}}
+      tags: "{{.TName}}",
+    };
+  }
+{{- end}}
 }
 
 // todo fill in the validate rules https://github.com/envoyproxy/protoc-gen-validate#constraint-rules
@@ -191,6 +429,18 @@ message Create{{.TableName}}Reply {
   uint64   id =1;
 }
 
+message CreateBatch{{.TableName}}Request {
+  repeated Create{{.TableName}}Request {{.TName}}s = 1;
+}
+
+message CreateBatch{{.TableName}}Reply {
+  repeated uint64 ids = 1;
+}
+
+message Upsert{{.TableName}}Reply {
+  uint64   id =1;
+}
+
 message Delete{{.TableName}}ByIDRequest {
   uint64   id =1 [(tagger.tags) = "uri:\"id\"" ];
 }
@@ -205,6 +455,16 @@ message Update{{.TableName}}ByIDReply {
 
 }
 
+// the non-zero fields of {{.TName}} are updated to the records of ids, the id of {{.TName}} is ignored
+message Update{{.TableName}}ByIDsRequest {
+  repeated uint64 ids = 1;
+  {{.TableName}} {{.TName}} = 2;
+}
+
+message Update{{.TableName}}ByIDsReply {
+
+}
+
 // protoMessageDetailCode
 
 message Get{{.TableName}}ByIDRequest {
@@ -230,31 +490,55 @@ message List{{.TableName}}Request {
 message List{{.TableName}}Reply {
   int64 total =1;
   repeated {{.TableName}} {{.TName}}s = 2;
+  string nextCursor = 3; // the cursor of the next page, empty means no more records
+}
+{{- range .Indexes}}
+
+message {{.RequestName}} {
+{{- range $i, $v := .Fields}}
+  {{$v.ProtoType}} {{$v.ColName}} = {{$v.AddOne $i}} [(tagger.tags) = "uri:\"{{$v.ColName}}\"" ];{{if $v.Comment}} // {{$v.Comment}}{{end}}
+{{- end}}
 }
+
+message {{.ReplyName}} {
+{{- if .IsUnique}}
+  {{.TableName}} {{.TName}} = 1;
+{{- else}}
+  repeated {{.TableName}} {{.TName}}s = 1;
+{{- end}}
+}
+{{- end}}
+{{- range $e := .Enums}}
+{{- if not .IsSet}}
+
+enum {{.TypeName}} {
+  {{.ProtoName .TypeName}}_UNSPECIFIED = 0;
+{{- range $i, $v := .Values}}
+  {{$e.ProtoName $v.Name}} = {{$v.AddOne $i}}; // {{$v.Value}}
+{{- end}}
+}
+{{- end}}
+{{- end}}
 `
 
-	protoMessageCreateTmpl    *template.Template
 	protoMessageCreateTmplRaw = `message Create{{.TableName}}Request {
 {{- range $i, $v := .Fields}}
 	{{$v.GoType}} {{$v.ColName}} = {{$v.AddOne $i}}; {{if $v.Comment}} // {{$v.Comment}}{{end}}
 {{- end}}
 }`
 
-	protoMessageUpdateTmpl    *template.Template
 	protoMessageUpdateTmplRaw = `message Update{{.TableName}}ByIDRequest {
 {{- range $i, $v := .Fields}}
 	{{$v.GoType}} {{$v.ColName}} = {{$v.AddOneWithTag $i}}; {{if $v.Comment}} // {{$v.Comment}}{{end}}
 {{- end}}
 }`
 
-	protoMessageDetailTmpl    *template.Template
 	protoMessageDetailTmplRaw = `message {{.TableName}} {
 {{- range $i, $v := .Fields}}
 	{{$v.GoType}} {{$v.ColName}} = {{$v.AddOne $i}}; {{if $v.Comment}} // {{$v.Comment}}{{end}}
 {{- end}}
 }`
 
-	serviceStructTmpl    *template.Template
 	serviceStructTmplRaw = `
 		{
 			name: "Create",
@@ -273,88 +557,793 @@ message List{{.TableName}}Reply {
 			},
 			wantErr: false,
 		},
+{{- range .Indexes}}
+
+		{
+			name: "{{.MethodName}}",
+			fn: func() (interface{}, error) {
+				// todo enter parameters before testing
+				return cli.{{.MethodName}}(ctx, &serverNameExampleV1.{{.RequestName}}{
+					{{- range .Fields}}
+						{{.ProtoName}}:  {{.GoTypeZero}}, {{if .Comment}} // {{.Comment}}{{end}}
+					{{- end}}
+				})
+			},
+			wantErr: false,
+		},
+{{- end}}
 `
 
-	serviceCreateStructTmpl    *template.Template
 	serviceCreateStructTmplRaw = `				return cli.Create(ctx, &serverNameExampleV1.Create{{.TableName}}Request{
 					{{- range .Fields}}
 						{{.Name}}:  {{.GoTypeZero}}, {{if .Comment}} // {{.Comment}}{{end}}
 					{{- end}}
 				})`
 
-	serviceUpdateStructTmpl    *template.Template
 	serviceUpdateStructTmplRaw = `				return cli.UpdateByID(ctx, &serverNameExampleV1.Update{{.TableName}}ByIDRequest{
 					{{- range .Fields}}
 						{{.Name}}:  {{.GoTypeZero}}, {{if .Comment}} // {{.Comment}}{{end}}
 					{{- end}}
 				})`
 
-	tmplParseOnce sync.Once
-)
+	handlerIndexStructTmplRaw = `
+{{- range .Indexes}}
 
-func initTemplate() {
-	tmplParseOnce.Do(func() {
-		var err, errSum error
+{{- if .IsUnique}}
+
+// {{.RequestName}} request params of the unique index {{.ColumnNames}}
+{{- else}}
 
-		modelStructTmpl, err = template.New("goStruct").Parse(modelStructTmplRaw)
+// {{.RequestName}} request params of the index {{.ColumnNames}}
+{{- end}}
+type {{.RequestName}} struct {
+{{- range .Fields}}
+	{{.Name}}  {{.RequestType}} ` + "`" + `uri:"{{.ColName}}" binding:""` + "`" + `{{if .Comment}} // {{.Comment}}{{end}}
+{{- end}}
+}
+{{- end}}`
+
+	daoIndexInterfaceTmplRaw = `{{if .SoftDelete}}Restore(ctx context.Context, ids []uint64) error
+	HardDelete(ctx context.Context, ids []uint64) error{{if .Indexes}}
+	{{end}}{{end}}{{range $i, $v := .Indexes}}{{if $i}}
+	{{end}}{{$v.MethodName}}(ctx context.Context, {{$v.Params}}) ({{if $v.IsUnique}}*{{else}}[]*{{end}}model.{{$v.TableName}}, error){{end}}`
+
+	daoIndexTmplRaw = `
+// the columns of the unique key that determine whether the record exists in Upsert, the primary key is used if empty
+var {{.TName}}ConflictColumns {{.ConflictColumns}}
+{{- if .SoftDelete}}
+
+// Restore restore the soft deleted records by ids
+func (d *{{.TName}}Dao) Restore(ctx context.Context, ids []uint64) error {
+	err := mysql.Restore(ctx, d.getDB(ctx), &model.{{.TableName}}{}, ids)
+	if err != nil {
+		return err
+	}
+
+	// delete the not found cache of the records, it is deferred until the transaction is committed
+	d.deleteCacheByIDs(ctx, ids...)
+
+	return nil
+}
+
+// HardDelete delete the records by ids permanently, including the soft deleted records
+func (d *{{.TName}}Dao) HardDelete(ctx context.Context, ids []uint64) error {
+	records, err := d.getBypassRecords(ctx, ids...)
+	if err != nil {
+		return err
+	}
+	err = mysql.HardDelete(ctx, d.getDB(ctx), &model.{{.TableName}}{}, ids)
+	if err != nil {
+		return err
+	}
+
+	// delete cache, it is deferred until the transaction is committed
+	mysql.AfterCommit(ctx, d.db, func() {
+		d.deleteRecordCache(ctx, records, ids...)
+	})
+
+	return nil
+}
+{{- end}}
+{{- range .Indexes}}
+{{- if .IsUnique}}
+
+// {{.MethodName}} get a record based on the unique index {{.ColumnNames}}
+func (d *{{.TName}}Dao) {{.MethodName}}(ctx context.Context, {{.Params}}) (*model.{{.TableName}}, error) {
+	// the uncommitted data in the transaction and the data of all tenants are not cached
+	if mysql.InTx(ctx, d.db) || tenant.IsBypass(ctx) {
+		table := &model.{{.TableName}}{}
+		err := d.getDB(ctx).Where("{{.Where}}", {{.Args ""}}).First(table).Error
 		if err != nil {
-			errSum = errors.Wrap(err, "modelStructTmplRaw")
+			return nil, err
 		}
-		modelTmpl, err = template.New("goFile").Parse(modelTmplRaw)
-		if err != nil {
-			errSum = errors.Wrap(errSum, "modelTmplRaw:"+err.Error())
+		return table, nil
+	}
+
+	indexKey := {{.CacheKey ""}}
+	ids, err := d.cache.GetIndexIDs(ctx, indexKey)
+	if err == nil && len(ids) > 0 {
+		record, err := d.GetByID(ctx, ids[0])
+		if err == nil && {{.Match "record"}} {
+			return record, nil
 		}
-		updateFieldTmpl, err = template.New("goUpdateField").Parse(updateFieldTmplRaw)
+		// the record has been deleted or the index column has been modified
+		_ = d.cache.DelIndexIDs(ctx, indexKey)
+	} else if errors.Is(err, cacheBase.ErrPlaceholder) {
+		return nil, model.ErrRecordNotFound
+	} else if err != nil && !errors.Is(err, model.ErrCacheNotFound) {
+		// fail fast, if cache error return, don't request to db
+		return nil, err
+	}
+
+	// for the same index value, prevent high concurrent simultaneous access to mysql
+	val, err, _ := d.sfg.Do(tenant.Key(ctx, indexKey), func() (interface{}, error) { //nolint
+		start := time.Now()
+		table := &model.{{.TableName}}{}
+		err = d.getDB(ctx).Where("{{.Where}}", {{.Args ""}}).First(table).Error
 		if err != nil {
-			errSum = errors.Wrap(errSum, "updateFieldTmplRaw:"+err.Error())
+			// if data is empty, set not found cache to prevent cache penetration
+			if errors.Is(err, model.ErrRecordNotFound) {
+				err = d.cache.SetIndexCacheWithNotFound(ctx, indexKey)
+				if err != nil {
+					return nil, err
+				}
+				return nil, model.ErrRecordNotFound
+			}
+			return nil, err
 		}
-		handlerCreateStructTmpl, err = template.New("goPostStruct").Parse(handlerCreateStructTmplRaw)
+		// set cache
+		err = d.cache.SetIndexIDs(ctx, indexKey, []uint64{table.ID}, d.hardTTL)
 		if err != nil {
-			errSum = errors.Wrap(errSum, "handlerCreateStructTmplRaw:"+err.Error())
+			return nil, fmt.Errorf("cache.SetIndexIDs error: %v, index=%s", err, indexKey)
 		}
-		handlerUpdateStructTmpl, err = template.New("goPutStruct").Parse(handlerUpdateStructTmplRaw)
+		err = d.setCache(ctx, time.Since(start), table)
 		if err != nil {
-			errSum = errors.Wrap(errSum, "handlerUpdateStructTmplRaw:"+err.Error())
+			return nil, fmt.Errorf("cache.Set error: %v, id=%d", err, table.ID)
 		}
-		handlerDetailStructTmpl, err = template.New("goGetStruct").Parse(handlerDetailStructTmplRaw)
+		return table, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	table, ok := val.(*model.{{.TableName}})
+	if !ok {
+		return nil, model.ErrRecordNotFound
+	}
+	return table, nil
+}
+{{- else}}
+
+// {{.MethodName}} get records based on the index {{.ColumnNames}}, sorted by id
+func (d *{{.TName}}Dao) {{.MethodName}}(ctx context.Context, {{.Params}}) ([]*model.{{.TableName}}, error) {
+	// the uncommitted data in the transaction and the data of all tenants are not cached
+	if mysql.InTx(ctx, d.db) || tenant.IsBypass(ctx) {
+		records := []*model.{{.TableName}}{}
+		err := d.getDB(ctx).Where("{{.Where}}", {{.Args ""}}).Order("id").Find(&records).Error
 		if err != nil {
-			errSum = errors.Wrap(errSum, "handlerDetailStructTmplRaw:"+err.Error())
+			return nil, err
 		}
-		modelJSONTmpl, err = template.New("modelJSON").Parse(modelJSONTmplRaw)
+		return records, nil
+	}
+
+	indexKey := {{.CacheKey ""}}
+	ids, err := d.cache.GetIndexIDs(ctx, indexKey)
+	if err == nil && len(ids) > 0 {
+		records, err := d.GetByIDs(ctx, ids)
+		if err == nil {
+			recordMap := make(map[uint64]*model.{{.TableName}}, len(records))
+			for _, record := range records {
+				if {{.Match "record"}} {
+					recordMap[record.ID] = record
+				}
+			}
+			if len(recordMap) == len(ids) {
+				records = make([]*model.{{.TableName}}, 0, len(ids))
+				for _, id := range ids {
+					records = append(records, recordMap[id])
+				}
+				return records, nil
+			}
+		}
+		// the records have been deleted or the index columns have been modified
+		_ = d.cache.DelIndexIDs(ctx, indexKey)
+	} else if errors.Is(err, cacheBase.ErrPlaceholder) {
+		return []*model.{{.TableName}}{}, nil
+	} else if err != nil && !errors.Is(err, model.ErrCacheNotFound) {
+		// fail fast, if cache error return, don't request to db
+		return nil, err
+	}
+
+	// for the same index value, prevent high concurrent simultaneous access to mysql
+	val, err, _ := d.sfg.Do(tenant.Key(ctx, indexKey), func() (interface{}, error) { //nolint
+		start := time.Now()
+		records := []*model.{{.TableName}}{}
+		err = d.getDB(ctx).Where("{{.Where}}", {{.Args ""}}).Order("id").Find(&records).Error
 		if err != nil {
-			errSum = errors.Wrap(errSum, "modelJSONTmplRaw:"+err.Error())
+			return nil, err
+		}
+		// if data is empty, set not found cache to prevent cache penetration
+		if len(records) == 0 {
+			err = d.cache.SetIndexCacheWithNotFound(ctx, indexKey)
+			if err != nil {
+				return nil, err
+			}
+			return records, nil
+		}
+		// set cache
+		ids := make([]uint64, 0, len(records))
+		for _, record := range records {
+			ids = append(ids, record.ID)
 		}
-		protoFileTmpl, err = template.New("protoFile").Parse(protoFileTmplRaw)
+		err = d.cache.SetIndexIDs(ctx, indexKey, ids, d.hardTTL)
 		if err != nil {
-			errSum = errors.Wrap(errSum, "protoFileTmplRaw:"+err.Error())
+			return nil, fmt.Errorf("cache.SetIndexIDs error: %v, index=%s", err, indexKey)
 		}
-		protoMessageCreateTmpl, err = template.New("protoMessageCreate").Parse(protoMessageCreateTmplRaw)
+		err = d.setCache(ctx, time.Since(start), records...)
 		if err != nil {
-			errSum = errors.Wrap(errSum, "protoMessageCreateTmplRaw:"+err.Error())
+			return nil, fmt.Errorf("cache.MultiSet error: %v, index=%s", err, indexKey)
+		}
+		return records, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	records, ok := val.([]*model.{{.TableName}})
+	if !ok {
+		return nil, model.ErrRecordNotFound
+	}
+	return records, nil
+}
+{{- end}}
+{{- end}}
+
+// delete the index cache of the records, the stale not found cache of the index values is also cleared,
+// it is called after the transaction is committed
+func (d *{{.TName}}Dao) deleteIndexCache(ctx context.Context, tables ...*model.{{.TableName}}) {
+{{- if .Indexes}}
+	indexKeys := make([]string, 0, len(tables))
+	for _, table := range tables {
+		indexKeys = append(indexKeys,
+		{{- range .Indexes}}
+			{{.CacheKey "table"}},
+		{{- end}}
+		)
+	}
+	_ = d.cache.DelIndexIDs(ctx, indexKeys...)
+{{- end}}
+}
+
+// delete the index cache of the records whose index columns may have been modified
+func (d *{{.TName}}Dao) deleteIndexCacheByIDs(ctx context.Context, ids ...uint64) {
+{{- if .Indexes}}
+	tables := []*model.{{.TableName}}{}
+	// read from the primary, the replicas may not have the modification yet
+	err := d.getDB(mysql.WithPrimary(ctx)).Where("id IN (?)", ids).Find(&tables).Error
+	if err != nil || len(tables) == 0 {
+		return
+	}
+	mysql.AfterCommit(ctx, d.db, func() {
+		d.deleteIndexCache(ctx, tables...)
+	})
+{{- end}}
+}
+`
+
+	daoIndexTestTmplRaw = `
+{{- if .SoftDelete}}
+
+func Test_{{.TName}}Dao_Restore(t *testing.T) {
+	d := new{{.TableName}}Dao()
+	defer d.Close()
+	testData := d.TestData.(*model.{{.TableName}})
+
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("UPDATE .* SET ` + "`" + `deleted_at` + "`" + `=.*").
+		WithArgs(nil, d.AnyTime, testData.ID).
+		WillReturnResult(sqlmock.NewResult(int64(testData.ID), 1))
+	d.SQLMock.ExpectCommit()
+{{- if .Indexes}}
+	d.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs(testData.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(testData.ID))
+{{- end}}
+
+	err := d.IDao.({{.TableName}}Dao).Restore(d.Ctx, []uint64{testData.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+
+	// restore error
+	err = d.IDao.({{.TableName}}Dao).Restore(d.Ctx, []uint64{testData.ID})
+	assert.Error(t, err)
+}
+
+func Test_{{.TName}}Dao_HardDelete(t *testing.T) {
+	d := new{{.TableName}}Dao()
+	defer d.Close()
+	testData := d.TestData.(*model.{{.TableName}})
+
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("DELETE FROM .*").
+		WithArgs(testData.ID).
+		WillReturnResult(sqlmock.NewResult(int64(testData.ID), 1))
+	d.SQLMock.ExpectCommit()
+
+	err := d.IDao.({{.TableName}}Dao).HardDelete(d.Ctx, []uint64{testData.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, d.SQLMock.ExpectationsWereMet())
+
+	// delete error
+	err = d.IDao.({{.TableName}}Dao).HardDelete(d.Ctx, []uint64{testData.ID})
+	assert.Error(t, err)
+}
+{{- end}}
+{{- with .ConflictIndex}}
+
+func Test_{{.TName}}Dao_UpsertConflict(t *testing.T) {
+	d := new{{.TableName}}Dao()
+	defer d.Close()
+	testData := d.TestData.(*model.{{.TableName}})
+	iCache := d.Cache.ICache.(cache.{{.TableName}}Cache)
+	err := iCache.Set(d.Ctx, testData.ID, testData, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the record of the same {{.ColumnNames}} already exists, the id is read by the values of the columns
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .* ON DUPLICATE KEY UPDATE .*").
+		WillReturnResult(sqlmock.NewResult(2, 2))
+	d.SQLMock.ExpectCommit()
+	d.SQLMock.ExpectQuery("SELECT ` + "`" + `id` + "`" + ` FROM .*").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(testData.ID))
+
+	table := &model.{{.TableName}}{}
+{{- range .Fields}}
+	table.{{.Name}} = {{.TestValue}}
+{{- end}}
+	err = d.IDao.({{.TableName}}Dao).Upsert(d.Ctx, table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, testData.ID, table.ID)
+	_, err = iCache.Get(d.Ctx, testData.ID)
+	assert.ErrorIs(t, err, model.ErrCacheNotFound)
+
+	err = d.SQLMock.ExpectationsWereMet()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+{{- end}}
+{{- range .Indexes}}
+
+func Test_{{.TName}}Dao_{{.MethodName}}(t *testing.T) {
+	d := new{{.TableName}}Dao()
+	defer d.Close()
+	testData := d.TestData.(*model.{{.TableName}})
+{{- range .Fields}}
+	testData.{{.Name}} = {{.TestValue}}
+{{- end}}
+{{- if .IsUnique}}
+
+	// notfound error
+	d.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs({{.TestArgs}}).
+		WillReturnError(gorm.ErrRecordNotFound)
+	_, err := d.IDao.({{.TableName}}Dao).{{.MethodName}}(d.Ctx, {{.TestArgs}})
+	assert.ErrorIs(t, err, model.ErrRecordNotFound)
+
+	// notfound error from the placeholder cache
+	_, err = d.IDao.({{.TableName}}Dao).{{.MethodName}}(d.Ctx, {{.TestArgs}})
+	assert.ErrorIs(t, err, model.ErrRecordNotFound)
+{{- else}}
+
+	// no records
+	d.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs({{.TestArgs}}).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	records, err := d.IDao.({{.TableName}}Dao).{{.MethodName}}(d.Ctx, {{.TestArgs}})
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+
+	// no records from the placeholder cache
+	records, err = d.IDao.({{.TableName}}Dao).{{.MethodName}}(d.Ctx, {{.TestArgs}})
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+{{- end}}
+
+	// the not found cache of the index is deleted after the record is created
+	d.SQLMock.ExpectBegin()
+	d.SQLMock.ExpectExec("INSERT INTO .*").
+		WillReturnResult(sqlmock.NewResult(int64(testData.ID), 1))
+	d.SQLMock.ExpectCommit()
+	err = d.IDao.({{.TableName}}Dao).CreateBatch(d.Ctx, []*model.{{.TableName}}{testData})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d.SQLMock.ExpectQuery("SELECT .*").
+		WithArgs({{.TestArgs}}).
+		WillReturnRows(sqlmock.NewRows([]string{ {{- .TestColumns -}} }).AddRow({{.TestRowValues}}))
+{{- if .IsUnique}}
+	record, err := d.IDao.({{.TableName}}Dao).{{.MethodName}}(d.Ctx, {{.TestArgs}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, testData.ID, record.ID)
+
+	// get from cache
+	record, err = d.IDao.({{.TableName}}Dao).{{.MethodName}}(d.Ctx, {{.TestArgs}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, testData.ID, record.ID)
+{{- else}}
+	records, err = d.IDao.({{.TableName}}Dao).{{.MethodName}}(d.Ctx, {{.TestArgs}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, records, 1)
+
+	// get from cache
+	records, err = d.IDao.({{.TableName}}Dao).{{.MethodName}}(d.Ctx, {{.TestArgs}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, records, 1)
+{{- end}}
+
+	err = d.SQLMock.ExpectationsWereMet()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+{{- end}}
+`
+
+	handlerIndexInterfaceTmplRaw = `{{range $i, $v := .Indexes}}{{if $i}}
+	{{end}}{{$v.MethodName}}(c *gin.Context){{end}}`
+
+	handlerIndexTmplRaw = `
+{{- range .Indexes}}
+{{- if .IsUnique}}
+
+// {{.MethodName}} get a record by the unique index {{.ColumnNames}}
+// @Summary get {{.TName}} details by {{.ColumnNames}}
+// @Description get {{.TName}} details by the unique index {{.ColumnNames}}
+// @Tags {{.TName}}
+{{- range .Fields}}
+// @Param {{.ColName}} path {{.SwaggerType}} true "{{.ColName}}"
+{{- end}}
+// @Accept json
+// @Produce json
+// @Success 200 {object} types.Result{}
+// @Router /api/v1/{{.TName}}/{{.ProtoPath}} [get]
+func (h *{{.TName}}Handler) {{.MethodName}}(c *gin.Context) {
+	form := &types.{{.RequestName}}{}
+	err := c.ShouldBindUri(form)
+	if err != nil {
+		logger.Warn("ShouldBindUri error: ", logger.Err(err), middleware.GCtxRequestIDField(c))
+		response.Error(c, ecode.InvalidParams)
+		return
+	}
+
+	{{.TName}}, err := h.iDao.{{.MethodName}}(c.Request.Context(), {{.Args "form"}})
+	if err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			logger.Warn("{{.MethodName}} not found", logger.Err(err), logger.Any("form", form), middleware.GCtxRequestIDField(c))
+			response.Error(c, ecode.NotFound)
+		} else {
+			logger.Error("{{.MethodName}} error", logger.Err(err), logger.Any("form", form), middleware.GCtxRequestIDField(c))
+			response.Output(c, ecode.InternalServerError.ToHTTPCode())
 		}
-		protoMessageUpdateTmpl, err = template.New("protoMessageUpdate").Parse(protoMessageUpdateTmplRaw)
+		return
+	}
+
+	data := &types.Get{{.TableName}}ByIDRespond{}
+	err = copier.Copy(data, {{.TName}})
+	if err != nil {
+		response.Error(c, ecode.ErrGet{{.TableName}})
+		return
+	}
+	data.ID = utils.Uint64ToStr({{.TName}}.ID)
+
+	response.Success(c, gin.H{"{{.TName}}": data})
+}
+{{- else}}
+
+// {{.MethodName}} get records by the index {{.ColumnNames}}
+// @Summary get a list of {{.TName}}s by {{.ColumnNames}}
+// @Description get a list of {{.TName}}s by the index {{.ColumnNames}}
+// @Tags {{.TName}}
+{{- range .Fields}}
+// @Param {{.ColName}} path {{.SwaggerType}} true "{{.ColName}}"
+{{- end}}
+// @Accept json
+// @Produce json
+// @Success 200 {object} types.Result{}
+// @Router /api/v1/{{.TName}}s/{{.ProtoPath}} [get]
+func (h *{{.TName}}Handler) {{.MethodName}}(c *gin.Context) {
+	form := &types.{{.RequestName}}{}
+	err := c.ShouldBindUri(form)
+	if err != nil {
+		logger.Warn("ShouldBindUri error: ", logger.Err(err), middleware.GCtxRequestIDField(c))
+		response.Error(c, ecode.InvalidParams)
+		return
+	}
+
+	{{.TName}}s, err := h.iDao.{{.MethodName}}(c.Request.Context(), {{.Args "form"}})
+	if err != nil {
+		logger.Error("{{.MethodName}} error", logger.Err(err), logger.Any("form", form), middleware.GCtxRequestIDField(c))
+		response.Output(c, ecode.InternalServerError.ToHTTPCode())
+		return
+	}
+
+	data, err := convert{{.TableName}}s({{.TName}}s)
+	if err != nil {
+		response.Error(c, ecode.ErrList{{.TableName}})
+		return
+	}
+
+	response.Success(c, gin.H{
+		"{{.TName}}s": data,
+	})
+}
+{{- end}}
+{{- end}}
+`
+
+	routerIndexTmplRaw = `{{range $i, $v := .Indexes}}{{if $i}}
+	{{end}}{{if $v.IsUnique}}group.GET("/{{$v.TName}}/{{$v.RoutePath}}", h.{{$v.MethodName}}){{else}}group.GET("/{{$v.TName}}s/{{$v.RoutePath}}", h.{{$v.MethodName}}){{end}}{{end}}`
+
+	routerTestIndexTmplRaw = `{{range .Indexes}}
+func (u mock) {{.MethodName}}(c *gin.Context) { return }
+{{- end}}`
+
+	serviceIndexTmplRaw = `
+{{- range .Indexes}}
+{{- if .IsUnique}}
+
+// {{.MethodName}} get a record by the unique index {{.ColumnNames}}
+func (s *{{.TName}}Service) {{.MethodName}}(ctx context.Context, req *serverNameExampleV1.{{.RequestName}}) (*serverNameExampleV1.{{.ReplyName}}, error) {
+	err := req.Validate()
+	if err != nil {
+		logger.Warn("req.Validate error", logger.Err(err), logger.Any("req", req), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusInvalidParams.Err()
+	}
+
+	record, err := s.iDao.{{.MethodName}}(ctx, {{.ProtoArgs}})
+	if err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			logger.Warn("s.iDao.{{.MethodName}} error", logger.Err(err), logger.Any("req", req), interceptor.ServerCtxRequestIDField(ctx))
+			return nil, ecode.StatusNotFound.Err()
+		}
+		logger.Error("s.iDao.{{.MethodName}} error", logger.Err(err), logger.Any("req", req), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusInternalServerError.ToRPCErr()
+	}
+
+	data, err := covert{{.TableName}}(record)
+	if err != nil {
+		logger.Warn("covert{{.TableName}} error", logger.Err(err), logger.Any("record", record), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusGet{{.TableName}}.Err()
+	}
+
+	return &serverNameExampleV1.{{.ReplyName}}{ {{- .TableName}}: data}, nil
+}
+{{- else}}
+
+// {{.MethodName}} get records by the index {{.ColumnNames}}
+func (s *{{.TName}}Service) {{.MethodName}}(ctx context.Context, req *serverNameExampleV1.{{.RequestName}}) (*serverNameExampleV1.{{.ReplyName}}, error) {
+	err := req.Validate()
+	if err != nil {
+		logger.Warn("req.Validate error", logger.Err(err), logger.Any("req", req), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusInvalidParams.Err()
+	}
+
+	records, err := s.iDao.{{.MethodName}}(ctx, {{.ProtoArgs}})
+	if err != nil {
+		logger.Error("s.iDao.{{.MethodName}} error", logger.Err(err), logger.Any("req", req), interceptor.ServerCtxRequestIDField(ctx))
+		return nil, ecode.StatusInternalServerError.ToRPCErr()
+	}
+
+	{{.TName}}s := []*serverNameExampleV1.{{.TableName}}{}
+	for _, record := range records {
+		{{.TName}}, err := covert{{.TableName}}(record)
 		if err != nil {
-			errSum = errors.Wrap(errSum, "protoMessageUpdateTmplRaw:"+err.Error())
+			logger.Warn("covert{{.TableName}} error", logger.Err(err), logger.Any("id", record.ID), interceptor.ServerCtxRequestIDField(ctx))
+			continue
+		}
+		{{.TName}}s = append({{.TName}}s, {{.TName}})
+	}
+
+	return &serverNameExampleV1.{{.ReplyName}}{ {{- .TableName}}s: {{.TName}}s}, nil
+}
+{{- end}}
+{{- end}}
+`
+
+	serviceConvertRequestTmplRaw = `
+{{- range .Fields}}
+{{- if and .Enum (not .Enum.IsSet)}}
+	{{$.TName}}.{{.Name}} = model.{{.Enum.TypeName}}FromNumber(int32(req.{{.ProtoFieldName}}))
+{{- else if .JSON}}
+	if req.{{.ProtoFieldName}} != nil {
+{{- if .JSON.IsStruct}}
+		var data []byte
+		data, err = req.{{.ProtoFieldName}}.MarshalJSON()
+		if err == nil {
+			{{$.TName}}.{{.Name}} = &model.{{.JSON.TypeName}}{}
+			err = json.Unmarshal(data, {{$.TName}}.{{.Name}})
 		}
-		protoMessageDetailTmpl, err = template.New("protoMessageDetail").Parse(protoMessageDetailTmplRaw)
+{{- else}}
+		{{$.TName}}.{{.Name}}, err = req.{{.ProtoFieldName}}.MarshalJSON()
+{{- end}}
 		if err != nil {
-			errSum = errors.Wrap(errSum, "protoMessageDetailTmplRaw:"+err.Error())
+			logger.Warn("convert req.{{.ProtoFieldName}} error", logger.Err(err), interceptor.ServerCtxRequestIDField(ctx))
+			return nil, ecode.StatusInvalidParams.Err()
 		}
-		serviceCreateStructTmpl, err = template.New("serviceCreateStruct").Parse(serviceCreateStructTmplRaw)
+	}
+{{- end}}
+{{- end}}`
+
+	serviceConvertReplyTmplRaw = `
+{{- range .Fields}}
+{{- if and .Enum (not .Enum.IsSet)}}
+	value.{{.ProtoFieldName}} = serverNameExampleV1.{{.Enum.TypeName}}(record.{{.Name}}.Number())
+{{- else if .JSON}}
+	value.{{.ProtoFieldName}} = nil
+{{- if .JSON.IsStruct}}
+	if record.{{.Name}} != nil {
+		var data []byte
+		data, err = json.Marshal(record.{{.Name}})
 		if err != nil {
-			errSum = errors.Wrap(errSum, "serviceCreateStructTmplRaw:"+err.Error())
+			return nil, err
 		}
-		serviceUpdateStructTmpl, err = template.New("serviceUpdateStruct").Parse(serviceUpdateStructTmplRaw)
+		value.{{.ProtoFieldName}} = &structpb.Struct{}
+		err = value.{{.ProtoFieldName}}.UnmarshalJSON(data)
 		if err != nil {
-			errSum = errors.Wrap(errSum, "serviceUpdateStructTmplRaw:"+err.Error())
+			return nil, err
 		}
-		serviceStructTmpl, err = template.New("serviceStruct").Parse(serviceStructTmplRaw)
+	}
+{{- else}}
+	if len(record.{{.Name}}) > 0 && record.{{.Name}}.String() != "null" {
+		value.{{.ProtoFieldName}} = &structpb.Struct{}
+		err = value.{{.ProtoFieldName}}.UnmarshalJSON(record.{{.Name}})
 		if err != nil {
-			errSum = errors.Wrap(errSum, "serviceStructTmplRaw:"+err.Error())
+			return nil, err
 		}
+	}
+{{- end}}
+{{- end}}
+{{- end}}`
 
-		if errSum != nil {
-			panic(errSum)
+	tmplParseOnce sync.Once
+	builtinTmpls  *tmplSet // the built-in templates, parsed once and not changed
+)
+
+// the templates used by a call of ParseSQL
+type tmplSet struct {
+	modelStructTmpl           *template.Template
+	modelTmpl                 *template.Template
+	updateFieldTmpl           *template.Template
+	handlerCreateStructTmpl   *template.Template
+	handlerUpdateStructTmpl   *template.Template
+	handlerDetailStructTmpl   *template.Template
+	modelJSONTmpl             *template.Template
+	protoFileTmpl             *template.Template
+	protoMessageCreateTmpl    *template.Template
+	protoMessageUpdateTmpl    *template.Template
+	protoMessageDetailTmpl    *template.Template
+	serviceCreateStructTmpl   *template.Template
+	serviceUpdateStructTmpl   *template.Template
+	serviceStructTmpl         *template.Template
+	modelEnumTmpl             *template.Template
+	handlerIndexStructTmpl    *template.Template
+	daoIndexInterfaceTmpl     *template.Template
+	daoIndexTmpl              *template.Template
+	daoIndexTestTmpl          *template.Template
+	handlerIndexInterfaceTmpl *template.Template
+	handlerIndexTmpl          *template.Template
+	routerIndexTmpl           *template.Template
+	routerTestIndexTmpl       *template.Template
+	serviceIndexTmpl          *template.Template
+	serviceConvertRequestTmpl *template.Template
+	serviceConvertReplyTmpl   *template.Template
+	modelJSONStructTmpl       *template.Template
+}
+
+type tmplDef struct {
+	name string // name of the template, the user-supplied template file is <name>.tmpl
+	tmpl **template.Template
+	raw  string
+}
+
+// the built-in templates, any of them can be overridden by the user-supplied template file with the same name
+func getTmplDefs(t *tmplSet) []tmplDef {
+	return []tmplDef{
+		{name: "modelStruct", tmpl: &t.modelStructTmpl, raw: modelStructTmplRaw},
+		{name: "model", tmpl: &t.modelTmpl, raw: modelTmplRaw},
+		{name: "updateField", tmpl: &t.updateFieldTmpl, raw: updateFieldTmplRaw},
+		{name: "handlerCreateStruct", tmpl: &t.handlerCreateStructTmpl, raw: handlerCreateStructTmplRaw},
+		{name: "handlerUpdateStruct", tmpl: &t.handlerUpdateStructTmpl, raw: handlerUpdateStructTmplRaw},
+		{name: "handlerDetailStruct", tmpl: &t.handlerDetailStructTmpl, raw: handlerDetailStructTmplRaw},
+		{name: "modelJSON", tmpl: &t.modelJSONTmpl, raw: modelJSONTmplRaw},
+		{name: "protoFile", tmpl: &t.protoFileTmpl, raw: protoFileTmplRaw},
+		{name: "protoMessageCreate", tmpl: &t.protoMessageCreateTmpl, raw: protoMessageCreateTmplRaw},
+		{name: "protoMessageUpdate", tmpl: &t.protoMessageUpdateTmpl, raw: protoMessageUpdateTmplRaw},
+		{name: "protoMessageDetail", tmpl: &t.protoMessageDetailTmpl, raw: protoMessageDetailTmplRaw},
+		{name: "serviceCreateStruct", tmpl: &t.serviceCreateStructTmpl, raw: serviceCreateStructTmplRaw},
+		{name: "serviceUpdateStruct", tmpl: &t.serviceUpdateStructTmpl, raw: serviceUpdateStructTmplRaw},
+		{name: "serviceStruct", tmpl: &t.serviceStructTmpl, raw: serviceStructTmplRaw},
+		{name: "modelEnum", tmpl: &t.modelEnumTmpl, raw: modelEnumTmplRaw},
+		{name: "handlerIndexStruct", tmpl: &t.handlerIndexStructTmpl, raw: handlerIndexStructTmplRaw},
+		{name: "daoIndexInterface", tmpl: &t.daoIndexInterfaceTmpl, raw: daoIndexInterfaceTmplRaw},
+		{name: "daoIndex", tmpl: &t.daoIndexTmpl, raw: daoIndexTmplRaw},
+		{name: "daoIndexTest", tmpl: &t.daoIndexTestTmpl, raw: daoIndexTestTmplRaw},
+		{name: "handlerIndexInterface", tmpl: &t.handlerIndexInterfaceTmpl, raw: handlerIndexInterfaceTmplRaw},
+		{name: "handlerIndex", tmpl: &t.handlerIndexTmpl, raw: handlerIndexTmplRaw},
+		{name: "routerIndex", tmpl: &t.routerIndexTmpl, raw: routerIndexTmplRaw},
+		{name: "routerTestIndex", tmpl: &t.routerTestIndexTmpl, raw: routerTestIndexTmplRaw},
+		{name: "serviceIndex", tmpl: &t.serviceIndexTmpl, raw: serviceIndexTmplRaw},
+		{name: "serviceConvertRequest", tmpl: &t.serviceConvertRequestTmpl, raw: serviceConvertRequestTmplRaw},
+		{name: "serviceConvertReply", tmpl: &t.serviceConvertReplyTmpl, raw: serviceConvertReplyTmplRaw},
+		{name: "modelJSONStruct", tmpl: &t.modelJSONStructTmpl, raw: modelJSONStructTmplRaw},
+	}
+}
+
+func initTemplate() {
+	tmplParseOnce.Do(func() {
+		var err error
+		builtinTmpls, err = parseTemplates("")
+		if err != nil {
+			panic(err)
 		}
 	})
 }
+
+// parse the templates, if the file <name>.tmpl exists in dir, it is used instead of the built-in template
+func parseTemplates(dir string) (*tmplSet, error) {
+	var errSum error
+	t := &tmplSet{}
+	for _, def := range getTmplDefs(t) {
+		raw := def.raw
+		if dir != "" {
+			data, err := os.ReadFile(filepath.Join(dir, def.name+".tmpl"))
+			if err == nil {
+				raw = string(data)
+			} else if !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+
+		tmpl, err := template.New(def.name).Parse(raw)
+		if err != nil {
+			if errSum == nil {
+				errSum = errors.Wrap(err, def.name+"TmplRaw")
+			} else {
+				errSum = errors.Wrap(errSum, def.name+"TmplRaw:"+err.Error())
+			}
+			continue
+		}
+		*def.tmpl = tmpl
+	}
+	if errSum != nil {
+		return nil, errSum
+	}
+
+	return t, nil
+}
+
+// get the templates of a call, the user-supplied templates in the directory are parsed for every call,
+// empty means the built-in templates
+func getTemplates(dir string) (*tmplSet, error) {
+	initTemplate()
+	if dir == "" {
+		return builtinTmpls, nil
+	}
+	return parseTemplates(dir)
+}
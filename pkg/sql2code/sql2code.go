@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/zhufuyi/sponge/pkg/sql2code/parser"
 )
@@ -14,8 +15,9 @@ type Args struct {
 
 	DDLFile string // DDL file
 
-	DBDsn   string // connecting to mysql's dsn
-	DBTable string
+	DBDriver string // db driver name, mysql(default), postgresql, sqlite
+	DBDsn    string // connecting to db's dsn, the sqlite dsn is the database file path
+	DBTable  string // table name, when generating codes from sql or ddl file, only the specified table is generated
 
 	Package        string // specify the package name (only valid for model types)
 	GormType       bool   // whether to display the gorm type name (only valid for model type codes)
@@ -30,12 +32,25 @@ type Args struct {
 	ColumnPrefix   string
 	NoNullType     bool
 	NullStyle      string
+	JSONType       string // go type of the JSON columns, string(default) or datatypes
+	JSONStructs    string // named struct types of the JSON columns, e.g. profile:UserProfile,users.settings:Settings
+	TemplateDir    string // directory of the user-supplied templates, the file <name>.tmpl overrides the built-in template
 }
 
 func (a *Args) checkValid() error {
 	if a.SQL == "" && a.DDLFile == "" && (a.DBDsn == "" && a.DBTable == "") {
 		return errors.New("you must specify sql or ddl file")
 	}
+	switch a.DBDriver {
+	case "", parser.DBDriverMysql, parser.DBDriverPostgresql, parser.DBDriverSqlite:
+	default:
+		return fmt.Errorf("unsupported db driver '%s'", a.DBDriver)
+	}
+	switch a.JSONType {
+	case "", "string", parser.JSONTypeDatatypes:
+	default:
+		return fmt.Errorf("unsupported json type '%s'", a.JSONType)
+	}
 	return nil
 }
 
@@ -53,9 +68,18 @@ func getSQL(args *Args) (string, error) {
 		return string(b), nil
 	} else if args.DBDsn != "" {
 		if args.DBTable == "" {
-			return sql, errors.New("miss mysql table")
+			return sql, errors.New("miss db table")
+		}
+		var sqlStr string
+		var err error
+		switch args.DBDriver {
+		case parser.DBDriverPostgresql:
+			sqlStr, err = parser.GetPostgresqlTableInfo(args.DBDsn, args.DBTable)
+		case parser.DBDriverSqlite:
+			sqlStr, err = parser.GetSqliteTableInfo(args.DBDsn, args.DBTable)
+		default:
+			sqlStr, err = parser.GetTableInfo(args.DBDsn, args.DBTable)
 		}
-		sqlStr, err := parser.GetTableInfo(args.DBDsn, args.DBTable)
 		if err != nil {
 			return sql, err
 		}
@@ -68,6 +92,9 @@ func getSQL(args *Args) (string, error) {
 func getOptions(args *Args) []parser.Option {
 	var opts []parser.Option
 
+	if args.DBDriver != "" {
+		opts = append(opts, parser.WithDBDriver(args.DBDriver))
+	}
 	if args.Charset != "" {
 		opts = append(opts, parser.WithCharset(args.Charset))
 	}
@@ -112,6 +139,22 @@ func getOptions(args *Args) []parser.Option {
 	if args.ForceTableName {
 		opts = append(opts, parser.WithForceTableName())
 	}
+	if args.JSONType != "" {
+		opts = append(opts, parser.WithJSONType(args.JSONType))
+	}
+	for _, v := range strings.Split(args.JSONStructs, ",") {
+		ss := strings.SplitN(strings.TrimSpace(v), ":", 2)
+		if len(ss) == 2 {
+			opts = append(opts, parser.WithJSONStruct(strings.TrimSpace(ss[0]), strings.TrimSpace(ss[1])))
+		}
+	}
+	if args.TemplateDir != "" {
+		opts = append(opts, parser.WithTemplateDir(args.TemplateDir))
+	}
+	if (args.SQL != "" || args.DDLFile != "") && args.DBTable != "" {
+		// the other tables in the sql are used to resolve the associations
+		opts = append(opts, parser.WithTableNames(strings.Split(args.DBTable, ",")...))
+	}
 
 	return opts
 }
@@ -40,6 +40,14 @@ func TestGenerateOne(t *testing.T) {
 			}},
 			wantErr: false,
 		},
+		{
+			name: "sql with table name",
+			args: args{args: &Args{
+				SQL:     sqlData,
+				DBTable: "user",
+			}},
+			wantErr: false,
+		},
 		{
 			name: "sql from file",
 			args: args{args: &Args{
@@ -68,6 +76,20 @@ func TestGenerateOne(t *testing.T) {
 	}
 }
 
+var pgSQLData = `
+create table "user"
+(
+    id         bigserial primary key,
+    created_at timestamptz null,
+    updated_at timestamptz null,
+    deleted_at timestamptz null,
+    name       varchar(50)  not null,
+    email      varchar(50)  not null unique,
+    age        smallint     not null
+);
+comment on column "user".name is 'username';
+`
+
 func TestGenerate(t *testing.T) {
 	type args struct {
 		args *Args
@@ -84,6 +106,14 @@ func TestGenerate(t *testing.T) {
 			}},
 			wantErr: false,
 		},
+		{
+			name: "postgresql sql form param",
+			args: args{args: &Args{
+				SQL:      pgSQLData,
+				DBDriver: "postgresql",
+			}},
+			wantErr: false,
+		},
 		//{
 		//	name: "sql from db",
 		//	args: args{args: &Args{
@@ -124,6 +154,14 @@ func TestGenerateError(t *testing.T) {
 	_, err = Generate(a)
 	assert.Error(t, err)
 
+	a = &Args{SQL: sqlData, DBDriver: "unknown"}
+	_, err = Generate(a)
+	assert.Error(t, err)
+
+	a = &Args{SQL: sqlData, JSONType: "unknown"}
+	_, err = Generate(a)
+	assert.Error(t, err)
+
 	a = &Args{DDLFile: "test.sql", CodeType: "unknown"}
 	_, err = GenerateOne(a)
 	t.Log(err)
@@ -142,6 +180,9 @@ func Test_getOptions(t *testing.T) {
 		ColumnPrefix:   "ColumnPrefix",
 		NoNullType:     true,
 		NullStyle:      "sql",
+		JSONType:       "datatypes",
+		JSONStructs:    "profile:UserProfile, users.settings:Settings",
+		TemplateDir:    "templates",
 	}
 
 	o := getOptions(a)
@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"time"
+)
+
+// Option set the sqlite options.
+type Option func(*options)
+
+type options struct {
+	isLog         bool
+	slowThreshold time.Duration
+
+	maxIdleConns    int
+	maxOpenConns    int
+	connMaxLifetime time.Duration
+
+	disableForeignKey bool
+	enableTrace       bool
+}
+
+func (o *options) apply(opts ...Option) {
+	for _, opt := range opts {
+		opt(o)
+	}
+}
+
+// default settings
+func defaultOptions() *options {
+	return &options{
+		isLog:         false,            // whether to output logs, default off
+		slowThreshold: time.Duration(0), // if greater than 0, only print logs that are longer than the threshold, higher priority than isLog
+
+		maxIdleConns:    1,                // set the maximum number of connections in the idle connection pool
+		maxOpenConns:    1,                // set the maximum number of open database connections, sqlite allows only one writer at a time
+		connMaxLifetime: 0,                // sets the maximum amount of time a connection can be reused, 0 means the connections are not closed due to age
+
+		disableForeignKey: true,  // disables the use of foreign keys, true is recommended for production environments, enabled by default
+		enableTrace:       false, // whether to enable link tracing, default is off
+	}
+}
+
+// WithLog set log sql
+func WithLog() Option {
+	return func(o *options) {
+		o.isLog = true
+	}
+}
+
+// WithSlowThreshold Set sql values greater than the threshold
+func WithSlowThreshold(d time.Duration) Option {
+	return func(o *options) {
+		o.slowThreshold = d
+	}
+}
+
+// WithMaxIdleConns set max idle conns
+func WithMaxIdleConns(size int) Option {
+	return func(o *options) {
+		o.maxIdleConns = size
+	}
+}
+
+// WithMaxOpenConns set max open conns
+func WithMaxOpenConns(size int) Option {
+	return func(o *options) {
+		o.maxOpenConns = size
+	}
+}
+
+// WithConnMaxLifetime set conn max lifetime
+func WithConnMaxLifetime(t time.Duration) Option {
+	return func(o *options) {
+		o.connMaxLifetime = t
+	}
+}
+
+// WithEnableForeignKey use foreign keys
+func WithEnableForeignKey() Option {
+	return func(o *options) {
+		o.disableForeignKey = false
+	}
+}
+
+// WithEnableTrace use trace
+func WithEnableTrace() Option {
+	return func(o *options) {
+		o.enableTrace = true
+	}
+}
@@ -0,0 +1,118 @@
+// Package sqlite is a library wrapped on top of gorm with a pure go sqlite driver, the options are the same as the mysql package.
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/glebarez/sqlite"
+	"github.com/uptrace/opentelemetry-go-extra/otelgorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+// Init sqlite, dsn is the database file path, e.g. ./test.db, file:test.db?cache=shared, :memory:
+func Init(dsn string, opts ...Option) (*gorm.DB, error) {
+	o := defaultOptions()
+	o.apply(opts...)
+	if isMemoryDsn(dsn) {
+		// every new connection opens a new empty in-memory database, the connection must not be closed
+		o.connMaxLifetime = 0
+	}
+
+	if !o.disableForeignKey {
+		// the pragma is executed by the driver on every new connection of the pool
+		dsn = addPragma(dsn, "foreign_keys(1)")
+	}
+
+	sqlDB, err := sql.Open(sqlite.DriverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxIdleConns(o.maxIdleConns)       // set the maximum number of connections in the idle connection pool
+	sqlDB.SetMaxOpenConns(o.maxOpenConns)       // set the maximum number of open database connections
+	sqlDB.SetConnMaxLifetime(o.connMaxLifetime) // set the maximum time a connection can be reused
+
+	db, err := gorm.Open(sqlite.Dialector{Conn: sqlDB}, gormConfig(o))
+	if err != nil {
+		return nil, fmt.Errorf("gorm.Open error, err: %v", err)
+	}
+
+	if o.enableTrace {
+		err = db.Use(otelgorm.NewPlugin())
+		if err != nil {
+			return nil, fmt.Errorf("using gorm opentelemetry, err: %v", err)
+		}
+	}
+
+	return db, nil
+}
+
+// Close sqlite
+func Close(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// IsDsn whether the dsn is a sqlite database file
+func IsDsn(dsn string) bool {
+	if dsn == ":memory:" || strings.HasPrefix(dsn, "file:") {
+		return true
+	}
+	for _, ext := range []string{".db", ".db3", ".sqlite", ".sqlite3"} {
+		if strings.HasSuffix(dsn, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+func isMemoryDsn(dsn string) bool {
+	return strings.HasPrefix(dsn, ":memory:") || strings.Contains(dsn, "mode=memory")
+}
+
+// add the pragma to the query parameters of dsn, e.g. ./test.db?_pragma=foreign_keys(1)
+func addPragma(dsn string, pragma string) string {
+	if strings.Contains(dsn, "?") {
+		return dsn + "&_pragma=" + pragma
+	}
+	return dsn + "?_pragma=" + pragma
+}
+
+// gorm setting
+func gormConfig(o *options) *gorm.Config {
+	config := &gorm.Config{
+		// disable foreign key constraints, not recommended for production environments
+		DisableForeignKeyConstraintWhenMigrating: o.disableForeignKey,
+		// removing the plural of an epithet
+		NamingStrategy: schema.NamingStrategy{SingularTable: true},
+	}
+
+	// print all SQL
+	if o.isLog {
+		config.Logger = logger.Default.LogMode(logger.Info)
+	} else {
+		config.Logger = logger.Default.LogMode(logger.Silent)
+	}
+
+	// print only slow queries
+	if o.slowThreshold > 0 {
+		config.Logger = logger.New(
+			log.New(os.Stdout, "\r\n", log.LstdFlags), // use the standard output asWriter
+			logger.Config{
+				SlowThreshold: o.slowThreshold,
+				Colorful:      true,
+				LogLevel:      logger.Warn, // set the logging level, only above the specified level will output the slow query log
+			},
+		)
+	}
+
+	return config
+}
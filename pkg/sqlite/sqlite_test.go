@@ -0,0 +1,102 @@
+package sqlite
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type userExample struct {
+	ID   uint64 `gorm:"column:id;AUTO_INCREMENT;primary_key"`
+	Name string `gorm:"column:name"`
+	Age  int    `gorm:"column:age"`
+}
+
+func TestInit(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "test.db")
+	db, err := Init(dsn, WithEnableTrace(), WithEnableForeignKey())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer Close(db) //nolint
+
+	err = db.AutoMigrate(&userExample{})
+	assert.NoError(t, err)
+
+	user := &userExample{Name: "foo", Age: 10}
+	err = db.Create(user).Error
+	assert.NoError(t, err)
+	assert.NotZero(t, user.ID)
+
+	result := &userExample{}
+	err = db.Where("id = ?", user.ID).First(result).Error
+	assert.NoError(t, err)
+	assert.Equal(t, user.Name, result.Name)
+}
+
+type userAddress struct {
+	ID     uint64 `gorm:"column:id;AUTO_INCREMENT;primary_key"`
+	UserID uint64 `gorm:"column:user_id"`
+	User   *userExample
+}
+
+func TestInit_foreignKey(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "test.db")
+	db, err := Init(dsn, WithEnableForeignKey(), WithMaxOpenConns(2), WithMaxIdleConns(2))
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer Close(db) //nolint
+	err = db.AutoMigrate(&userExample{}, &userAddress{})
+	assert.NoError(t, err)
+
+	// the foreign key is enabled on every connection of the pool
+	tx := db.Begin()
+	err = tx.Create(&userAddress{UserID: 100}).Error
+	assert.Error(t, err)
+	err = db.Create(&userAddress{UserID: 100}).Error
+	assert.Error(t, err)
+	tx.Rollback()
+
+	// the data of the in-memory database is kept
+	memDB, err := Init(":memory:", WithEnableForeignKey(), WithConnMaxLifetime(time.Millisecond))
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer Close(memDB) //nolint
+	err = memDB.AutoMigrate(&userExample{})
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 10)
+	err = memDB.Create(&userExample{Name: "foo"}).Error
+	assert.NoError(t, err)
+
+	assert.Equal(t, time.Duration(0), defaultOptions().connMaxLifetime)
+	assert.Equal(t, "./test.db?_pragma=foreign_keys(1)", addPragma("./test.db", "foreign_keys(1)"))
+	assert.Equal(t, "file:test.db?cache=shared&_pragma=foreign_keys(1)", addPragma("file:test.db?cache=shared", "foreign_keys(1)"))
+}
+
+func Test_gormConfig(t *testing.T) {
+	o := defaultOptions()
+	o.apply(
+		WithLog(),
+		WithSlowThreshold(time.Millisecond*100),
+		WithEnableTrace(),
+		WithMaxIdleConns(5),
+		WithMaxOpenConns(50),
+		WithConnMaxLifetime(time.Minute*3),
+		WithEnableForeignKey(),
+	)
+
+	c := gormConfig(o)
+	assert.NotNil(t, c)
+}
+
+func TestIsDsn(t *testing.T) {
+	assert.True(t, IsDsn("./test.db"))
+	assert.True(t, IsDsn("/data/test.sqlite3"))
+	assert.True(t, IsDsn("file:test.db?cache=shared"))
+	assert.True(t, IsDsn(":memory:"))
+	assert.False(t, IsDsn("root:123456@(192.168.3.37:3306)/test"))
+}
@@ -0,0 +1,50 @@
+// Package tenant stores the tenant id in the context to isolate the data of tenants,
+// e.g. the queries of gorm are scoped by mysql.TenantScope and the keys of cache include the tenant id.
+package tenant
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrMissingTenant there is no tenant id in the context
+var ErrMissingTenant = errors.New("missing tenant id in context")
+
+type (
+	tenantIDKey struct{}
+	bypassKey   struct{}
+)
+
+// NewContext returns a new context that carries the tenant id
+func NewContext(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantIDKey{}, tenantID)
+}
+
+// FromContext get the tenant id from context, return empty string if not exist
+func FromContext(ctx context.Context) string {
+	if tenantID, ok := ctx.Value(tenantIDKey{}).(string); ok {
+		return tenantID
+	}
+	return ""
+}
+
+// WithBypass returns a new context that bypasses the tenant scope, the data of all tenants can be accessed,
+// e.g. the jobs of administrators, it must be set explicitly and must not be derived from the request.
+func WithBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassKey{}, true)
+}
+
+// IsBypass determine if the context bypasses the tenant scope
+func IsBypass(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassKey{}).(bool)
+	return bypass
+}
+
+// Key prefix the key with the tenant id in the context, e.g. tenantID:key, it is used to separate the keys of
+// tenants, e.g. the keys of cache and singleflight, the key is unchanged if there is no tenant id in the context.
+func Key(ctx context.Context, key string) string {
+	if tenantID := FromContext(ctx); tenantID != "" {
+		return tenantID + ":" + key
+	}
+	return key
+}
@@ -0,0 +1,24 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "", FromContext(ctx))
+	assert.False(t, IsBypass(ctx))
+	assert.Equal(t, "100", Key(ctx, "100"))
+
+	ctx = NewContext(ctx, "t1")
+	assert.Equal(t, "t1", FromContext(ctx))
+	assert.Equal(t, "t1:100", Key(ctx, "100"))
+	assert.False(t, IsBypass(ctx))
+
+	ctx = WithBypass(ctx)
+	assert.True(t, IsBypass(ctx))
+	assert.Equal(t, "t1", FromContext(ctx))
+}